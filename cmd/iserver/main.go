@@ -30,8 +30,10 @@ import (
 )
 
 var (
-	configFile = flag.StringP("config", "f", "", "Configuration `file`")
-	help       = flag.BoolP("help", "h", false, "Display available options")
+	configFile  = flag.StringP("config", "f", "", "Configuration `file`")
+	dev         = flag.Bool("dev", false, "Run a single node dev chain on an ephemeral db, with a prefunded admin account")
+	devInterval = flag.Duration("dev_interval", 0, "The `interval` of the blocks produced without any tx in the dev mode, 0 means only on demand")
+	help        = flag.BoolP("help", "h", false, "Display available options")
 )
 
 func initMetrics(metricsConfig *common.MetricsConfig) error {
@@ -83,7 +85,21 @@ func main() {
 
 	initLogger(conf.Log)
 
-	ilog.Infof("Config Information:\n%v", strings.Replace(conf.YamlString(), conf.ACC.SecKey, conf.ACC.SecKey[:3]+"******", -1))
+	if *dev {
+		if err := iserver.SetDevMode(conf, *devInterval); err != nil {
+			ilog.Fatalf("Set dev mode failed: %v", err)
+		}
+	}
+
+	confString := conf.YamlString()
+	// The seckey is empty if the blocks are signed by a remote signer.
+	if len(conf.ACC.SecKey) > 3 {
+		confString = strings.Replace(confString, conf.ACC.SecKey, conf.ACC.SecKey[:3]+"******", -1)
+	}
+	if len(conf.ACC.BLSSecKey) > 3 {
+		confString = strings.Replace(confString, conf.ACC.BLSSecKey, conf.ACC.BLSSecKey[:3]+"******", -1)
+	}
+	ilog.Infof("Config Information:\n%v", confString)
 
 	ilog.Infof("build time:%v", global.BuildTime)
 	ilog.Infof("git hash:%v", global.GitHash)
@@ -97,15 +113,33 @@ func main() {
 	server := iserver.New(conf)
 	server.Start()
 
-	waitExit()
+	for waitExit(server.ResetRequested()) {
+		ilog.Infof("IOST server is resetting the dev chain...")
+		server.Stop()
+		if err := iserver.ResetDev(conf); err != nil {
+			ilog.Fatalf("Reset dev chain failed: %v", err)
+		}
+		server = iserver.New(conf)
+		server.Start()
+	}
 
 	server.Stop()
+	if conf.Dev != nil {
+		os.RemoveAll(conf.DB.LdbPath)
+	}
 	ilog.Stop()
 }
 
-func waitExit() {
+// waitExit returns false when interrupted, and true when the dev chain is requested to reset.
+func waitExit(reset <-chan struct{}) bool {
 	c := make(chan os.Signal, 1)
 	signal.Notify(c, syscall.SIGINT, syscall.SIGTERM, syscall.SIGQUIT)
-	i := <-c
-	ilog.Infof("IOST server received interrupt[%v], shutting down...", i)
+	defer signal.Stop(c)
+	select {
+	case i := <-c:
+		ilog.Infof("IOST server received interrupt[%v], shutting down...", i)
+		return false
+	case <-reset:
+		return true
+	}
 }
@@ -0,0 +1,74 @@
+// Command signer is the reference signer daemon, it keeps the key of a producer and signs the blocks and transactions
+// for the nodes configured with acc.signer, so that the key can live on a separate hardened host.
+//
+// The daemon only serves the nodes with a client certificate signed by the CA, and it records the last signed block
+// in the guard file, refusing to sign any block not after it, so that it never signs two conflicting blocks.
+//
+//	signer -k producer000_ed25519 -l 10.0.0.2:30010 --cert signer.crt --key signer.key --ca ca.crt
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+
+	"github.com/iost-official/go-iost/account"
+	"github.com/iost-official/go-iost/common"
+	"github.com/iost-official/go-iost/consensus/failover"
+	"github.com/iost-official/go-iost/crypto"
+	"github.com/iost-official/go-iost/ilog"
+	"github.com/iost-official/go-iost/signer"
+	flag "github.com/spf13/pflag"
+)
+
+var (
+	keyFile   = flag.StringP("keyfile", "k", "", "The `file` of the base58 encoded secret key")
+	algorithm = flag.StringP("algorithm", "a", "ed25519", "The algorithm of the key")
+	listen    = flag.StringP("listen", "l", "127.0.0.1:30010", "The `address` to serve on")
+	certFile  = flag.String("cert", "", "The certificate `file` of the signer")
+	certKey   = flag.String("key", "", "The key `file` of the certificate")
+	caFile    = flag.String("ca", "", "The CA `file` of the client certificates")
+	guardFile = flag.StringP("guard", "g", "signer_guard", "The `file` recording the last signed block")
+	help      = flag.BoolP("help", "h", false, "Display available options")
+)
+
+func main() {
+	flag.Parse()
+	if *help || *keyFile == "" {
+		flag.Usage()
+		os.Exit(1)
+	}
+
+	b, err := ioutil.ReadFile(*keyFile)
+	if err != nil {
+		ilog.Fatalf("Read key file failed: %v", err)
+	}
+	algo := crypto.NewAlgorithm(*algorithm)
+	kp, err := account.NewKeyPair(common.Base58Decode(strings.TrimSpace(string(b))), algo)
+	if err != nil {
+		ilog.Fatalf("Load key failed: %v", err)
+	}
+
+	tlsConfig, err := signer.LoadTLSConfig(*certFile, *certKey, *caFile, true)
+	if err != nil {
+		ilog.Fatalf("Load tls config failed: %v", err)
+	}
+	guard, err := failover.NewSignGuard(*guardFile)
+	if err != nil {
+		ilog.Fatalf("Load sign guard failed: %v", err)
+	}
+
+	server := signer.NewServer(signer.NewLocal(kp), algo, guard, tlsConfig)
+	if err := server.Start(*listen); err != nil {
+		ilog.Fatalf("Start signer failed: %v", err)
+	}
+	ilog.Infof("Signer of %v is serving on %v", kp.ReadablePubkey(), *listen)
+
+	c := make(chan os.Signal, 1)
+	signal.Notify(c, syscall.SIGINT, syscall.SIGTERM, syscall.SIGQUIT)
+	<-c
+	server.Stop()
+	ilog.Stop()
+}
@@ -20,6 +20,16 @@ type ACCConfig struct {
 	ID        string
 	SecKey    string
 	Algorithm string
+	// Signer is the address of the remote signer daemon, the blocks are signed by it instead of the SecKey if set.
+	Signer string
+	// SignerCert, SignerKey and SignerCA are the files of the client certificate, its key and the CA,
+	// which authenticate the node and the signer daemon to each other.
+	SignerCert string
+	SignerKey  string
+	SignerCA   string
+	// BLSSecKey is the BLS secret key of the witness in base58, the attestation votes are also signed by it
+	// to be aggregated into a single signature if set.
+	BLSSecKey string
 }
 
 // Witness config of the genesis block
@@ -47,11 +57,65 @@ type GenesisConfig struct {
 	ContractPath     string
 	AdminInfo        *Witness
 	FoundationInfo   *Witness
+	// SlotLength is the length of a slot in seconds, 3 if not set
+	SlotLength int64
+	// BlocksPerSlot is the number of the blocks produced by the witness of a slot, 6 if not set
+	BlocksPerSlot int
+	// WitnessNumber is the size of the witness list rotated by the votes, the number of the genesis witnesses if not set
+	WitnessNumber int64
+}
+
+// ForkConfig is the heights of the forks, which must be the same on all the nodes of a chain
+type ForkConfig struct {
+	// StateRoot is the number of the first block whose head commits to the state root, 0 means never
+	StateRoot int64
 }
 
 // DBConfig config of the database
 type DBConfig struct {
 	LdbPath string
+	// Archive makes the state db retain the history of every irreversible block
+	Archive bool
+	// ArchiveRetain is the number of recent irreversible blocks whose state is retained, 0 means all
+	ArchiveRetain int64
+	// AccountIndex makes the block chain db index the txs of every account
+	AccountIndex bool
+}
+
+// TxPoolConfig config of the txpool
+type TxPoolConfig struct {
+	// MaxSize is the max number of pending txs
+	MaxSize int
+	// MaxPerPublisher is the max number of pending txs of one publisher, 0 means no limit
+	MaxPerPublisher int
+	// MinGasRatio is the lowest gas ratio accepted when the pool is not crowded
+	MinGasRatio float64
+	// FullGasRatio is the lowest gas ratio accepted when the pool is full
+	FullGasRatio float64
+	// FloorThreshold is the fill rate of the pool above which the lowest accepted gas ratio rises
+	// linearly from MinGasRatio to FullGasRatio
+	FloorThreshold float64
+	// Journal makes the pending txs recorded on disk and reloaded after restart
+	Journal bool
+}
+
+// ConsensusConfig config of the consensus
+type ConsensusConfig struct {
+	// Attestation makes the witnesses vote for every new irreversible block and save the collected votes
+	Attestation bool
+	// Synchronizer selects the block synchronizer, "synchro" for consensus/synchro and "legacy" for consensus/synchronizer
+	Synchronizer string
+}
+
+// FailoverConfig config of the active and standby producers sharing the same key
+type FailoverConfig struct {
+	// Mode is "active" or "standby", empty means the failover is disabled
+	Mode string
+	// LeaseAddr is the address of the lease endpoint, served by the active node and polled by the standby node
+	LeaseAddr string
+	// LeaseTimeout is the seconds without the lease of the active node, after which the standby node produces blocks.
+	// The active node also waits for it at start, until the standby node stops producing.
+	LeaseTimeout int64
 }
 
 // VMConfig config of the v8vm
@@ -118,6 +182,16 @@ type MetricsConfig struct {
 type SnapshotConfig struct {
 	Enable   bool
 	FilePath string
+	// FastSync makes a new node download the state at a recent irreversible block from the neighbor nodes,
+	// instead of executing all blocks from genesis.
+	FastSync bool
+	// FastSyncCheckpoint is the base58 hash of the trusted irreversible block to fast sync to, which must be
+	// obtained out of band and be served by the neighbor nodes.
+	FastSyncCheckpoint string
+	// FastSyncPeers is the least number of neighbor nodes that must serve the same state for fast sync.
+	FastSyncPeers int
+	// ServeInterval is the number of irreversible blocks between two states served for fast sync, 0 means not serving.
+	ServeInterval int64
 }
 
 // DebugConfig is the config of debug.
@@ -125,6 +199,12 @@ type DebugConfig struct {
 	ListenAddr string
 }
 
+// DevConfig is the config of the single node dev mode, it is set by the --dev flag of iserver
+type DevConfig struct {
+	// BlockInterval is the milliseconds between the blocks produced without any tx, 0 means only on demand
+	BlockInterval int64
+}
+
 // VersionConfig contrains netname(mainnet / testnet etc) and protocol info
 type VersionConfig struct {
 	NetName         string
@@ -133,17 +213,22 @@ type VersionConfig struct {
 
 // Config provide all configuration for the application
 type Config struct {
-	ACC      *ACCConfig
-	Genesis  string
-	VM       *VMConfig
-	DB       *DBConfig
-	Snapshot *SnapshotConfig
-	P2P      *P2PConfig
-	RPC      *RPCConfig
-	Log      *LogConfig
-	Metrics  *MetricsConfig
-	Debug    *DebugConfig
-	Version  *VersionConfig
+	ACC       *ACCConfig
+	Genesis   string
+	VM        *VMConfig
+	DB        *DBConfig
+	Consensus *ConsensusConfig
+	Failover  *FailoverConfig
+	TxPool    *TxPoolConfig
+	Snapshot  *SnapshotConfig
+	P2P       *P2PConfig
+	RPC       *RPCConfig
+	Log       *LogConfig
+	Metrics   *MetricsConfig
+	Debug     *DebugConfig
+	Version   *VersionConfig
+	Dev       *DevConfig
+	Fork      *ForkConfig
 }
 
 // LoadYamlAsViper load yaml file as viper object
@@ -122,6 +122,16 @@ func (sd *SimpleDecoder) ParseInt32() (int32, error) {
 	return result, nil
 }
 
+// ParseInt64 parse input, return first int64
+func (sd *SimpleDecoder) ParseInt64() (int64, error) {
+	if len(sd.input) < 8 {
+		return 0, fmt.Errorf("parse int64 fail: invalid len %v", sd.input)
+	}
+	result := BytesToInt64(sd.input[:8])
+	sd.input = sd.input[8:]
+	return result, nil
+}
+
 // ParseBytes parse input, return first byte array
 func (sd *SimpleDecoder) ParseBytes() ([]byte, error) {
 	length, err := sd.ParseInt32()
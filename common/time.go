@@ -0,0 +1,30 @@
+package common
+
+import (
+	"sync/atomic"
+	"time"
+)
+
+// timeOffset is the nanoseconds the chain time is ahead of the local time, it is only changed in the dev mode.
+var timeOffset int64
+
+// NowNano returns the chain time in nanoseconds, which is the local time unless advanced in the dev mode.
+func NowNano() int64 {
+	return time.Now().UnixNano() + atomic.LoadInt64(&timeOffset)
+}
+
+// TimeOffset returns how far the chain time is ahead of the local time.
+func TimeOffset() time.Duration {
+	return time.Duration(atomic.LoadInt64(&timeOffset))
+}
+
+// AdvanceTime advances the chain time by d in the dev mode, and returns the new chain time in nanoseconds.
+func AdvanceTime(d time.Duration) int64 {
+	atomic.AddInt64(&timeOffset, int64(d))
+	return NowNano()
+}
+
+// ResetTime resets the chain time to the local time.
+func ResetTime() {
+	atomic.StoreInt64(&timeOffset, 0)
+}
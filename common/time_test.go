@@ -0,0 +1,19 @@
+package common
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAdvanceTime(t *testing.T) {
+	defer ResetTime()
+
+	assert.Equal(t, time.Duration(0), TimeOffset())
+	now := AdvanceTime(time.Hour)
+	assert.Equal(t, time.Hour, TimeOffset())
+	assert.True(t, now >= time.Now().Add(time.Hour).UnixNano()-int64(time.Second))
+	ResetTime()
+	assert.Equal(t, time.Duration(0), TimeOffset())
+}
@@ -1,7 +1,124 @@
 package common
 
+import (
+	"encoding/json"
+	"fmt"
+)
+
 // consts
 const (
 	VoteInterval = 1200
-	SlotLength   = 3
+
+	defaultSlotLength    = 3
+	defaultBlocksPerSlot = 6
+)
+
+// The chain parameters, they are set from the genesis config by SetChainParams at the start, before any goroutine
+// is started, and never changed afterwards, so they are read without locks. They are committed by the genesis block
+// and must be the same on all the nodes of a chain, see CheckChainParams.
+var (
+	// SlotLength is the length of a slot in seconds.
+	SlotLength int64 = defaultSlotLength
+	// BlocksPerSlot is the number of the blocks produced by the witness of a slot.
+	BlocksPerSlot = defaultBlocksPerSlot
+)
+
+// The fork heights, they are set from the fork config by SetForks at the start, and never changed afterwards.
+// Unlike the chain parameters, they are not committed by the genesis block, so that an existing chain adopts
+// a fork by setting the same height on all of its nodes before the chain reaches it.
+var (
+	// StateRootNumber is the number of the first block whose head commits to the state root, 0 means never.
+	StateRootNumber int64
 )
+
+// SetForks sets the fork heights from the fork config, nil means no fork.
+func SetForks(conf *ForkConfig) error {
+	StateRootNumber = 0
+	if conf == nil {
+		return nil
+	}
+	if conf.StateRoot < 0 {
+		return fmt.Errorf("invalid state root fork height %v", conf.StateRoot)
+	}
+	StateRootNumber = conf.StateRoot
+	return nil
+}
+
+// SetChainParams sets the chain parameters from the genesis config, the zero values keep the defaults.
+func SetChainParams(conf *GenesisConfig) error {
+	if conf.SlotLength < 0 || conf.BlocksPerSlot < 0 {
+		return fmt.Errorf("invalid slot length %v or blocks per slot %v", conf.SlotLength, conf.BlocksPerSlot)
+	}
+	if conf.SlotLength > 0 {
+		SlotLength = conf.SlotLength
+	}
+	if conf.BlocksPerSlot > 0 {
+		BlocksPerSlot = conf.BlocksPerSlot
+	}
+	return nil
+}
+
+// chainParams is the chain parameters committed by the info of the genesis block.
+type chainParams struct {
+	SlotLength    int64 `json:"slot_length,omitempty"`
+	BlocksPerSlot int   `json:"blocks_per_slot,omitempty"`
+}
+
+// EncodeChainParams returns the chain parameters of the genesis config kept in the info of the genesis block.
+// The defaults are omitted, and it is empty if all of them are the defaults, so that the genesis blocks of
+// the chains with the defaults are unchanged.
+func EncodeChainParams(conf *GenesisConfig) []byte {
+	p := chainParams{
+		SlotLength:    conf.SlotLength,
+		BlocksPerSlot: conf.BlocksPerSlot,
+	}
+	if p.SlotLength == defaultSlotLength {
+		p.SlotLength = 0
+	}
+	if p.BlocksPerSlot == defaultBlocksPerSlot {
+		p.BlocksPerSlot = 0
+	}
+	if p == (chainParams{}) {
+		return nil
+	}
+	b, err := json.Marshal(p)
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+func decodeChainParams(info []byte) (chainParams, error) {
+	var p chainParams
+	if len(info) > 0 {
+		if err := json.Unmarshal(info, &p); err != nil {
+			return p, fmt.Errorf("invalid chain params in genesis block: %v", err)
+		}
+	}
+	if p.SlotLength == 0 {
+		p.SlotLength = defaultSlotLength
+	}
+	if p.BlocksPerSlot == 0 {
+		p.BlocksPerSlot = defaultBlocksPerSlot
+	}
+	return p, nil
+}
+
+// DecodeChainParams returns the slot length and the blocks per slot committed by the genesis block info.
+func DecodeChainParams(info []byte) (slotLength int64, blocksPerSlot int, err error) {
+	p, err := decodeChainParams(info)
+	return p.SlotLength, p.BlocksPerSlot, err
+}
+
+// CheckChainParams checks that the chain parameters set at the start are the ones committed by the genesis block info.
+func CheckChainParams(info []byte) error {
+	p, err := decodeChainParams(info)
+	if err != nil {
+		return err
+	}
+	if p.SlotLength != SlotLength || p.BlocksPerSlot != BlocksPerSlot {
+		return fmt.Errorf("chain params mismatch the genesis block, slot length:%v, blocks per slot:%v",
+			p.SlotLength, p.BlocksPerSlot)
+	}
+	return nil
+}
@@ -0,0 +1,63 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetChainParams(t *testing.T) {
+	defer func(slotLength int64, blocksPerSlot int) {
+		SlotLength, BlocksPerSlot = slotLength, blocksPerSlot
+	}(SlotLength, BlocksPerSlot)
+
+	assert.Nil(t, SetChainParams(&GenesisConfig{}))
+	assert.Equal(t, int64(3), SlotLength)
+	assert.Equal(t, 6, BlocksPerSlot)
+
+	assert.Nil(t, SetChainParams(&GenesisConfig{SlotLength: 1, BlocksPerSlot: 2}))
+	assert.Equal(t, int64(1), SlotLength)
+	assert.Equal(t, 2, BlocksPerSlot)
+
+	assert.NotNil(t, SetChainParams(&GenesisConfig{SlotLength: -1}))
+	assert.NotNil(t, SetChainParams(&GenesisConfig{BlocksPerSlot: -1}))
+}
+
+func TestSetForks(t *testing.T) {
+	defer func(stateRootNumber int64) {
+		StateRootNumber = stateRootNumber
+	}(StateRootNumber)
+
+	assert.Nil(t, SetForks(&ForkConfig{StateRoot: 100}))
+	assert.Equal(t, int64(100), StateRootNumber)
+	assert.Nil(t, SetForks(nil))
+	assert.Equal(t, int64(0), StateRootNumber)
+	assert.NotNil(t, SetForks(&ForkConfig{StateRoot: -1}))
+
+	// the fork is not committed by the genesis block, an existing chain adopts it
+	assert.Nil(t, SetForks(&ForkConfig{StateRoot: 100}))
+	assert.Nil(t, CheckChainParams(nil))
+}
+
+func TestCheckChainParams(t *testing.T) {
+	defer func(slotLength int64, blocksPerSlot int) {
+		SlotLength, BlocksPerSlot = slotLength, blocksPerSlot
+	}(SlotLength, BlocksPerSlot)
+
+	assert.Nil(t, EncodeChainParams(&GenesisConfig{}))
+	assert.Nil(t, EncodeChainParams(&GenesisConfig{SlotLength: 3, BlocksPerSlot: 6}))
+	assert.Nil(t, SetChainParams(&GenesisConfig{}))
+	assert.Nil(t, CheckChainParams(nil))
+
+	conf := &GenesisConfig{SlotLength: 1}
+	info := EncodeChainParams(conf)
+	assert.NotNil(t, info)
+	assert.NotNil(t, CheckChainParams(info))
+	assert.Nil(t, SetChainParams(conf))
+	assert.Nil(t, CheckChainParams(info))
+	assert.NotNil(t, CheckChainParams(nil))
+
+	assert.Nil(t, SetChainParams(&GenesisConfig{SlotLength: 1, BlocksPerSlot: 6}))
+	assert.Nil(t, CheckChainParams(info))
+	assert.NotNil(t, CheckChainParams([]byte("invalid")))
+}
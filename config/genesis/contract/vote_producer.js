@@ -8,6 +8,7 @@ const ADMIN_PERMISSION = "active";
 const VOTE_PERMISSION = "vote";
 const ACTIVE_PERMISSION = "active";
 const WITHDRAW_PERMISSION = "operate";
+const DOUBLE_SIGN_JAIL_BLOCKS = 1209600; // one week
 
 const STATUS_APPLY = 0;
 const STATUS_APPROVED = 1;
@@ -90,6 +91,14 @@ class VoteContract {
         this._addToProducerMap(proID, pro);
     }
 
+    initProducerNumber(producerNumber) {
+        const bn = block.number;
+        if(bn !== 0) {
+            throw new Error("init out of genesis block");
+        }
+        this._put("producerNumber", producerNumber);
+    }
+
     initAdmin(adminID) {
         const bn = block.number;
         if(bn !== 0) {
@@ -444,6 +453,9 @@ class VoteContract {
             throw new Error("producer not exists, " + account);
         }
         const pro = this._mapGet("producerTable", account);
+        if (pro.jailedUntil !== undefined && block.number < pro.jailedUntil) {
+            throw new Error("producer is jailed for double sign until block " + pro.jailedUntil);
+        }
         pro.online = true;
         this._mapPut("producerTable", account, pro, blockchain.publisher());
         if (pro.status === STATUS_APPROVED || pro.status === STATUS_UNAPPLY) {
@@ -465,6 +477,39 @@ class VoteContract {
         }
     }
 
+    // punish the producer who signed two different blocks in one slot, anyone can submit the evidence.
+    // system.iost only accepts the recent evidence of this chain, and every double sign is punished once.
+    punishDoubleSign(evidence) {
+        const ret = blockchain.callWithAuth("system.iost", "verifyDoubleSign", [evidence]);
+        const pubkey = ret[0];
+        const number = ret[1];
+        const evidenceKey = pubkey + "_" + number;
+        if (storage.mapHas("doubleSignEvidence", evidenceKey)) {
+            throw new Error("double sign evidence exists");
+        }
+        const account = this._mapGet("producerKeyToId", pubkey);
+        if (!account) {
+            throw new Error("producer not exists, " + pubkey);
+        }
+        const publisher = blockchain.publisher();
+        storage.mapPut("doubleSignEvidence", evidenceKey, publisher, publisher);
+
+        // the producer is logged out and loses its score, it will be removed from the pending list on stat
+        const pro = this._mapGet("producerTable", account);
+        pro.online = false;
+        pro.jailedUntil = block.number + DOUBLE_SIGN_JAIL_BLOCKS;
+        this._mapPut("producerTable", account, pro, publisher);
+        if (pro.status === STATUS_APPROVED || pro.status === STATUS_UNAPPLY) {
+            this._addToProducerMap(account, pro);
+        }
+        let scores = this._getScores();
+        if (scores[account] !== undefined) {
+            scores[account] = "0";
+            this._putScores(scores);
+        }
+        blockchain.receipt(JSON.stringify([account, pubkey, number]));
+    }
+
     _getVoterCoef(producer) {
         let voterCoef = this._mapGet(voterCoefTable, producer);
         if (!voterCoef) {
@@ -1,12 +1,14 @@
 package consensus
 
 import (
-	"github.com/iost-official/go-iost/account"
 	"github.com/iost-official/go-iost/consensus/pob"
+	"github.com/iost-official/go-iost/consensus/producerstats"
+	"github.com/iost-official/go-iost/core/block"
 	"github.com/iost-official/go-iost/core/blockcache"
 	"github.com/iost-official/go-iost/core/global"
 	"github.com/iost-official/go-iost/core/txpool"
 	"github.com/iost-official/go-iost/p2p"
+	"github.com/iost-official/go-iost/signer"
 )
 
 // Type is the type of consensus
@@ -22,14 +24,16 @@ const (
 type Consensus interface {
 	Start() error
 	Stop()
+	// AddVerifyHook adds a hook called with the received block and the result of its verification.
+	AddVerifyHook(hook func(*block.Block, error))
 }
 
 // New returns the different consensus strategy.
-func New(cType Type, account *account.KeyPair, baseVariable global.BaseVariable, blkcache blockcache.BlockCache, txPool txpool.TxPool, service p2p.Service) Consensus {
+func New(cType Type, sig signer.Signer, baseVariable global.BaseVariable, blkcache blockcache.BlockCache, txPool txpool.TxPool, service p2p.Service, stats *producerstats.Tracker) Consensus {
 	switch cType {
 	case Pob:
-		return pob.New(account, baseVariable, blkcache, txPool, service)
+		return pob.New(sig, baseVariable, blkcache, txPool, service, stats)
 	default:
-		return pob.New(account, baseVariable, blkcache, txPool, service)
+		return pob.New(sig, baseVariable, blkcache, txPool, service, stats)
 	}
 }
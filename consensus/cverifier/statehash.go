@@ -0,0 +1,207 @@
+package cverifier
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+
+	"github.com/iost-official/go-iost/common"
+	"github.com/iost-official/go-iost/core/block"
+	"github.com/iost-official/go-iost/db"
+	"github.com/iost-official/go-iost/ilog"
+)
+
+// StateHashLead is the number of blocks before the state root fork from which the blocks track the changes
+// of the state hash, so that the state hash at the fork can be computed off the block path in the meantime.
+const StateHashLead int64 = 7200
+
+var stateHashBase struct {
+	sync.RWMutex
+	number int64
+	h      *db.StateHash
+}
+
+// stateHashTrackNumber returns the first block tracking the changes of the state hash, 0 if the state root is not activated.
+func stateHashTrackNumber() int64 {
+	if common.StateRootNumber <= 0 {
+		return 0
+	}
+	if common.StateRootNumber-StateHashLead < 1 {
+		return 1
+	}
+	return common.StateRootNumber - StateHashLead
+}
+
+func precomputedBase() *db.StateHash {
+	stateHashBase.RLock()
+	defer stateHashBase.RUnlock()
+	if stateHashBase.h == nil || stateHashBase.number != stateHashTrackNumber() {
+		return nil
+	}
+	h := *stateHashBase.h
+	return &h
+}
+
+func setPrecomputedBase(number int64, h *db.StateHash) {
+	stateHashBase.Lock()
+	stateHashBase.number = number
+	stateHashBase.h = h
+	stateHashBase.Unlock()
+}
+
+func getStateHash(mvccdb db.MVCCDB, key string) (*db.StateHash, error) {
+	v, err := mvccdb.Get(db.StateHashTable, key)
+	if err != nil {
+		return nil, fmt.Errorf("fail to get state hash %v: %v", key, err)
+	}
+	if v == "" {
+		return nil, nil
+	}
+	h := db.NewStateHash()
+	if err := h.Decode([]byte(v)); err != nil {
+		return nil, err
+	}
+	return h, nil
+}
+
+// TrackStateHash records the changes of the state hash by the write set of the executed block before the state root fork.
+// The changes are kept in the state db under db.StateHashDeltaKey, so they follow the fork of the block.
+func TrackStateHash(mvccdb db.MVCCDB, number int64, writeSet []*db.Item) error {
+	start := stateHashTrackNumber()
+	if start <= 0 || number < start || number >= common.StateRootNumber {
+		return nil
+	}
+	delta := db.NewStateHash()
+	if number > start {
+		var err error
+		if delta, err = getStateHash(mvccdb, db.StateHashDeltaKey); err != nil {
+			return err
+		}
+		if delta == nil {
+			// The node starts to track after the first block, the state hash will be computed at the fork.
+			return nil
+		}
+	}
+	delta.Update(writeSet)
+	if err := mvccdb.Put(db.StateHashTable, db.StateHashDeltaKey, string(delta.Encode())); err != nil {
+		return fmt.Errorf("fail to put state hash delta: %v", err)
+	}
+	base := precomputedBase()
+	if base == nil {
+		return nil
+	}
+	v, err := mvccdb.Get(db.StateHashTable, db.StateHashBaseKey)
+	if err != nil {
+		return fmt.Errorf("fail to get state hash base: %v", err)
+	}
+	if v != "" {
+		return nil
+	}
+	if err := mvccdb.Put(db.StateHashTable, db.StateHashBaseKey, string(base.Encode())); err != nil {
+		return fmt.Errorf("fail to put state hash base: %v", err)
+	}
+	return nil
+}
+
+// initialStateHash returns the state hash of the parent state of the first block committing to the state root.
+// It is the base computed off the block path plus the changes tracked since then, and only falls back to reading
+// through the parent state if any of them is missing.
+func initialStateHash(mvccdb db.MVCCDB, parentBlock *block.Block) (*db.StateHash, error) {
+	base, err := getStateHash(mvccdb, db.StateHashBaseKey)
+	if err != nil {
+		return nil, err
+	}
+	if base == nil {
+		base = precomputedBase()
+	}
+	delta, err := getStateHash(mvccdb, db.StateHashDeltaKey)
+	if err != nil {
+		return nil, err
+	}
+	if delta == nil && parentBlock.Head.Number < stateHashTrackNumber() {
+		delta = db.NewStateHash()
+	}
+	if base == nil || delta == nil {
+		ilog.Warnf("The state hash before block %v is not precomputed, compute it by reading through the state.", parentBlock.Head.Number+1)
+		return db.ComputeStateHash(mvccdb)
+	}
+	base.Merge(delta)
+	for _, key := range []string{db.StateHashBaseKey, db.StateHashDeltaKey} {
+		if err := mvccdb.Del(db.StateHashTable, key); err != nil {
+			return nil, fmt.Errorf("fail to delete state hash %v: %v", key, err)
+		}
+	}
+	return base, nil
+}
+
+// StateHashPrecomputer computes the state hash before the blocks start to track its changes from the flushed state,
+// so the first block committing to the state root needs not to read through the state.
+type StateHashPrecomputer struct {
+	stateDB db.MVCCDB
+	running int32
+}
+
+// NewStateHashPrecomputer returns a new StateHashPrecomputer of the state db.
+func NewStateHashPrecomputer(stateDB db.MVCCDB) *StateHashPrecomputer {
+	return &StateHashPrecomputer{
+		stateDB: stateDB,
+	}
+}
+
+// OnFlush should be called right after the state of the irreversible block is flushed into db.
+// The state hash is computed in another goroutine.
+func (p *StateHashPrecomputer) OnFlush(blk *block.Block) {
+	start := stateHashTrackNumber()
+	if start <= 0 || blk.Head.Number < start-1 || blk.Head.Number >= common.StateRootNumber-1 || precomputedBase() != nil {
+		return
+	}
+	if !atomic.CompareAndSwapInt32(&p.running, 0, 1) {
+		return
+	}
+	snapshot, err := p.stateDB.Snapshot()
+	if err != nil {
+		ilog.Errorf("Take the state snapshot failed: %v", err)
+		atomic.StoreInt32(&p.running, 0)
+		return
+	}
+	if snapshot.Tag() != string(blk.HeadHash()) {
+		snapshot.Release()
+		atomic.StoreInt32(&p.running, 0)
+		return
+	}
+	go func() {
+		defer atomic.StoreInt32(&p.running, 0)
+		defer snapshot.Release()
+		if err := p.precompute(blk, start, snapshot); err != nil {
+			ilog.Errorf("Precompute the state hash at block %v failed: %v", blk.Head.Number, err)
+		}
+	}()
+}
+
+func (p *StateHashPrecomputer) precompute(blk *block.Block, start int64, snapshot *db.Snapshot) error {
+	h := db.NewStateHash()
+	err := snapshot.Range(nil, func(key []byte, value []byte) bool {
+		h.Add(key, value)
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	if blk.Head.Number >= start {
+		v, err := snapshot.Get(db.StateHashTable, db.StateHashDeltaKey)
+		if err != nil {
+			return err
+		}
+		if v == "" {
+			return fmt.Errorf("the changes of the state hash are not tracked since block %v", start)
+		}
+		delta := db.NewStateHash()
+		if err := delta.Decode([]byte(v)); err != nil {
+			return err
+		}
+		h.Unmerge(delta)
+	}
+	setPrecomputedBase(start, h)
+	ilog.Infof("Precomputed the state hash before block %v at block %v.", start, blk.Head.Number)
+	return nil
+}
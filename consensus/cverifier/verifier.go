@@ -3,9 +3,12 @@ package cverifier
 import (
 	"bytes"
 	"errors"
+	"fmt"
 	"time"
 
+	"github.com/iost-official/go-iost/common"
 	"github.com/iost-official/go-iost/core/block"
+	"github.com/iost-official/go-iost/db"
 )
 
 var (
@@ -15,6 +18,8 @@ var (
 	errNumber     = errors.New("wrong number")
 	errTxHash     = errors.New("wrong txs hash")
 	errMerkleHash = errors.New("wrong tx receipt merkle hash")
+	errVersion    = errors.New("wrong block version")
+	errStateRoot  = errors.New("wrong state root")
 	// errTxReceipt  = errors.New("wrong tx receipt")
 
 	// TxExecTimeLimit the maximum verify execution time of a transaction
@@ -27,7 +32,7 @@ var (
 // VerifyBlockHead verifies the block head.
 func VerifyBlockHead(blk *block.Block, parentBlock *block.Block) error {
 	bh := blk.Head
-	if bh.Time > time.Now().UnixNano()+MaxBlockTimeGap {
+	if bh.Time > common.NowNano()+MaxBlockTimeGap {
 		return errFutureBlk
 	}
 	if bh.Time <= parentBlock.Head.Time {
@@ -45,6 +50,55 @@ func VerifyBlockHead(blk *block.Block, parentBlock *block.Block) error {
 	if !bytes.Equal(blk.CalculateTxReceiptMerkleHash(), bh.TxReceiptMerkleHash) {
 		return errMerkleHash
 	}
+	if bh.Version < parentBlock.Head.Version || bh.Version > block.V1 {
+		return errVersion
+	}
+	// The state root itself can only be verified after the block is executed, see VerifyStateRoot.
+	if bh.Version >= block.V1 && len(bh.StateRoot) != len(common.Sha3(nil)) {
+		return errStateRoot
+	}
+	if bh.Version < block.V1 && len(bh.StateRoot) != 0 {
+		return errStateRoot
+	}
+
+	return nil
+}
 
+// UpdateStateHash applies the write set of the executed block to the state hash kept in the state db,
+// and returns the state root of the block. The state root is the root of a multiset hash over all the
+// key value pairs of the state after the block, see db.StateHash. The state hash of the parent state of
+// the first block committing to the state root is precomputed before it, see StateHashPrecomputer, and
+// updated with the write set of every block after it.
+func UpdateStateHash(mvccdb db.MVCCDB, parentBlock *block.Block, writeSet []*db.Item) ([]byte, error) {
+	h := db.NewStateHash()
+	if parentBlock.Head.Version < block.V1 {
+		var err error
+		if h, err = initialStateHash(mvccdb, parentBlock); err != nil {
+			return nil, fmt.Errorf("fail to compute state hash: %v", err)
+		}
+	} else {
+		v, err := mvccdb.Get(db.StateHashTable, db.StateHashKey)
+		if err != nil {
+			return nil, fmt.Errorf("fail to get state hash: %v", err)
+		}
+		if err := h.Decode([]byte(v)); err != nil {
+			return nil, err
+		}
+	}
+	h.Update(writeSet)
+	if err := mvccdb.Put(db.StateHashTable, db.StateHashKey, string(h.Encode())); err != nil {
+		return nil, fmt.Errorf("fail to put state hash: %v", err)
+	}
+	return h.Root(), nil
+}
+
+// VerifyStateRoot verifies the state root of the executed block against the one returned by UpdateStateHash.
+func VerifyStateRoot(blk *block.Block, stateRoot []byte) error {
+	if blk.Head.Version < block.V1 {
+		return nil
+	}
+	if !bytes.Equal(stateRoot, blk.Head.StateRoot) {
+		return errStateRoot
+	}
 	return nil
 }
@@ -1,14 +1,17 @@
 package cverifier
 
 import (
+	"os"
 	"testing"
 
 	"github.com/smartystreets/goconvey/convey"
 
 	"time"
 
+	"github.com/iost-official/go-iost/common"
 	"github.com/iost-official/go-iost/core/block"
 	"github.com/iost-official/go-iost/core/tx"
+	"github.com/iost-official/go-iost/db"
 	. "github.com/smartystreets/goconvey/convey"
 )
 
@@ -67,5 +70,120 @@ func TestVerifyBlockHead(t *testing.T) {
 			err = VerifyBlockHead(blk, parentBlk)
 			convey.So(err, convey.ShouldEqual, errTxHash)
 		})
+
+		convey.Convey("Wrong version", func() {
+			blk.Head.Version = block.V1
+			err := VerifyBlockHead(blk, parentBlk)
+			convey.So(err, convey.ShouldEqual, errStateRoot)
+			blk.Head.StateRoot = db.NewStateHash().Root()
+			err = VerifyBlockHead(blk, parentBlk)
+			convey.So(err, convey.ShouldBeNil)
+
+			parentBlk.Head.Version = block.V1
+			blk.Head.Version = block.V0
+			blk.Head.StateRoot = nil
+			err = VerifyBlockHead(blk, parentBlk)
+			convey.So(err, convey.ShouldEqual, errVersion)
+		})
+
+		convey.Convey("Wrong state root", func() {
+			mvccdb, err := db.NewMVCCDB("StateRootDB")
+			convey.So(err, convey.ShouldBeNil)
+			defer os.RemoveAll("StateRootDB")
+			defer mvccdb.Close()
+			mvccdb.Put("state", "a", "1")
+			mvccdb.Commit("parent")
+			mvccdb.Put("state", "b", "2")
+			mvccdb.Del("state", "a")
+
+			// the state hash is computed from the whole parent state at the first block
+			blk.Head.Version = block.V1
+			root, err := UpdateStateHash(mvccdb, parentBlk, mvccdb.WriteSet())
+			convey.So(err, convey.ShouldBeNil)
+			expected := db.NewStateHash()
+			expected.Add([]byte("state/b"), []byte("2"))
+			convey.So(root, convey.ShouldResemble, expected.Root())
+			blk.Head.StateRoot = root
+			convey.So(VerifyStateRoot(blk, root), convey.ShouldBeNil)
+			mvccdb.Commit("blk")
+
+			// and updated with the write set after it
+			mvccdb.Put("state", "c", "3")
+			root, err = UpdateStateHash(mvccdb, blk, mvccdb.WriteSet())
+			convey.So(err, convey.ShouldBeNil)
+			expected.Add([]byte("state/c"), []byte("3"))
+			convey.So(root, convey.ShouldResemble, expected.Root())
+			convey.So(VerifyStateRoot(blk, root), convey.ShouldEqual, errStateRoot)
+			blk.Head.Version = block.V0
+			convey.So(VerifyStateRoot(blk, root), convey.ShouldBeNil)
+		})
+	})
+}
+
+func TestPrecomputeStateHash(t *testing.T) {
+	Convey("Test of precompute state hash", t, func() {
+		common.StateRootNumber = StateHashLead + 3
+		defer func() {
+			common.StateRootNumber = 0
+			setPrecomputedBase(0, nil)
+		}()
+		mvccdb, err := db.NewMVCCDB("PrecomputeDB")
+		So(err, ShouldBeNil)
+		defer os.RemoveAll("PrecomputeDB")
+		defer mvccdb.Close()
+
+		newBlock := func(number int64) *block.Block {
+			return &block.Block{Head: &block.BlockHead{Number: number}}
+		}
+		commit := func(blk *block.Block) {
+			So(TrackStateHash(mvccdb, blk.Head.Number, mvccdb.WriteSet()), ShouldBeNil)
+			mvccdb.Commit(string(blk.HeadHash()))
+		}
+
+		// the changes are tracked since the block StateHashLead before the fork
+		blk2 := newBlock(2)
+		mvccdb.Put("state", "a", "1")
+		commit(blk2)
+		blk3 := newBlock(3)
+		mvccdb.Put("state", "b", "2")
+		mvccdb.Del("state", "a")
+		commit(blk3)
+		blk4 := newBlock(4)
+		mvccdb.Put("state", "c", "3")
+		commit(blk4)
+		So(mvccdb.Flush(string(blk4.HeadHash())), ShouldBeNil)
+
+		// the state hash before them is computed from the flushed state
+		snapshot, err := mvccdb.Snapshot()
+		So(err, ShouldBeNil)
+		p := NewStateHashPrecomputer(mvccdb)
+		So(p.precompute(blk4, stateHashTrackNumber(), snapshot), ShouldBeNil)
+		snapshot.Release()
+		expected := db.NewStateHash()
+		expected.Add([]byte("state/a"), []byte("1"))
+		So(precomputedBase(), ShouldResemble, expected)
+
+		// and kept in the state db by the next block
+		blk5 := newBlock(5)
+		mvccdb.Put("state", "d", "4")
+		commit(blk5)
+		setPrecomputedBase(0, nil)
+
+		// the first block committing to the state root needs not to read through the state
+		parentBlk := newBlock(common.StateRootNumber - 1)
+		mvccdb.Put("state", "e", "5")
+		root, err := UpdateStateHash(mvccdb, parentBlk, mvccdb.WriteSet())
+		So(err, ShouldBeNil)
+		expected = db.NewStateHash()
+		expected.Add([]byte("state/b"), []byte("2"))
+		expected.Add([]byte("state/c"), []byte("3"))
+		expected.Add([]byte("state/d"), []byte("4"))
+		expected.Add([]byte("state/e"), []byte("5"))
+		So(root, ShouldResemble, expected.Root())
+		for _, key := range []string{db.StateHashBaseKey, db.StateHashDeltaKey} {
+			v, err := mvccdb.Get(db.StateHashTable, key)
+			So(err, ShouldBeNil)
+			So(v, ShouldEqual, "")
+		}
 	})
 }
@@ -0,0 +1,100 @@
+// Package failover makes a standby node produce blocks with the key of the active node when the active node dies,
+// and guards the producers against signing two blocks of the same slot and number.
+package failover
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sync"
+
+	"github.com/iost-official/go-iost/common"
+)
+
+// ErrDoubleSign is returned when the block to sign is not after the last signed one.
+var ErrDoubleSign = errors.New("the block is not after the last signed block, refuse to sign")
+
+// SignGuard records the slot and number of the last signed block on disk, and refuses to sign a block
+// in an earlier slot, or in the same slot with a number not larger, so that a restarted node never signs
+// a block conflicting with the ones signed before.
+type SignGuard struct {
+	path   string
+	mu     sync.Mutex
+	slot   int64
+	number int64
+}
+
+// NewSignGuard returns a sign guard recording in the file of the path, the last record is loaded if exists.
+func NewSignGuard(path string) (*SignGuard, error) {
+	g := &SignGuard{path: path}
+	b, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return g, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("fail to read sign guard: %v", err)
+	}
+	if len(b) != 16 {
+		return nil, fmt.Errorf("invalid sign guard file %v", path)
+	}
+	g.slot = common.BytesToInt64(b[:8])
+	g.number = common.BytesToInt64(b[8:])
+	return g, nil
+}
+
+// Last returns the slot and number of the last signed block.
+func (g *SignGuard) Last() (int64, int64) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return g.slot, g.number
+}
+
+func (g *SignGuard) after(slot, number int64) bool {
+	return slot > g.slot || (slot == g.slot && number > g.number)
+}
+
+// Sign records the block to sign on disk, it must succeed before the signed block is sent out.
+// It returns ErrDoubleSign if the block must not be signed.
+func (g *SignGuard) Sign(slot, number int64) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if !g.after(slot, number) {
+		return ErrDoubleSign
+	}
+	return g.save(slot, number)
+}
+
+// Observe records the block signed by another node with the same key, such as the active node,
+// and it is ignored if not after the last record.
+func (g *SignGuard) Observe(slot, number int64) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if !g.after(slot, number) {
+		return nil
+	}
+	return g.save(slot, number)
+}
+
+func (g *SignGuard) save(slot, number int64) error {
+	b := append(common.Int64ToBytes(slot), common.Int64ToBytes(number)...)
+	tmp := g.path + ".tmp"
+	f, err := os.OpenFile(tmp, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0644)
+	if err != nil {
+		return fmt.Errorf("fail to write sign guard: %v", err)
+	}
+	if _, err := f.Write(b); err != nil {
+		f.Close()
+		return fmt.Errorf("fail to write sign guard: %v", err)
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return fmt.Errorf("fail to sync sign guard: %v", err)
+	}
+	f.Close()
+	if err := os.Rename(tmp, g.path); err != nil {
+		return fmt.Errorf("fail to write sign guard: %v", err)
+	}
+	g.slot, g.number = slot, number
+	return nil
+}
@@ -0,0 +1,42 @@
+package failover
+
+import (
+	"os"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestSignGuard(t *testing.T) {
+	Convey("Test of sign guard", t, func() {
+		path := "SignGuardTest"
+		os.Remove(path)
+		defer os.Remove(path)
+
+		g, err := NewSignGuard(path)
+		So(err, ShouldBeNil)
+		So(g.Sign(100, 10), ShouldBeNil)
+		So(g.Sign(100, 11), ShouldBeNil)
+		So(g.Sign(100, 11), ShouldEqual, ErrDoubleSign)
+		So(g.Sign(100, 9), ShouldEqual, ErrDoubleSign)
+		So(g.Sign(99, 12), ShouldEqual, ErrDoubleSign)
+		// A later slot may sign a smaller number on another fork.
+		So(g.Sign(121, 8), ShouldBeNil)
+
+		So(g.Observe(110, 20), ShouldBeNil)
+		slot, number := g.Last()
+		So(slot, ShouldEqual, 121)
+		So(number, ShouldEqual, 8)
+		So(g.Observe(130, 20), ShouldBeNil)
+
+		Convey("reload after restart", func() {
+			g, err := NewSignGuard(path)
+			So(err, ShouldBeNil)
+			slot, number := g.Last()
+			So(slot, ShouldEqual, 130)
+			So(number, ShouldEqual, 20)
+			So(g.Sign(130, 20), ShouldEqual, ErrDoubleSign)
+			So(g.Sign(130, 21), ShouldBeNil)
+		})
+	})
+}
@@ -0,0 +1,191 @@
+package failover
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/iost-official/go-iost/ilog"
+)
+
+// The modes of the failover.
+const (
+	ModeActive  = "active"
+	ModeStandby = "standby"
+)
+
+var leasePollInterval = time.Second
+
+// Lease is served by the active node to show it is alive, with its last signed block.
+type Lease struct {
+	Slot   int64 `json:"slot"`
+	Number int64 `json:"number"`
+}
+
+// Server serves the lease of the active node.
+type Server struct {
+	guard *SignGuard
+	srv   *http.Server
+	ln    net.Listener
+	done  chan struct{}
+}
+
+// NewServer returns a lease server listening on the addr.
+func NewServer(addr string, guard *SignGuard) *Server {
+	s := &Server{
+		guard: guard,
+		done:  make(chan struct{}),
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/lease", s.handleLease)
+	s.srv = &http.Server{Addr: addr, Handler: mux}
+	return s
+}
+
+func (s *Server) handleLease(rw http.ResponseWriter, r *http.Request) {
+	slot, number := s.guard.Last()
+	b, err := json.Marshal(&Lease{Slot: slot, Number: number})
+	if err != nil {
+		rw.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	rw.Header().Set("Content-Type", "application/json")
+	rw.Write(b)
+}
+
+// Start starts the lease server.
+func (s *Server) Start() error {
+	ln, err := net.Listen("tcp", s.srv.Addr)
+	if err != nil {
+		return fmt.Errorf("fail to listen lease addr: %v", err)
+	}
+	s.ln = ln
+	go func() {
+		if err := s.srv.Serve(ln); err != nil && err != http.ErrServerClosed {
+			ilog.Errorf("Lease server stopped: %v", err)
+		}
+		close(s.done)
+	}()
+	return nil
+}
+
+// Stop stops the lease server.
+func (s *Server) Stop() {
+	if s.ln == nil {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	s.srv.Shutdown(ctx)
+	<-s.done
+}
+
+// Standby polls the lease of the active node, and takes over the block production once the lease is not
+// renewed for the timeout. It gives the production back as soon as the lease is renewed again.
+// The blocks signed by the active node are recorded into the sign guard, so that the standby node never
+// signs a block conflicting with them.
+//
+// The active node and the standby node must never be alive at the same time without reaching each other,
+// otherwise both of them produce blocks, so the timeout should be far longer than a network hiccup.
+type Standby struct {
+	url     string
+	timeout time.Duration
+	guard   *SignGuard
+	client  *http.Client
+
+	mu      sync.RWMutex
+	renewed time.Time
+	active  bool
+
+	quitCh chan struct{}
+	done   chan struct{}
+}
+
+// NewStandby returns a standby polling the lease of the active node at the addr.
+func NewStandby(addr string, timeout time.Duration, guard *SignGuard) *Standby {
+	return &Standby{
+		url:     "http://" + addr + "/lease",
+		timeout: timeout,
+		guard:   guard,
+		client:  &http.Client{Timeout: leasePollInterval},
+		// The active node is assumed alive at start, the standby node waits for the timeout at least.
+		renewed: time.Now(),
+		quitCh:  make(chan struct{}),
+		done:    make(chan struct{}),
+	}
+}
+
+// Start starts polling the lease.
+func (s *Standby) Start() error {
+	go s.pollLoop()
+	return nil
+}
+
+// Stop stops polling the lease.
+func (s *Standby) Stop() {
+	close(s.quitCh)
+	<-s.done
+}
+
+// Active returns whether the standby node should produce blocks.
+func (s *Standby) Active() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.active
+}
+
+func (s *Standby) poll() error {
+	resp, err := s.client.Get(s.url)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("lease status %v", resp.Status)
+	}
+	var l Lease
+	if err := json.NewDecoder(resp.Body).Decode(&l); err != nil {
+		return err
+	}
+	// The standby node fails to sign any block without the sign guard, so the lease is renewed anyway.
+	if err := s.guard.Observe(l.Slot, l.Number); err != nil {
+		ilog.Errorf("Record the last signed block of the active node failed: %v", err)
+	}
+	return nil
+}
+
+func (s *Standby) update(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	if err == nil {
+		s.renewed = now
+		if s.active {
+			s.active = false
+			ilog.Warnf("The lease of the active node is renewed, stop producing blocks.")
+		}
+		return
+	}
+	ilog.Debugf("Poll the lease of the active node failed: %v", err)
+	if !s.active && now.Sub(s.renewed) > s.timeout {
+		s.active = true
+		ilog.Warnf("The lease of the active node expired at %v, start producing blocks.", s.renewed.Add(s.timeout))
+	}
+}
+
+func (s *Standby) pollLoop() {
+	defer close(s.done)
+	for {
+		s.update(s.poll())
+		select {
+		case <-time.After(leasePollInterval):
+		case <-s.quitCh:
+			return
+		}
+	}
+}
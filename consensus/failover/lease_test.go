@@ -0,0 +1,49 @@
+package failover
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestStandby(t *testing.T) {
+	Convey("Test of standby", t, func() {
+		os.Remove("ActiveGuard")
+		os.Remove("StandbyGuard")
+		defer os.Remove("ActiveGuard")
+		defer os.Remove("StandbyGuard")
+
+		interval := leasePollInterval
+		leasePollInterval = 20 * time.Millisecond
+		defer func() { leasePollInterval = interval }()
+
+		activeGuard, err := NewSignGuard("ActiveGuard")
+		So(err, ShouldBeNil)
+		So(activeGuard.Sign(100, 10), ShouldBeNil)
+		standbyGuard, err := NewSignGuard("StandbyGuard")
+		So(err, ShouldBeNil)
+
+		server := NewServer("127.0.0.1:30306", activeGuard)
+		So(server.Start(), ShouldBeNil)
+		standby := NewStandby("127.0.0.1:30306", 100*time.Millisecond, standbyGuard)
+		So(standby.Start(), ShouldBeNil)
+		defer standby.Stop()
+
+		time.Sleep(200 * time.Millisecond)
+		So(standby.Active(), ShouldBeFalse)
+		So(standbyGuard.Sign(100, 10), ShouldEqual, ErrDoubleSign)
+
+		server.Stop()
+		time.Sleep(300 * time.Millisecond)
+		So(standby.Active(), ShouldBeTrue)
+		So(standbyGuard.Sign(101, 11), ShouldBeNil)
+
+		server = NewServer("127.0.0.1:30306", activeGuard)
+		So(server.Start(), ShouldBeNil)
+		defer server.Stop()
+		time.Sleep(200 * time.Millisecond)
+		So(standby.Active(), ShouldBeFalse)
+	})
+}
@@ -21,15 +21,20 @@ import (
 // GenesisTxExecTime is the maximum execution time of a transaction in genesis block
 var GenesisTxExecTime = 10 * time.Second
 
-// GenGenesisByFile is create a genesis block by config file
-func GenGenesisByFile(db db.MVCCDB, path string) (*block.Block, error) {
+// LoadGenesisConfig loads the genesis config in the path
+func LoadGenesisConfig(path string) *common.GenesisConfig {
 	v := common.LoadYamlAsViper(filepath.Join(path, "genesis.yml"))
 	genesisConfig := &common.GenesisConfig{}
 	if err := v.Unmarshal(genesisConfig); err != nil {
 		ilog.Fatalf("Unable to decode into struct, %v", err)
 	}
 	genesisConfig.ContractPath = filepath.Join(path, "contract")
-	return GenGenesis(db, genesisConfig)
+	return genesisConfig
+}
+
+// GenGenesisByFile is create a genesis block by config file
+func GenGenesisByFile(db db.MVCCDB, path string) (*block.Block, error) {
+	return GenGenesis(db, LoadGenesisConfig(path))
 }
 
 func compile(id string, path string, name string) (*contract.Contract, error) {
@@ -137,6 +142,12 @@ func genGenesisTx(gConf *common.GenesisConfig) (*tx.Tx, *account.Account, error)
 	for _, v := range witnessInfo {
 		acts = append(acts, tx.NewAction("vote_producer.iost", "initProducer", fmt.Sprintf(`["%v", "%v"]`, v.ID, v.SignatureBlock)))
 	}
+	if gConf.WitnessNumber > 0 {
+		if gConf.WitnessNumber < int64(len(witnessInfo)) {
+			return nil, nil, fmt.Errorf("witness number %v is less than the genesis witnesses", gConf.WitnessNumber)
+		}
+		acts = append(acts, tx.NewAction("vote_producer.iost", "initProducerNumber", fmt.Sprintf(`[%v]`, gConf.WitnessNumber)))
+	}
 
 	// pledge gas for admin
 	gasPledgeAmount := 100
@@ -196,6 +207,7 @@ func GenGenesis(db db.MVCCDB, gConf *common.GenesisConfig) (*block.Block, error)
 		Number:     0,
 		Witness:    "0",
 		Time:       t.UnixNano(),
+		Info:       common.EncodeChainParams(gConf),
 	}
 	v := verifier.Verifier{}
 	txr, err := v.Exec(&blockHead, db, trx, GenesisTxExecTime)
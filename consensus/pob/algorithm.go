@@ -2,6 +2,7 @@ package pob
 
 import (
 	"errors"
+	"fmt"
 	"time"
 
 	"github.com/iost-official/go-iost/account"
@@ -14,6 +15,7 @@ import (
 	"github.com/iost-official/go-iost/crypto"
 	"github.com/iost-official/go-iost/db"
 	"github.com/iost-official/go-iost/ilog"
+	"github.com/iost-official/go-iost/signer"
 	"github.com/iost-official/go-iost/verifier"
 )
 
@@ -23,10 +25,19 @@ var (
 	errTxDup                  = errors.New("duplicate tx")
 	errDoubleTx               = errors.New("double tx in block")
 	errTxLenUnmatchReceiptLen = errors.New("tx len unmatch receipt len")
+	errVersion                = errors.New("wrong block version")
 )
 
+// blockVersion returns the version of the block head of the number.
+func blockVersion(number int64) int64 {
+	if common.StateRootNumber > 0 && number >= common.StateRootNumber {
+		return block.V1
+	}
+	return block.V0
+}
+
 func generateBlock(
-	acc *account.KeyPair,
+	sig signer.Signer,
 	txPool txpool.TxPool,
 	db db.MVCCDB,
 	limitTime time.Duration,
@@ -38,12 +49,12 @@ func generateBlock(
 	topBlock := head.Block
 	blk := &block.Block{
 		Head: &block.BlockHead{
-			Version:    0,
+			Version:    blockVersion(topBlock.Head.Number + 1),
 			ParentHash: topBlock.HeadHash(),
 			Info:       make([]byte, 0),
 			Number:     topBlock.Head.Number + 1,
-			Witness:    acc.ReadablePubkey(),
-			Time:       time.Now().UnixNano(),
+			Witness:    account.EncodePubkey(sig.Pubkey()),
+			Time:       common.NowNano(),
 		},
 		Txs:      []*tx.Tx{},
 		Receipts: []*tx.TxReceipt{},
@@ -70,11 +81,22 @@ func generateBlock(
 	}
 	blk.Head.TxMerkleHash = blk.CalculateTxMerkleHash()
 	blk.Head.TxReceiptMerkleHash = blk.CalculateTxReceiptMerkleHash()
+	if blk.Head.Version >= block.V1 {
+		blk.Head.StateRoot, err = cverifier.UpdateStateHash(db, topBlock, db.WriteSet())
+		if err != nil {
+			return nil, err
+		}
+	} else if err := cverifier.TrackStateHash(db, blk.Head.Number, db.WriteSet()); err != nil {
+		return nil, err
+	}
 	err = blk.CalculateHeadHash()
 	if err != nil {
 		return nil, err
 	}
-	blk.Sign = acc.Sign(blk.HeadHash())
+	blk.Sign, err = sig.SignBlock(blk.Head)
+	if err != nil {
+		return nil, fmt.Errorf("fail to sign block: %v", err)
+	}
 	db.Commit(string(blk.HeadHash()))
 	metricsGeneratedBlockCount.Add(1, nil)
 	return blk, nil
@@ -97,6 +119,9 @@ func verifyBlock(blk, parent *block.Block, witnessList *blockcache.WitnessList,
 	if err != nil {
 		return err
 	}
+	if blk.Head.Version != blockVersion(blk.Head.Number) {
+		return errVersion
+	}
 
 	if replay == false && witnessOfNanoSec(blk.Head.Time, witnessList.Active()) != blk.Head.Witness {
 		ilog.Errorf("blk num: %v, time: %v, witness: %v, witness len: %v, witness list: %v",
@@ -129,9 +154,29 @@ func verifyBlock(blk, parent *block.Block, witnessList *blockcache.WitnessList,
 		}
 	}
 	v := verifier.Verifier{}
-	return v.Verify(blk, parent, witnessList, db, &verifier.Config{
+	err = v.Verify(blk, parent, witnessList, db, &verifier.Config{
 		Mode:        0,
 		Timeout:     genBlockTime,
 		TxTimeLimit: common.MaxTxTimeLimit,
 	})
+	if err != nil {
+		return err
+	}
+	if blk.Head.Version < block.V1 {
+		return cverifier.TrackStateHash(db, blk.Head.Number, db.WriteSet())
+	}
+	writeSet := db.WriteSet()
+	stateRoot, err := cverifier.UpdateStateHash(db, parent, writeSet)
+	if err != nil {
+		return err
+	}
+	if err := cverifier.VerifyStateRoot(blk, stateRoot); err != nil {
+		ilog.Errorf("State root of block %v mismatch, local: %v, block: %v. Use tools/statediff to locate the divergent keys.",
+			blk.Head.Number, common.Base58Encode(stateRoot), common.Base58Encode(blk.Head.StateRoot))
+		for _, item := range writeSet {
+			ilog.Debugf("write set of block %v: %v/%v deleted: %v, value: %v", blk.Head.Number, item.Table(), item.Key(), item.Deleted(), item.Value())
+		}
+		return err
+	}
+	return nil
 }
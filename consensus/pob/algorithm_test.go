@@ -16,6 +16,7 @@ import (
 	txpool_mock "github.com/iost-official/go-iost/core/txpool/mock"
 	"github.com/iost-official/go-iost/crypto"
 	"github.com/iost-official/go-iost/db"
+	"github.com/iost-official/go-iost/signer"
 	"github.com/iost-official/go-iost/verifier"
 	"github.com/iost-official/go-iost/vm/database"
 	"github.com/iost-official/go-iost/vm/native"
@@ -76,7 +77,7 @@ func BenchmarkGenerateBlock(b *testing.B) { // 296275 = 0.3ms(0tx), 466353591 =
 	b.ResetTimer()
 	pTx, head := mockTxPool.PendingTx()
 	for j := 0; j < b.N; j++ {
-		generateBlock(account, mockTxPool, stateDB, time.Millisecond*1000, pTx, head)
+		generateBlock(signer.NewLocal(account), mockTxPool, stateDB, time.Millisecond*1000, pTx, head)
 	}
 	b.StopTimer()
 }
@@ -114,7 +115,7 @@ func BenchmarkVerifyBlockWithVM(b *testing.B) { // 296275 = 0.3ms(0tx), 46635359
 	mockTxPool.EXPECT().DelTxList(gomock.Any()).AnyTimes()
 
 	pTx, head := mockTxPool.PendingTx()
-	blk, _ := generateBlock(account, mockTxPool, stateDB, time.Millisecond*1000, pTx, head)
+	blk, _ := generateBlock(signer.NewLocal(account), mockTxPool, stateDB, time.Millisecond*1000, pTx, head)
 
 	b.ResetTimer()
 	for j := 0; j < b.N; j++ {
@@ -0,0 +1,233 @@
+package pob
+
+import (
+	"bytes"
+	"sync"
+
+	"github.com/iost-official/go-iost/account"
+	"github.com/iost-official/go-iost/common"
+	"github.com/iost-official/go-iost/core/block"
+	"github.com/iost-official/go-iost/core/blockcache"
+	"github.com/iost-official/go-iost/ilog"
+	"github.com/iost-official/go-iost/metrics"
+	"github.com/iost-official/go-iost/p2p"
+	"github.com/iost-official/go-iost/signer"
+)
+
+var (
+	maxPendingAttestation int64 = 64
+	metricsAttestedLength       = metrics.NewGauge("iost_pob_attested_length", nil)
+)
+
+type attestationTarget struct {
+	number    int64
+	witnesses []string
+	votes     map[string]*block.AttestationVote
+	done      bool
+}
+
+// attestor collects the votes of the witnesses for the irreversible blocks,
+// and saves the attestation once the votes of more than 2/3 of the witnesses are collected.
+// Only the votes for the linked blocks known by the node are kept and relayed, so that
+// the votes stored for a block are bounded by its witness list.
+//
+// The witnesses with a BLS key also sign their votes by it, and the attestation aggregates the BLS signatures
+// once enough of them are collected. The BLS keys of the witnesses are verified once and saved by the chain.
+type attestor struct {
+	signer     signer.Signer
+	pubkey     string
+	blsSeckey  []byte
+	key        *block.WitnessKey
+	blockCache blockcache.BlockCache
+	blockChain block.Chain
+	p2pService p2p.Service
+	mu         sync.Mutex
+	lib        int64
+	targets    map[string]*attestationTarget
+	keys       map[string][]byte
+}
+
+func newAttestor(sig signer.Signer, blsSeckey []byte, blockCache blockcache.BlockCache, blockChain block.Chain, p2pService p2p.Service) *attestor {
+	a := &attestor{
+		signer:     sig,
+		pubkey:     account.EncodePubkey(sig.Pubkey()),
+		blockCache: blockCache,
+		blockChain: blockChain,
+		p2pService: p2pService,
+		targets:    make(map[string]*attestationTarget),
+		keys:       make(map[string][]byte),
+	}
+	if blsSeckey != nil {
+		key, err := block.NewWitnessKey(blsSeckey, sig.SignWitnessKey)
+		if err != nil {
+			ilog.Errorf("fail to sign witness key, the votes are not signed by BLS, err:%v", err)
+			return a
+		}
+		a.blsSeckey = blsSeckey
+		a.key = key
+		a.putKey(key)
+	}
+	return a
+}
+
+// verifyKey verifies the BLS key of the witness unless it is known already.
+func (a *attestor) verifyKey(k *block.WitnessKey) bool {
+	w := k.Witness()
+	if pubkey, ok := a.keys[w]; ok && bytes.Equal(pubkey, k.Pubkey) {
+		return true
+	}
+	if saved, err := a.blockChain.GetWitnessKey(w); err == nil && bytes.Equal(saved.Pubkey, k.Pubkey) {
+		a.keys[w] = k.Pubkey
+		return true
+	}
+	if err := k.Verify(); err != nil {
+		ilog.Debugf("wrong witness key of %v, err:%v", w, err)
+		return false
+	}
+	a.putKey(k)
+	return true
+}
+
+func (a *attestor) putKey(k *block.WitnessKey) {
+	a.keys[k.Witness()] = k.Pubkey
+	if err := a.blockChain.PutWitnessKey(k); err != nil {
+		ilog.Errorf("fail to put witness key, err:%v", err)
+	}
+}
+
+func (a *attestor) target(number int64, hash []byte) *attestationTarget {
+	t, ok := a.targets[string(hash)]
+	if !ok {
+		t = &attestationTarget{
+			number: number,
+			votes:  make(map[string]*block.AttestationVote),
+		}
+		a.targets[string(hash)] = t
+	}
+	return t
+}
+
+// onLIB is called when the irreversible block changes, it votes for the block if the node is a witness.
+func (a *attestor) onLIB(node *blockcache.BlockCacheNode) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	hash := node.HeadHash()
+	a.lib = node.Head.Number
+	a.prune()
+	t := a.target(node.Head.Number, hash)
+	t.witnesses = node.Active()
+	if isWitness(a.pubkey, t.witnesses) {
+		sig, err := a.signer.SignAttestation(node.Head.Number, hash)
+		if err != nil {
+			ilog.Errorf("fail to sign attestation vote, err:%v", err)
+		} else {
+			v := &block.AttestationVote{Number: node.Head.Number, BlockHash: hash, Sign: sig}
+			if a.key != nil {
+				if err := v.SignBLS(a.blsSeckey, a.key); err != nil {
+					ilog.Errorf("fail to sign attestation vote by BLS, err:%v", err)
+				}
+			}
+			t.votes[v.Witness()] = v
+			a.broadcast(v)
+		}
+	}
+	a.tryAttest(hash, t)
+}
+
+// onVote is called when a vote is received from other witnesses.
+func (a *attestor) onVote(v *block.AttestationVote) {
+	if !v.Verify() {
+		ilog.Debugf("wrong attestation vote of block %v", v.Number)
+		return
+	}
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if v.Number+maxPendingAttestation <= a.lib || v.Number > a.lib+maxPendingAttestation {
+		return
+	}
+	if v.Key != nil && !a.verifyKey(v.Key) {
+		return
+	}
+	witnesses := a.witnessesOf(v)
+	if witnesses == nil {
+		ilog.Debugf("attestation vote of unknown block %v", v.Number)
+		return
+	}
+	w := v.Witness()
+	if !isWitness(w, witnesses) {
+		return
+	}
+	t := a.target(v.Number, v.BlockHash)
+	if _, ok := t.votes[w]; ok || t.done {
+		return
+	}
+	t.witnesses = witnesses
+	t.votes[w] = v
+	a.broadcast(v)
+	a.tryAttest(v.BlockHash, t)
+}
+
+// witnessesOf returns the witness list of the voted block, or nil if the block is unknown.
+func (a *attestor) witnessesOf(v *block.AttestationVote) []string {
+	if t, ok := a.targets[string(v.BlockHash)]; ok && t.witnesses != nil {
+		return t.witnesses
+	}
+	node, err := a.blockCache.Find(v.BlockHash)
+	if err != nil || node.Type != blockcache.Linked || node.Head.Number != v.Number {
+		return nil
+	}
+	return node.Active()
+}
+
+func (a *attestor) tryAttest(hash []byte, t *attestationTarget) {
+	if t.done || t.witnesses == nil {
+		return
+	}
+	att := block.NewAggregateAttestation(t.number, hash, t.witnesses, a.keyedVotes(t))
+	if att == nil {
+		att = block.NewAttestation(t.number, hash, t.witnesses, t.votes)
+	}
+	if att == nil {
+		return
+	}
+	t.done = true
+	t.votes = nil
+	err := a.blockChain.PutAttestation(att)
+	if err != nil {
+		ilog.Errorf("fail to put attestation of block %v, err:%v", t.number, err)
+		return
+	}
+	metricsAttestedLength.Set(float64(t.number), nil)
+	ilog.Debugf("attested block %v, hash:%v", t.number, common.Base58Encode(hash))
+}
+
+// keyedVotes returns the votes signed by the current BLS keys of their witnesses, which are saved by the chain
+// to verify the aggregate attestations.
+func (a *attestor) keyedVotes(t *attestationTarget) map[string]*block.AttestationVote {
+	votes := make(map[string]*block.AttestationVote)
+	for w, v := range t.votes {
+		if v.Key != nil && bytes.Equal(a.keys[w], v.Key.Pubkey) {
+			votes[w] = v
+		}
+	}
+	return votes
+}
+
+func (a *attestor) broadcast(v *block.AttestationVote) {
+	b, err := v.Encode()
+	if err != nil {
+		ilog.Errorf("fail to encode attestation vote, err:%v", err)
+		return
+	}
+	a.p2pService.Broadcast(b, p2p.LIBVote, p2p.NormalMessage)
+}
+
+func (a *attestor) prune() {
+	for hash, t := range a.targets {
+		if t.number+maxPendingAttestation <= a.lib {
+			delete(a.targets, hash)
+		}
+	}
+}
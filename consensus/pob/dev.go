@@ -0,0 +1,43 @@
+package pob
+
+import (
+	"time"
+
+	"github.com/iost-official/go-iost/common"
+	"github.com/iost-official/go-iost/core/global"
+)
+
+// devPollInterval is how often the dev mode checks whether to produce a block.
+var devPollInterval = 50 * time.Millisecond
+
+// devLoop produces the blocks of the dev mode instead of scheduleLoop. A block is produced as soon as new txs are pending
+// or the chain time is advanced, and at the block interval if set.
+func (p *PoB) devLoop() {
+	defer p.wg.Done()
+	last := time.Now()
+	offset := common.TimeOffset()
+	// The txs still pending after a block are not packable yet, such as the ones from the future,
+	// so they do not make the next block until new txs come.
+	var tried int
+	for {
+		select {
+		case <-time.After(devPollInterval):
+			if p.baseVariable.Mode() != global.ModeNormal {
+				continue
+			}
+			pTx, head := p.txPool.PendingTx()
+			newTx := pTx.Size() > 0 && pTx.Size() != tried
+			timeout := p.devInterval > 0 && time.Since(last) >= p.devInterval
+			if !newTx && !timeout && common.TimeOffset() == offset {
+				continue
+			}
+			offset = common.TimeOffset()
+			last = time.Now()
+			p.gen(0, pTx, head)
+			pTx, _ = p.txPool.PendingTx()
+			tried = pTx.Size()
+		case <-p.exitSignal:
+			return
+		}
+	}
+}
@@ -0,0 +1,158 @@
+package pob
+
+import (
+	"sync"
+	"time"
+
+	"github.com/iost-official/go-iost/account"
+	"github.com/iost-official/go-iost/common"
+	"github.com/iost-official/go-iost/core/block"
+	"github.com/iost-official/go-iost/core/tx"
+	"github.com/iost-official/go-iost/core/txpool"
+	"github.com/iost-official/go-iost/ilog"
+	"github.com/iost-official/go-iost/metrics"
+	"github.com/iost-official/go-iost/p2p"
+	"github.com/iost-official/go-iost/signer"
+)
+
+var (
+	evidenceTxGasLimit   int64 = 100000000
+	evidenceTxExpiration       = 90 * time.Second
+	metricsDoubleSign          = metrics.NewCounter("iost_pob_double_sign", nil)
+)
+
+type signKey struct {
+	witness string
+	slot    int64
+	number  int64
+}
+
+func signKeyOf(head *block.BlockHead) signKey {
+	return signKey{
+		witness: head.Witness,
+		slot:    block.SlotOfHead(head),
+		number:  head.Number,
+	}
+}
+
+// doubleSignDetector remembers the first signed block of every witness, slot and number above the irreversible block.
+// Once another block of the same key is received, the evidence is broadcast, and submitted to vote_producer.iost
+// if the node is a witness with an account.
+type doubleSignDetector struct {
+	signer     signer.Signer
+	pubkey     string
+	accountID  string
+	txPool     txpool.TxPool
+	p2pService p2p.Service
+	mu         sync.Mutex
+	lib        int64
+	signed     map[signKey]*block.Block
+	reported   map[signKey]bool
+}
+
+func newDoubleSignDetector(sig signer.Signer, accountID string, txPool txpool.TxPool, p2pService p2p.Service) *doubleSignDetector {
+	return &doubleSignDetector{
+		signer:     sig,
+		pubkey:     account.EncodePubkey(sig.Pubkey()),
+		accountID:  accountID,
+		txPool:     txPool,
+		p2pService: p2pService,
+		signed:     make(map[signKey]*block.Block),
+		reported:   make(map[signKey]bool),
+	}
+}
+
+// onBlock is called with every block whose signature is verified, witnesses is the active witness list of the head.
+func (d *doubleSignDetector) onBlock(blk *block.Block, lib int64, witnesses []string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if lib > d.lib {
+		d.lib = lib
+		d.prune()
+	}
+	if blk.Head.Number <= d.lib {
+		return
+	}
+	key := signKeyOf(blk.Head)
+	first, ok := d.signed[key]
+	if !ok {
+		d.signed[key] = blk
+		return
+	}
+	if string(first.HeadHash()) == string(blk.HeadHash()) || d.reported[key] {
+		return
+	}
+	e := block.NewDoubleSignEvidence(first, blk)
+	if err := e.Verify(); err != nil {
+		ilog.Errorf("fail to make double sign evidence of block %v, err:%v", blk.Head.Number, err)
+		return
+	}
+	ilog.Warnf("witness %v signed two blocks of number %v in slot %v, hash:%v and %v",
+		key.witness, key.number, key.slot, common.Base58Encode(first.HeadHash()), common.Base58Encode(blk.HeadHash()))
+	d.report(key, e, witnesses)
+}
+
+// onEvidence is called when an evidence is received from other nodes.
+func (d *doubleSignDetector) onEvidence(e *block.DoubleSignEvidence, witnesses []string) {
+	if err := e.Verify(); err != nil {
+		ilog.Debugf("wrong double sign evidence of block %v, err:%v", e.Number(), err)
+		return
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if e.Number() <= d.lib {
+		return
+	}
+	key := signKeyOf(e.Head1)
+	if d.reported[key] {
+		return
+	}
+	ilog.Warnf("received double sign evidence of witness %v, number:%v", key.witness, key.number)
+	d.report(key, e, witnesses)
+}
+
+func (d *doubleSignDetector) report(key signKey, e *block.DoubleSignEvidence, witnesses []string) {
+	d.reported[key] = true
+	metricsDoubleSign.Add(1, nil)
+	b, err := e.Encode()
+	if err != nil {
+		ilog.Errorf("fail to encode double sign evidence, err:%v", err)
+		return
+	}
+	d.p2pService.Broadcast(b, p2p.DoubleSignEvidence, p2p.UrgentMessage)
+	if d.accountID != "" && isWitness(d.pubkey, witnesses) {
+		go d.submit(b)
+	}
+}
+
+// submit publishes the tx which calls vote_producer.iost to punish the witness.
+func (d *doubleSignDetector) submit(evidence []byte) {
+	data := `["` + common.Base58Encode(evidence) + `"]`
+	actions := []*tx.Action{tx.NewAction("vote_producer.iost", "punishDoubleSign", data)}
+	t := tx.NewTx(actions, nil, evidenceTxGasLimit, 100, time.Now().Add(evidenceTxExpiration).UnixNano(), 0, tx.ChainID)
+	t, err := tx.SignTxBySigner(t, d.accountID, d.signer)
+	if err != nil {
+		ilog.Errorf("fail to sign double sign evidence tx, err:%v", err)
+		return
+	}
+	if err := d.txPool.AddTx(t); err != nil {
+		ilog.Errorf("fail to add double sign evidence tx, err:%v", err)
+		return
+	}
+	ilog.Infof("submitted double sign evidence tx %v", common.Base58Encode(t.Hash()))
+}
+
+func (d *doubleSignDetector) prune() {
+	for key := range d.signed {
+		if key.number <= d.lib {
+			delete(d.signed, key)
+		}
+	}
+	for key := range d.reported {
+		if key.number <= d.lib {
+			delete(d.reported, key)
+		}
+	}
+}
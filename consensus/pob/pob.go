@@ -8,15 +8,19 @@ import (
 	"github.com/golang/protobuf/proto"
 	"github.com/iost-official/go-iost/account"
 	"github.com/iost-official/go-iost/common"
+	"github.com/iost-official/go-iost/consensus/failover"
+	"github.com/iost-official/go-iost/consensus/producerstats"
 	msgpb "github.com/iost-official/go-iost/consensus/synchronizer/pb"
 	"github.com/iost-official/go-iost/core/block"
 	"github.com/iost-official/go-iost/core/blockcache"
 	"github.com/iost-official/go-iost/core/global"
 	"github.com/iost-official/go-iost/core/txpool"
+	"github.com/iost-official/go-iost/crypto/bls"
 	"github.com/iost-official/go-iost/db"
 	"github.com/iost-official/go-iost/ilog"
 	"github.com/iost-official/go-iost/metrics"
 	"github.com/iost-official/go-iost/p2p"
+	"github.com/iost-official/go-iost/signer"
 )
 
 var (
@@ -52,7 +56,8 @@ type verifyBlockMessage struct {
 
 //PoB is a struct that handles the consensus logic.
 type PoB struct {
-	account          *account.KeyPair
+	signer           signer.Signer
+	pubkey           string
 	baseVariable     global.BaseVariable
 	blockChain       block.Chain
 	blockCache       blockcache.BlockCache
@@ -67,16 +72,30 @@ type PoB struct {
 	chRecvBlockHash  chan p2p.IncomingMessage
 	chQueryBlock     chan p2p.IncomingMessage
 	chVerifyBlock    chan *verifyBlockMessage
+	chRecvLIBVote    chan p2p.IncomingMessage
+	chRecvEvidence   chan p2p.IncomingMessage
+	attestor         *attestor
+	detector         *doubleSignDetector
+	stats            *producerstats.Tracker
+	guard            *failover.SignGuard
+	lease            *failover.Server
+	standby          *failover.Standby
+	leaseTimeout     time.Duration
+	produceAfter     time.Time
+	dev              bool
+	devInterval      time.Duration
 	wg               *sync.WaitGroup
 	mu               *sync.RWMutex
 	headNumber       int64
 	recvTimesMap     map[string]int64
+	verifyHooks      []func(*block.Block, error)
 }
 
 // New init a new PoB.
-func New(account *account.KeyPair, baseVariable global.BaseVariable, blockCache blockcache.BlockCache, txPool txpool.TxPool, p2pService p2p.Service) *PoB {
+func New(sig signer.Signer, baseVariable global.BaseVariable, blockCache blockcache.BlockCache, txPool txpool.TxPool, p2pService p2p.Service, stats *producerstats.Tracker) *PoB {
 	p := PoB{
-		account:          account,
+		signer:           sig,
+		pubkey:           account.EncodePubkey(sig.Pubkey()),
 		baseVariable:     baseVariable,
 		blockChain:       baseVariable.BlockChain(),
 		blockCache:       blockCache,
@@ -90,13 +109,59 @@ func New(account *account.KeyPair, baseVariable global.BaseVariable, blockCache
 		chRecvBlock:      p2pService.Register("consensus channel", p2p.NewBlock, p2p.SyncBlockResponse),
 		chRecvBlockHash:  p2pService.Register("consensus block head", p2p.NewBlockHash),
 		chQueryBlock:     p2pService.Register("consensus query block", p2p.NewBlockRequest),
+		chRecvEvidence:   p2pService.Register("consensus double sign evidence", p2p.DoubleSignEvidence),
 		chVerifyBlock:    make(chan *verifyBlockMessage, 1024),
 		wg:               new(sync.WaitGroup),
 		mu:               new(sync.RWMutex),
 		headNumber:       0,
 		recvTimesMap:     make(map[string]int64, 0),
+		stats:            stats,
 	}
 	continuousNum = baseVariable.Continuous()
+	if continuousNum > 0 {
+		// The slot is divided evenly among its blocks, it is 500ms for a block with the default 3s slot and 6 blocks.
+		subSlotTime = time.Duration(common.SlotLength) * time.Second / time.Duration(continuousNum)
+		genBlockTime = subSlotTime * 4 / 5
+		last2GenBlockTime = subSlotTime / 10
+	}
+	var accountID string
+	if acc := baseVariable.Config().ACC; acc != nil {
+		accountID = acc.ID
+	}
+	p.detector = newDoubleSignDetector(sig, accountID, txPool, p2pService)
+	if conf := baseVariable.Config().DB; conf != nil {
+		guard, err := failover.NewSignGuard(conf.LdbPath + "SignGuard")
+		if err != nil {
+			ilog.Fatalf("Load sign guard failed: %v", err)
+		}
+		p.guard = guard
+	}
+	if conf := baseVariable.Config().Failover; conf != nil && p.guard != nil {
+		p.leaseTimeout = time.Duration(conf.LeaseTimeout) * time.Second
+		switch conf.Mode {
+		case failover.ModeActive:
+			p.lease = failover.NewServer(conf.LeaseAddr, p.guard)
+		case failover.ModeStandby:
+			p.standby = failover.NewStandby(conf.LeaseAddr, p.leaseTimeout, p.guard)
+		}
+	}
+	if conf := baseVariable.Config().Dev; conf != nil {
+		p.dev = true
+		p.devInterval = time.Duration(conf.BlockInterval) * time.Millisecond
+	}
+	if conf := baseVariable.Config().Consensus; conf != nil {
+		if conf.Attestation {
+			var blsSeckey []byte
+			if acc := baseVariable.Config().ACC; acc != nil && acc.BLSSecKey != "" {
+				blsSeckey = common.Base58Decode(acc.BLSSecKey)
+				if err := bls.CheckSeckey(blsSeckey); err != nil {
+					ilog.Fatalf("Invalid BLS seckey: %v", err)
+				}
+			}
+			p.attestor = newAttestor(sig, blsSeckey, p.blockCache, p.blockChain, p2pService)
+			p.chRecvLIBVote = p2pService.Register("consensus lib vote", p2p.LIBVote)
+		}
+	}
 
 	p.recoverBlockcache()
 	close(p.quitGenerateMode)
@@ -120,11 +185,25 @@ func (p *PoB) recoverBlockcache() error {
 
 //Start make the PoB run.
 func (p *PoB) Start() error {
+	if p.lease != nil {
+		if err := p.lease.Start(); err != nil {
+			return err
+		}
+		// The standby node may be producing, it stops once it sees the lease.
+		p.produceAfter = time.Now().Add(p.leaseTimeout)
+	}
+	if p.standby != nil {
+		p.standby.Start()
+	}
 	p.wg.Add(4)
 	go p.messageLoop()
 	go p.blockLoop()
 	go p.verifyLoop()
-	go p.scheduleLoop()
+	if p.dev {
+		go p.devLoop()
+	} else {
+		go p.scheduleLoop()
+	}
 	return nil
 }
 
@@ -132,6 +211,21 @@ func (p *PoB) Start() error {
 func (p *PoB) Stop() {
 	close(p.exitSignal)
 	p.wg.Wait()
+	if p.lease != nil {
+		p.lease.Stop()
+	}
+	if p.standby != nil {
+		p.standby.Stop()
+	}
+}
+
+// canProduce returns whether the node produces blocks in its slots, which is false for the standby node
+// holding no lease, and for the active node waiting for the standby node to stop.
+func (p *PoB) canProduce() bool {
+	if p.standby != nil {
+		return p.standby.Active()
+	}
+	return time.Now().After(p.produceAfter)
 }
 
 func (p *PoB) messageLoop() {
@@ -170,6 +264,32 @@ func (p *PoB) messageLoop() {
 				}
 				p.handleBlockQuery(&rh, incomingMessage.From())
 			}
+		case incomingMessage, ok := <-p.chRecvLIBVote:
+			if !ok {
+				ilog.Infof("chRecvLIBVote has closed")
+				return
+			}
+			if p.baseVariable.Mode() == global.ModeNormal {
+				var v block.AttestationVote
+				err := v.Decode(incomingMessage.Data())
+				if err != nil {
+					continue
+				}
+				p.attestor.onVote(&v)
+			}
+		case incomingMessage, ok := <-p.chRecvEvidence:
+			if !ok {
+				ilog.Infof("chRecvEvidence has closed")
+				return
+			}
+			if p.baseVariable.Mode() == global.ModeNormal {
+				var e block.DoubleSignEvidence
+				err := e.Decode(incomingMessage.Data())
+				if err != nil {
+					continue
+				}
+				p.detector.onEvidence(&e, p.blockCache.Head().Active())
+			}
 		case <-p.exitSignal:
 			return
 		}
@@ -248,6 +368,9 @@ func (p *PoB) doVerifyBlock(vbm *verifyBlockMessage) {
 	case p2p.NewBlock:
 		t1 := calculateTime(blk)
 		metricsTransferCost.Set(t1, nil)
+		if p.stats != nil {
+			p.stats.OnReceive(blk, time.Duration(t1)*time.Millisecond)
+		}
 		timer, ok := p.blockReqMap.Load(string(blk.HeadHash()))
 		if ok {
 			t, ok := timer.(*time.Timer)
@@ -346,7 +469,7 @@ func (p *PoB) scheduleLoop() {
 	defer p.wg.Done()
 	nextSchedule := timeUntilNextSchedule(time.Now().UnixNano())
 	ilog.Debugf("nextSchedule: %.2f", time.Duration(nextSchedule).Seconds())
-	pubkey := p.account.ReadablePubkey()
+	pubkey := p.pubkey
 
 	var slotFlag int64
 	for {
@@ -357,7 +480,7 @@ func (p *PoB) scheduleLoop() {
 			t := time.Now()
 			pTx, head := p.txPool.PendingTx()
 			witnessList := head.Active()
-			if slotFlag != slotOfSec(t.Unix()) && p.baseVariable.Mode() == global.ModeNormal && witnessOfNanoSec(t.UnixNano(), witnessList) == pubkey {
+			if slotFlag != slotOfSec(t.Unix()) && p.baseVariable.Mode() == global.ModeNormal && witnessOfNanoSec(t.UnixNano(), witnessList) == pubkey && p.canProduce() {
 				p.quitGenerateMode = make(chan struct{})
 				slotFlag = slotOfSec(t.Unix())
 				generateBlockTicker := time.NewTicker(subSlotTime)
@@ -371,7 +494,7 @@ func (p *PoB) scheduleLoop() {
 					}
 					pTx, head = p.txPool.PendingTx()
 					witnessList = head.Active()
-					if witnessOfNanoSec(time.Now().UnixNano(), witnessList) != pubkey {
+					if witnessOfNanoSec(time.Now().UnixNano(), witnessList) != pubkey || !p.canProduce() {
 						break
 					}
 				}
@@ -392,12 +515,18 @@ func (p *PoB) gen(num int, pTx *txpool.SortedTxMap, head *blockcache.BlockCacheN
 		limitTime = last2GenBlockTime
 	}
 	p.txPool.Lock()
-	blk, err := generateBlock(p.account, p.txPool, p.produceDB, limitTime, pTx, head)
+	blk, err := generateBlock(p.signer, p.txPool, p.produceDB, limitTime, pTx, head)
 	p.txPool.Release()
 	if err != nil {
 		ilog.Error(err)
 		return
 	}
+	if p.guard != nil {
+		if err := p.guard.Sign(block.SlotOfHead(blk.Head), blk.Head.Number); err != nil {
+			ilog.Errorf("[pob] drop block %v, err:%v", blk.Head.Number, err)
+			return
+		}
+	}
 	p.printStatistics(num, blk)
 	blkByte, err := blk.Encode()
 	if err != nil {
@@ -417,7 +546,7 @@ func (p *PoB) printStatistics(num int, blk *block.Block) {
 	ptx, _ := p.txPool.PendingTx()
 	ilog.Infof("Gen block - @%v id:%v..., t:%v, num:%v, confirmed:%v, txs:%v, pendingtxs:%v, et:%vms",
 		num,
-		p.account.ReadablePubkey()[:10],
+		p.pubkey[:10],
 		blk.Head.Time,
 		blk.Head.Number,
 		p.blockCache.LinkedRoot().Head.Number,
@@ -445,6 +574,18 @@ func (p *PoB) RecoverBlock(blk *block.Block) error {
 	return errSingle
 }
 
+// AddVerifyHook adds a hook called with the received block and the result of its verification.
+// It should be called before Start.
+func (p *PoB) AddVerifyHook(hook func(*block.Block, error)) {
+	p.verifyHooks = append(p.verifyHooks, hook)
+}
+
+func (p *PoB) onVerify(blk *block.Block, err error) {
+	for _, hook := range p.verifyHooks {
+		hook(blk, err)
+	}
+}
+
 func (p *PoB) handleRecvBlock(blk *block.Block) error {
 	p.mu.Lock()
 	defer p.mu.Unlock()
@@ -456,8 +597,10 @@ func (p *PoB) handleRecvBlock(blk *block.Block) error {
 
 	err = verifyBasics(blk, blk.Sign)
 	if err != nil {
+		p.onVerify(blk, err)
 		return err
 	}
+	p.detector.onBlock(blk, p.blockCache.LinkedRoot().Head.Number, p.blockCache.Head().Active())
 
 	parent, err := p.blockCache.Find(blk.Head.ParentHash)
 	p.blockCache.Add(blk)
@@ -477,7 +620,9 @@ func (p *PoB) addExistingBlock(blk *block.Block, parentNode *blockcache.BlockCac
 		node.SerialNum = parentNode.SerialNum + 1
 	}
 
-	if node.SerialNum >= int64(p.baseVariable.Continuous()) {
+	// The dev mode produces blocks on demand regardless of the slots.
+	if !p.dev && node.SerialNum >= int64(p.baseVariable.Continuous()) {
+		p.onVerify(blk, errOutOfLimit)
 		return errOutOfLimit
 	}
 	ok := p.verifyDB.Checkout(string(blk.HeadHash()))
@@ -489,25 +634,31 @@ func (p *PoB) addExistingBlock(blk *block.Block, parentNode *blockcache.BlockCac
 		if err != nil {
 			ilog.Errorf("verify block failed, blockNum:%v, blockHash:%v. err=%v", blk.Head.Number, common.Base58Encode(blk.HeadHash()), err)
 			p.blockCache.Del(node)
+			p.onVerify(blk, err)
 			return err
 		}
 		p.verifyDB.Commit(string(blk.HeadHash()))
 	}
+	p.onVerify(blk, nil)
 	p.blockCache.Link(node, replay)
+	lib := p.blockCache.LinkedRoot()
 	p.blockCache.UpdateLib(node)
+	if p.attestor != nil && !replay && p.blockCache.LinkedRoot() != lib {
+		p.attestor.onLIB(p.blockCache.LinkedRoot())
+	}
 	// After UpdateLib, the block head active witness list will be right
 	// So AddLinkedNode need execute after UpdateLib
 	p.txPool.AddLinkedNode(node)
 
 	metricsConfirmedLength.Set(float64(p.blockCache.LinkedRoot().Head.Number), nil)
 
-	if isWitness(p.account.ReadablePubkey(), p.blockCache.Head().Active()) {
+	if isWitness(p.pubkey, p.blockCache.Head().Active()) {
 		p.p2pService.ConnectBPs(p.blockCache.Head().NetID())
 	} else {
 		p.p2pService.ConnectBPs(nil)
 	}
 
-	if node.Head.Witness != p.account.ReadablePubkey() {
+	if node.Head.Witness != p.pubkey {
 		ilog.Infof("Rec block - @%v id:%v..., num:%v, t:%v, txs:%v, confirmed:%v, et:%vms",
 			node.SerialNum, node.Head.Witness[:10], node.Head.Number, node.Head.Time, len(node.Txs), p.blockCache.LinkedRoot().Head.Number, calculateTime(node.Block))
 	}
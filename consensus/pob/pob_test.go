@@ -19,6 +19,7 @@ import (
 	"github.com/iost-official/go-iost/ilog"
 	"github.com/iost-official/go-iost/p2p"
 	"github.com/iost-official/go-iost/p2p/mocks"
+	"github.com/iost-official/go-iost/signer"
 )
 
 func testRun(t *testing.T) {
@@ -56,7 +57,7 @@ func testRun(t *testing.T) {
 	channel := make(chan p2p.IncomingMessage, 1024)
 	mockP2PService.EXPECT().Register(gomock.Any(), gomock.Any()).Return(channel).AnyTimes()
 	txPool, _ := txpool.NewTxPoolImpl(baseVariable, blockCache, mockP2PService) //mock
-	pob := New(account1, baseVariable, blockCache, txPool, mockP2PService)
+	pob := New(signer.NewLocal(account1), baseVariable, blockCache, txPool, mockP2PService, nil)
 	pob.Start()
 	fmt.Println(time.Now().Second())
 	fmt.Println(time.Now().Nanosecond())
@@ -0,0 +1,248 @@
+// Package producerstats keeps the reliability statistics of the block producers.
+package producerstats
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/iost-official/go-iost/common"
+	"github.com/iost-official/go-iost/core/block"
+	"github.com/iost-official/go-iost/core/blockcache"
+	"github.com/iost-official/go-iost/db/kv"
+	"github.com/iost-official/go-iost/ilog"
+	"github.com/iost-official/go-iost/metrics"
+)
+
+var (
+	metricsProducedBlock = metrics.NewCounter("iost_producer_produced_block", []string{"witness"})
+	metricsMissedSlot    = metrics.NewCounter("iost_producer_missed_slot", []string{"witness"})
+	metricsBlockLatency  = metrics.NewGauge("iost_producer_block_latency", []string{"witness"})
+)
+
+// Stats is the reliability statistics of a producer, counted on the irreversible blocks.
+type Stats struct {
+	Witness string
+	// Produced is the number of the irreversible blocks produced.
+	Produced int64
+	// Missed is the number of the slots of the witness without any irreversible block.
+	Missed int64
+	// LastProduced is the number of the last irreversible block produced.
+	LastProduced int64
+	// LastMissedSlot is the last slot missed.
+	LastMissedSlot int64
+	// LatencySum and LatencyCount are the sum in milliseconds and the count of the delays
+	// between the time of the blocks and the time they are received by this node.
+	LatencySum   int64
+	LatencyCount int64
+}
+
+// AvgLatency returns the average delay in milliseconds of the blocks received.
+func (s *Stats) AvgLatency() int64 {
+	if s.LatencyCount == 0 {
+		return 0
+	}
+	return s.LatencySum / s.LatencyCount
+}
+
+// Encode will encode the stats.
+func (s *Stats) Encode() []byte {
+	se := common.NewSimpleEncoder()
+	se.WriteString(s.Witness)
+	se.WriteInt64(s.Produced)
+	se.WriteInt64(s.Missed)
+	se.WriteInt64(s.LastProduced)
+	se.WriteInt64(s.LastMissedSlot)
+	se.WriteInt64(s.LatencySum)
+	se.WriteInt64(s.LatencyCount)
+	return se.Bytes()
+}
+
+// Decode will decode the stats.
+func (s *Stats) Decode(b []byte) error {
+	sd := common.NewSimpleDecoder(b)
+	witness, err := sd.ParseBytes()
+	if err != nil {
+		return err
+	}
+	s.Witness = string(witness)
+	fields := []*int64{&s.Produced, &s.Missed, &s.LastProduced, &s.LastMissedSlot, &s.LatencySum, &s.LatencyCount}
+	for _, f := range fields {
+		if *f, err = sd.ParseInt64(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Tracker counts the blocks produced and the slots missed by every witness when the blocks become irreversible,
+// so that the forked blocks are not counted, and saves the statistics in a db.
+type Tracker struct {
+	bc    blockcache.BlockCache
+	db    *kv.Storage
+	mutex sync.RWMutex
+	stats map[string]*Stats
+
+	// The last irreversible block and the witness list of the slots after it.
+	last      *block.Block
+	witnesses []string
+}
+
+// New returns a tracker with the statistics saved in the db of the path.
+func New(path string, bc blockcache.BlockCache) (*Tracker, error) {
+	db, err := kv.NewStorage(path, kv.LevelDBStorage)
+	if err != nil {
+		return nil, fmt.Errorf("fail to open producer stats db: %v", err)
+	}
+	t := &Tracker{
+		bc:    bc,
+		db:    db,
+		stats: make(map[string]*Stats),
+	}
+	iter := db.NewIteratorByPrefix(nil)
+	for iter.Next() {
+		s := &Stats{}
+		if err := s.Decode(iter.Value()); err != nil {
+			ilog.Warnf("Decode producer stats failed: %v", err)
+			continue
+		}
+		t.stats[s.Witness] = s
+	}
+	iter.Release()
+	if err := iter.Error(); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("fail to load producer stats: %v", err)
+	}
+	if root := bc.LinkedRoot(); root != nil {
+		t.last = root.Block
+		t.witnesses = root.Active()
+	}
+	return t, nil
+}
+
+// Close closes the db.
+func (t *Tracker) Close() {
+	t.db.Close()
+}
+
+func (t *Tracker) get(witness string) *Stats {
+	s, ok := t.stats[witness]
+	if !ok {
+		s = &Stats{Witness: witness}
+		t.stats[witness] = s
+	}
+	return s
+}
+
+// OnReceive records the delay of the new block received from the network.
+func (t *Tracker) OnReceive(blk *block.Block, latency time.Duration) {
+	ms := int64(latency / time.Millisecond)
+	if ms < 0 {
+		ms = 0
+	}
+	t.mutex.Lock()
+	s := t.get(blk.Head.Witness)
+	s.LatencySum += ms
+	s.LatencyCount++
+	t.mutex.Unlock()
+	metricsBlockLatency.Set(float64(ms), map[string]string{"witness": blk.Head.Witness})
+}
+
+// OnFlush should be called right after the irreversible block is flushed,
+// when the linked root of the block cache is the block.
+func (t *Tracker) OnFlush(blk *block.Block) {
+	t.record(blk, t.bc.LinkedRoot().Active())
+}
+
+func (t *Tracker) record(blk *block.Block, witnesses []string) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	changed := make(map[string]*Stats)
+	// The slots after the genesis block are not counted, since the chain may start long after it.
+	if t.last != nil && t.last.Head.Number > 0 && len(t.witnesses) > 0 {
+		from, to := block.SlotOfHead(t.last.Head)+1, block.SlotOfHead(blk.Head)
+		for w, n := range missedSlots(from, to, t.witnesses) {
+			s := t.get(w)
+			s.Missed += n
+			s.LastMissedSlot = lastSlotOf(from, to, t.witnesses, w)
+			changed[w] = s
+			metricsMissedSlot.Add(float64(n), map[string]string{"witness": w})
+		}
+	}
+	s := t.get(blk.Head.Witness)
+	s.Produced++
+	s.LastProduced = blk.Head.Number
+	changed[blk.Head.Witness] = s
+	metricsProducedBlock.Add(1, map[string]string{"witness": blk.Head.Witness})
+
+	t.last = blk
+	t.witnesses = witnesses
+	for w, s := range changed {
+		if err := t.db.Put([]byte(w), s.Encode()); err != nil {
+			ilog.Errorf("Save producer stats of %v failed: %v", w, err)
+		}
+	}
+}
+
+// missedSlots counts the slots in [from, to) of every witness, all of them are missed since no block is between.
+func missedSlots(from, to int64, witnesses []string) map[string]int64 {
+	missed := make(map[string]int64)
+	if to <= from {
+		return missed
+	}
+	n := int64(len(witnesses))
+	full, rem := (to-from)/n, (to-from)%n
+	for i := int64(0); i < n; i++ {
+		// The first rem slots from the slot from get one more.
+		count := full
+		if (i-from%n+n)%n < rem {
+			count++
+		}
+		if count > 0 {
+			missed[witnesses[i]] += count
+		}
+	}
+	return missed
+}
+
+// lastSlotOf returns the last slot of the witness in [from, to).
+func lastSlotOf(from, to int64, witnesses []string, witness string) int64 {
+	n := int64(len(witnesses))
+	for slot := to - 1; slot >= from && slot > to-1-n; slot-- {
+		if witnesses[slot%n] == witness {
+			return slot
+		}
+	}
+	return 0
+}
+
+// Get returns the statistics of the witness, nil if not found.
+func (t *Tracker) Get(witness string) *Stats {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+
+	s, ok := t.stats[witness]
+	if !ok {
+		return nil
+	}
+	cp := *s
+	return &cp
+}
+
+// All returns the statistics of all witnesses sorted by witness.
+func (t *Tracker) All() []*Stats {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+
+	all := make([]*Stats, 0, len(t.stats))
+	for _, s := range t.stats {
+		cp := *s
+		all = append(all, &cp)
+	}
+	sort.Slice(all, func(i, j int) bool {
+		return all[i].Witness < all[j].Witness
+	})
+	return all
+}
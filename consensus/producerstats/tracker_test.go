@@ -0,0 +1,81 @@
+package producerstats
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/iost-official/go-iost/core/block"
+	"github.com/iost-official/go-iost/db/kv"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func blockAt(number, slot int64, witness string) *block.Block {
+	return &block.Block{
+		Head: &block.BlockHead{
+			Number:  number,
+			Witness: witness,
+			Time:    slot * 3 * 1e9,
+		},
+	}
+}
+
+func TestTracker(t *testing.T) {
+	Convey("Test of producer stats tracker", t, func() {
+		os.RemoveAll("StatsDB")
+		defer os.RemoveAll("StatsDB")
+
+		db, err := kv.NewStorage("StatsDB", kv.LevelDBStorage)
+		So(err, ShouldBeNil)
+		witnesses := []string{"w0", "w1", "w2"}
+		tracker := &Tracker{
+			db:        db,
+			stats:     make(map[string]*Stats),
+			last:      blockAt(10, 99, "w0"),
+			witnesses: witnesses,
+		}
+
+		// slot 100 of w1 and slot 101 of w2 are missed
+		tracker.record(blockAt(11, 102, "w0"), witnesses)
+		tracker.OnReceive(blockAt(11, 102, "w0"), 300*time.Millisecond)
+		// no slot is missed in the same slot
+		tracker.record(blockAt(12, 102, "w0"), witnesses)
+		tracker.OnReceive(blockAt(12, 102, "w0"), 100*time.Millisecond)
+		// slots from 103 to 109 are missed
+		tracker.record(blockAt(13, 110, "w2"), witnesses)
+
+		w0 := tracker.Get("w0")
+		So(w0.Produced, ShouldEqual, 2)
+		So(w0.LastProduced, ShouldEqual, 12)
+		So(w0.Missed, ShouldEqual, 2)
+		So(w0.LastMissedSlot, ShouldEqual, 108)
+		So(w0.AvgLatency(), ShouldEqual, 200)
+		w1 := tracker.Get("w1")
+		So(w1.Produced, ShouldEqual, 0)
+		So(w1.Missed, ShouldEqual, 4)
+		So(w1.LastMissedSlot, ShouldEqual, 109)
+		w2 := tracker.Get("w2")
+		So(w2.Produced, ShouldEqual, 1)
+		So(w2.Missed, ShouldEqual, 3)
+		So(tracker.Get("w3"), ShouldBeNil)
+		So(len(tracker.All()), ShouldEqual, 3)
+
+		Convey("reload the stats", func() {
+			b, err := db.Get([]byte("w1"))
+			So(err, ShouldBeNil)
+			s := &Stats{}
+			So(s.Decode(b), ShouldBeNil)
+			So(s, ShouldResemble, w1)
+		})
+		db.Close()
+	})
+}
+
+func TestMissedSlots(t *testing.T) {
+	Convey("Test of missed slots", t, func() {
+		witnesses := []string{"w0", "w1", "w2"}
+		So(missedSlots(5, 5, witnesses), ShouldBeEmpty)
+		So(missedSlots(5, 6, witnesses), ShouldResemble, map[string]int64{"w2": 1})
+		So(missedSlots(4, 11, witnesses), ShouldResemble, map[string]int64{"w0": 2, "w1": 3, "w2": 2})
+	})
+}
@@ -0,0 +1,248 @@
+package snapshot
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/iost-official/go-iost/common"
+	"github.com/iost-official/go-iost/core/block"
+	"github.com/iost-official/go-iost/db"
+	"github.com/iost-official/go-iost/ilog"
+	"github.com/iost-official/go-iost/p2p"
+)
+
+var (
+	manifestRequestInterval = 5 * time.Second
+	manifestTimeout         = 10 * time.Minute
+	chunkRequestTimeout     = 20 * time.Second
+	chunkCheckInterval      = time.Second
+	// fastSyncStallTimeout is the time without any valid chunk, after which the fast sync fails.
+	fastSyncStallTimeout = 2 * time.Minute
+	maxChunkRequests     = 8
+)
+
+// Errors of the fast sync.
+var (
+	ErrStateNotEmpty   = errors.New("state db is not empty")
+	ErrNoCheckpoint    = errors.New("no trusted checkpoint to fast sync to")
+	ErrNoManifest      = errors.New("no state manifest agreed by enough neighbor nodes")
+	ErrFastSyncStalled = errors.New("fast sync stalled")
+	ErrStateRoot       = errors.New("state root mismatch with the checkpoint block")
+)
+
+type chunkRequest struct {
+	peerID   p2p.PeerID
+	deadline time.Time
+}
+
+type fastSync struct {
+	p          p2p.Service
+	minPeers   int
+	checkpoint []byte
+
+	msgCh chan p2p.IncomingMessage
+}
+
+// FastSync downloads the state at the trusted checkpoint block from the neighbor nodes and writes it into
+// the empty state db, and then pushes the block into the empty block chain. The node only needs to sync
+// the blocks after it.
+//
+// The checkpoint is the hash of an irreversible block served by the neighbor nodes, which must be obtained
+// from a trusted source, and the block must commit to the state root. The neighbor nodes are not trusted:
+// every chunk is verified against the chunk hashes of the manifest, and the whole state is verified against
+// the state root in the head of the checkpoint block, see db.StateHash. At least minPeers neighbor nodes
+// must serve the same manifest, which only protects the download from being stalled by a single node.
+// The state db is left incomplete if the fast sync fails, it must be removed before the next try.
+func FastSync(stateDB db.MVCCDB, bChain block.Chain, p p2p.Service, minPeers int, checkpoint []byte) error {
+	if bChain.Length() != 0 {
+		return fmt.Errorf("block chain is not empty")
+	}
+	if stateDB.CurrentTag() != "" {
+		return ErrStateNotEmpty
+	}
+	if len(checkpoint) == 0 {
+		return ErrNoCheckpoint
+	}
+	if minPeers < 1 {
+		minPeers = 1
+	}
+
+	f := &fastSync{
+		p:          p,
+		minPeers:   minPeers,
+		checkpoint: checkpoint,
+		msgCh:      p.Register("snapshot response", p2p.SnapshotManifestResponse, p2p.SnapshotChunkResponse),
+	}
+	defer p.Deregister("snapshot response", p2p.SnapshotManifestResponse, p2p.SnapshotChunkResponse)
+
+	manifest, peers, err := f.findManifest()
+	if err != nil {
+		return err
+	}
+	blk := manifest.Block
+	ilog.Infof("Fast sync the state at block %v, state root: %v, chunks: %v, peers: %v.",
+		blk.Head.Number, common.Base58Encode(manifest.StateRoot), len(manifest.ChunkHashes), len(peers))
+
+	stateHash := db.NewStateHash()
+	err = f.download(manifest, peers, func(index int, data []byte) error {
+		if err := writeChunk(stateDB, data, stateHash); err != nil {
+			return err
+		}
+		// The chunks are flushed one by one to bound the memory, the final tag is set after all of them.
+		tag := fmt.Sprintf("fastsync-%v", index)
+		stateDB.Commit(tag)
+		return stateDB.Flush(tag)
+	})
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(stateHash.Root(), blk.Head.StateRoot) {
+		return ErrStateRoot
+	}
+	if err := stateDB.Put(db.StateHashTable, db.StateHashKey, string(stateHash.Encode())); err != nil {
+		return fmt.Errorf("fail to put state hash: %v", err)
+	}
+
+	stateDB.Commit(string(blk.HeadHash()))
+	if err := stateDB.Flush(string(blk.HeadHash())); err != nil {
+		return fmt.Errorf("fail to flush state: %v", err)
+	}
+	if err := bChain.Push(blk); err != nil {
+		return fmt.Errorf("fail to push block: %v", err)
+	}
+	ilog.Infof("Finished fast sync at block %v.", blk.Head.Number)
+	return nil
+}
+
+// findManifest requests the manifests at the checkpoint from the neighbor nodes until one of them is served by minPeers peers.
+func (f *fastSync) findManifest() (*Manifest, []p2p.PeerID, error) {
+	// The latest manifest of each peer, and the peers grouped by state root.
+	latest := make(map[p2p.PeerID]string)
+	groups := make(map[string]map[p2p.PeerID]*Manifest)
+
+	timeout := time.After(manifestTimeout)
+	f.p.Broadcast(f.checkpoint, p2p.SnapshotManifestRequest, p2p.NormalMessage)
+	for {
+		select {
+		case msg := <-f.msgCh:
+			if msg.Type() != p2p.SnapshotManifestResponse {
+				continue
+			}
+			manifest := &Manifest{}
+			if err := manifest.Decode(msg.Data()); err != nil {
+				ilog.Warnf("Decode manifest from %v failed: %v", msg.From().Pretty(), err)
+				continue
+			}
+			if err := manifest.Verify(); err != nil {
+				ilog.Warnf("Verify manifest from %v failed: %v", msg.From().Pretty(), err)
+				continue
+			}
+			if err := manifest.VerifyCheckpoint(f.checkpoint); err != nil {
+				ilog.Warnf("Verify manifest from %v against the checkpoint failed: %v", msg.From().Pretty(), err)
+				continue
+			}
+
+			key := string(manifest.StateRoot)
+			if old, ok := latest[msg.From()]; ok {
+				delete(groups[old], msg.From())
+			}
+			latest[msg.From()] = key
+			if groups[key] == nil {
+				groups[key] = make(map[p2p.PeerID]*Manifest)
+			}
+			groups[key][msg.From()] = manifest
+
+			if len(groups[key]) >= f.minPeers {
+				peers := make([]p2p.PeerID, 0, len(groups[key]))
+				for peerID := range groups[key] {
+					peers = append(peers, peerID)
+				}
+				return manifest, peers, nil
+			}
+		case <-time.After(manifestRequestInterval):
+			f.p.Broadcast(f.checkpoint, p2p.SnapshotManifestRequest, p2p.NormalMessage)
+		case <-timeout:
+			return nil, nil, ErrNoManifest
+		}
+	}
+}
+
+// download requests the chunks from the peers in parallel, and calls write with every verified chunk.
+func (f *fastSync) download(manifest *Manifest, peers []p2p.PeerID, write func(int, []byte) error) error {
+	queue := make([]int, 0, len(manifest.ChunkHashes))
+	for i := range manifest.ChunkHashes {
+		queue = append(queue, i)
+	}
+	inflight := make(map[int]*chunkRequest)
+	next := 0
+	finished := 0
+	lastProgress := time.Now()
+
+	for finished < len(manifest.ChunkHashes) {
+		for len(inflight) < maxChunkRequests && len(queue) > 0 {
+			if len(peers) == 0 {
+				return fmt.Errorf("no peer to download the state from")
+			}
+			index := queue[0]
+			queue = queue[1:]
+			peerID := peers[next%len(peers)]
+			next++
+			inflight[index] = &chunkRequest{
+				peerID:   peerID,
+				deadline: time.Now().Add(chunkRequestTimeout),
+			}
+			f.p.SendToPeer(peerID, encodeChunkRequest(manifest.StateRoot, index), p2p.SnapshotChunkRequest, p2p.NormalMessage)
+		}
+
+		select {
+		case msg := <-f.msgCh:
+			if msg.Type() != p2p.SnapshotChunkResponse {
+				continue
+			}
+			root, index, data, err := decodeChunkResponse(msg.Data())
+			if err != nil || !bytes.Equal(root, manifest.StateRoot) {
+				continue
+			}
+			r, ok := inflight[index]
+			if !ok || r.peerID != msg.From() {
+				continue
+			}
+			delete(inflight, index)
+			if err := manifest.VerifyChunk(index, data); err != nil {
+				ilog.Warnf("Verify chunk %v from %v failed: %v", index, msg.From().Pretty(), err)
+				peers = removePeer(peers, msg.From())
+				queue = append(queue, index)
+				continue
+			}
+			if err := write(index, data); err != nil {
+				return fmt.Errorf("fail to write chunk %v: %v", index, err)
+			}
+			finished++
+			lastProgress = time.Now()
+			ilog.Debugf("Downloaded chunk %v, %v/%v.", index, finished, len(manifest.ChunkHashes))
+		case <-time.After(chunkCheckInterval):
+			now := time.Now()
+			for index, r := range inflight {
+				if now.After(r.deadline) {
+					delete(inflight, index)
+					queue = append(queue, index)
+				}
+			}
+			if now.Sub(lastProgress) > fastSyncStallTimeout {
+				return ErrFastSyncStalled
+			}
+		}
+	}
+	return nil
+}
+
+func removePeer(peers []p2p.PeerID, peerID p2p.PeerID) []p2p.PeerID {
+	for i, p := range peers {
+		if p == peerID {
+			return append(peers[:i], peers[i+1:]...)
+		}
+	}
+	return peers
+}
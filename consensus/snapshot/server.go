@@ -0,0 +1,272 @@
+package snapshot
+
+import (
+	"bytes"
+	"sync"
+
+	"github.com/iost-official/go-iost/common"
+	"github.com/iost-official/go-iost/core/block"
+	"github.com/iost-official/go-iost/db"
+	"github.com/iost-official/go-iost/ilog"
+	"github.com/iost-official/go-iost/p2p"
+)
+
+// Server serves the state snapshots at the irreversible blocks to the neighbor nodes for fast sync.
+// The state is exported at the irreversible blocks whose number is a multiple of the interval, so that
+// the neighbor nodes export the same state at the block a new node is configured to sync to.
+type Server struct {
+	stateDB  db.MVCCDB
+	p        p2p.Service
+	interval int64
+
+	// The previous export is retained so that the downloads in progress are not broken by a new export.
+	exports []*export
+	mutex   *sync.RWMutex
+
+	exportCh chan *exportRequest
+	msgCh    chan p2p.IncomingMessage
+
+	quitCh chan struct{}
+	done   *sync.WaitGroup
+}
+
+// NewServer returns a new snapshot server, which exports the state every interval irreversible blocks.
+// The server does nothing if the interval is not positive.
+func NewServer(stateDB db.MVCCDB, p p2p.Service, interval int64) *Server {
+	return &Server{
+		stateDB:  stateDB,
+		p:        p,
+		interval: interval,
+
+		exports: make([]*export, 0, 2),
+		mutex:   new(sync.RWMutex),
+
+		exportCh: make(chan *exportRequest, 1),
+
+		quitCh: make(chan struct{}),
+		done:   new(sync.WaitGroup),
+	}
+}
+
+// Start will start the snapshot server.
+func (s *Server) Start() error {
+	if s.interval <= 0 {
+		return nil
+	}
+	s.msgCh = s.p.Register("snapshot request", p2p.SnapshotManifestRequest, p2p.SnapshotChunkRequest)
+	s.done.Add(2)
+	go s.requestController()
+	go s.exportController()
+	return nil
+}
+
+// Stop will stop the snapshot server.
+func (s *Server) Stop() {
+	if s.interval <= 0 {
+		return
+	}
+	s.p.Deregister("snapshot request", p2p.SnapshotManifestRequest, p2p.SnapshotChunkRequest)
+	close(s.quitCh)
+	s.done.Wait()
+
+	select {
+	case r := <-s.exportCh:
+		r.snapshot.Release()
+	default:
+	}
+	s.mutex.Lock()
+	for _, e := range s.exports {
+		e.release()
+	}
+	s.exports = nil
+	s.mutex.Unlock()
+	ilog.Infof("Stopped snapshot server.")
+}
+
+type exportRequest struct {
+	blk      *block.Block
+	snapshot *db.Snapshot
+}
+
+// OnFlush should be called right after the state of the irreversible block is flushed into db,
+// and before the next flush. The export itself is done in another goroutine.
+func (s *Server) OnFlush(blk *block.Block) {
+	if s.interval <= 0 || blk.Head.Number%s.interval != 0 {
+		return
+	}
+	snapshot, err := s.stateDB.Snapshot()
+	if err != nil {
+		ilog.Errorf("Take the state snapshot failed: %v", err)
+		return
+	}
+	if snapshot.Tag() != string(blk.HeadHash()) {
+		ilog.Warnf("Skip exporting the state at block %v, the flushed state is at another block.", blk.Head.Number)
+		snapshot.Release()
+		return
+	}
+	select {
+	case s.exportCh <- &exportRequest{blk: blk, snapshot: snapshot}:
+	default:
+		ilog.Warnf("Skip exporting the state at block %v, the last export is not finished.", blk.Head.Number)
+		snapshot.Release()
+	}
+}
+
+func (s *Server) doExport(blk *block.Block, snapshot *db.Snapshot) {
+	e, err := newExport(snapshot, blk)
+	if err != nil {
+		ilog.Errorf("Export the state at block %v failed: %v", blk.Head.Number, err)
+		snapshot.Release()
+		return
+	}
+
+	s.mutex.Lock()
+	s.exports = append(s.exports, e)
+	if len(s.exports) > 2 {
+		s.exports[0].release()
+		s.exports = s.exports[1:]
+	}
+	s.mutex.Unlock()
+	ilog.Infof("Exported the state at block %v, state root: %v, chunks: %v.",
+		blk.Head.Number, common.Base58Encode(e.root), len(e.starts))
+}
+
+func (s *Server) exportController() {
+	for {
+		select {
+		case r := <-s.exportCh:
+			s.doExport(r.blk, r.snapshot)
+		case <-s.quitCh:
+			s.done.Done()
+			return
+		}
+	}
+}
+
+func (s *Server) latest() *export {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	if len(s.exports) == 0 {
+		return nil
+	}
+	return s.exports[len(s.exports)-1]
+}
+
+func (s *Server) find(root []byte) *export {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	for _, e := range s.exports {
+		if bytes.Equal(e.root, root) {
+			return e
+		}
+	}
+	return nil
+}
+
+// findBlock returns the export at the block.
+func (s *Server) findBlock(blockHash []byte) *export {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	for _, e := range s.exports {
+		if bytes.Equal(e.blockHash, blockHash) {
+			return e
+		}
+	}
+	return nil
+}
+
+// handleManifestRequest replies the manifest at the requested block, or the latest one if no block is requested.
+func (s *Server) handleManifestRequest(msg *p2p.IncomingMessage) {
+	e := s.latest()
+	if len(msg.Data()) > 0 {
+		e = s.findBlock(msg.Data())
+	}
+	if e == nil {
+		return
+	}
+	s.p.SendToPeer(msg.From(), e.manifest, p2p.SnapshotManifestResponse, p2p.NormalMessage)
+}
+
+func (s *Server) handleChunkRequest(msg *p2p.IncomingMessage) {
+	root, index, err := decodeChunkRequest(msg.Data())
+	if err != nil {
+		ilog.Warnf("Decode chunk request failed: %v", err)
+		return
+	}
+	e := s.find(root)
+	if e == nil {
+		ilog.Debugf("The requested state %v is not exported.", common.Base58Encode(root))
+		return
+	}
+	data, err := e.chunk(index)
+	if err != nil {
+		ilog.Warnf("Read chunk %v of state %v failed: %v", index, common.Base58Encode(root), err)
+		return
+	}
+	s.p.SendToPeer(msg.From(), encodeChunkResponse(root, index, data), p2p.SnapshotChunkResponse, p2p.NormalMessage)
+}
+
+func (s *Server) requestController() {
+	for {
+		select {
+		case msg := <-s.msgCh:
+			switch msg.Type() {
+			case p2p.SnapshotManifestRequest:
+				s.handleManifestRequest(&msg)
+			case p2p.SnapshotChunkRequest:
+				s.handleChunkRequest(&msg)
+			}
+		case <-s.quitCh:
+			s.done.Done()
+			return
+		}
+	}
+}
+
+func encodeChunkRequest(root []byte, index int) []byte {
+	se := common.NewSimpleEncoder()
+	se.WriteBytes(root)
+	se.WriteInt32(int32(index))
+	return se.Bytes()
+}
+
+func decodeChunkRequest(b []byte) ([]byte, int, error) {
+	sd := common.NewSimpleDecoder(b)
+	root, err := sd.ParseBytes()
+	if err != nil {
+		return nil, 0, err
+	}
+	index, err := sd.ParseInt32()
+	if err != nil {
+		return nil, 0, err
+	}
+	return root, int(index), nil
+}
+
+func encodeChunkResponse(root []byte, index int, data []byte) []byte {
+	se := common.NewSimpleEncoder()
+	se.WriteBytes(root)
+	se.WriteInt32(int32(index))
+	se.WriteBytes(data)
+	return se.Bytes()
+}
+
+func decodeChunkResponse(b []byte) ([]byte, int, []byte, error) {
+	sd := common.NewSimpleDecoder(b)
+	root, err := sd.ParseBytes()
+	if err != nil {
+		return nil, 0, nil, err
+	}
+	index, err := sd.ParseInt32()
+	if err != nil {
+		return nil, 0, nil, err
+	}
+	data, err := sd.ParseBytes()
+	if err != nil {
+		return nil, 0, nil, err
+	}
+	return root, int(index), data, nil
+}
@@ -0,0 +1,237 @@
+package snapshot
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/iost-official/go-iost/common"
+	"github.com/iost-official/go-iost/core/block"
+	"github.com/iost-official/go-iost/db"
+)
+
+var (
+	// stateChunkSize is the size in bytes over which a chunk of the state is cut.
+	// All nodes must use the same size so that they export the same chunks for the same state.
+	stateChunkSize = 1 << 20
+	// maxStateChunks limits the number of chunks in a manifest received from the neighbor nodes.
+	maxStateChunks = 1 << 20
+)
+
+// Errors of the state snapshot.
+var (
+	ErrInvalidManifest = errors.New("invalid state manifest")
+	ErrInvalidChunk    = errors.New("invalid state chunk")
+)
+
+// Manifest describes the state snapshot at an irreversible block,
+// the state is split into chunks in the order of the keys.
+type Manifest struct {
+	Block       *block.Block
+	StateRoot   []byte
+	ChunkHashes [][]byte
+}
+
+// StateRoot returns the root hash committing to all chunks of the state.
+func StateRoot(chunkHashes [][]byte) []byte {
+	return common.Sha3(bytes.Join(chunkHashes, nil))
+}
+
+// Encode will encode the manifest.
+func (m *Manifest) Encode() ([]byte, error) {
+	blk, err := m.Block.Encode()
+	if err != nil {
+		return nil, fmt.Errorf("fail to encode block: %v", err)
+	}
+	se := common.NewSimpleEncoder()
+	se.WriteBytes(blk)
+	se.WriteBytes(m.StateRoot)
+	se.WriteInt32(int32(len(m.ChunkHashes)))
+	for _, hash := range m.ChunkHashes {
+		se.WriteBytes(hash)
+	}
+	return se.Bytes(), nil
+}
+
+// Decode will decode the manifest.
+func (m *Manifest) Decode(b []byte) error {
+	sd := common.NewSimpleDecoder(b)
+	blk, err := sd.ParseBytes()
+	if err != nil {
+		return err
+	}
+	m.Block = &block.Block{}
+	if err := m.Block.Decode(blk); err != nil {
+		return fmt.Errorf("fail to decode block: %v", err)
+	}
+	if m.StateRoot, err = sd.ParseBytes(); err != nil {
+		return err
+	}
+	n, err := sd.ParseInt32()
+	if err != nil {
+		return err
+	}
+	if n <= 0 || int(n) > maxStateChunks {
+		return fmt.Errorf("invalid chunk number %v", n)
+	}
+	m.ChunkHashes = make([][]byte, n)
+	for i := range m.ChunkHashes {
+		if m.ChunkHashes[i], err = sd.ParseBytes(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Verify checks that the state root commits to the chunk hashes.
+func (m *Manifest) Verify() error {
+	if m.Block == nil || m.Block.Head == nil || len(m.ChunkHashes) == 0 {
+		return ErrInvalidManifest
+	}
+	if !bytes.Equal(m.StateRoot, StateRoot(m.ChunkHashes)) {
+		return ErrInvalidManifest
+	}
+	return nil
+}
+
+// VerifyCheckpoint checks that the block of the manifest is the checkpoint block, and that it commits to the state root.
+func (m *Manifest) VerifyCheckpoint(checkpoint []byte) error {
+	blk := m.Block
+	if !bytes.Equal(blk.HeadHash(), checkpoint) {
+		return fmt.Errorf("block %v is not the checkpoint", common.Base58Encode(blk.HeadHash()))
+	}
+	if blk.Head.Version < block.V1 || len(blk.Head.StateRoot) == 0 {
+		return fmt.Errorf("block %v does not commit to the state root", blk.Head.Number)
+	}
+	if !bytes.Equal(blk.CalculateTxMerkleHash(), blk.Head.TxMerkleHash) ||
+		!bytes.Equal(blk.CalculateTxReceiptMerkleHash(), blk.Head.TxReceiptMerkleHash) {
+		return fmt.Errorf("txs of block %v mismatch the head", blk.Head.Number)
+	}
+	return nil
+}
+
+// VerifyChunk checks the chunk of the index against the manifest.
+func (m *Manifest) VerifyChunk(index int, data []byte) error {
+	if index < 0 || index >= len(m.ChunkHashes) {
+		return ErrInvalidChunk
+	}
+	if !bytes.Equal(common.Sha3(data), m.ChunkHashes[index]) {
+		return ErrInvalidChunk
+	}
+	return nil
+}
+
+// readChunk reads the key value pairs from the key start until the size of the chunk reaches stateChunkSize.
+// It returns the encoded chunk and the first key of the next chunk, which is nil for the last chunk.
+func readChunk(snapshot *db.Snapshot, start []byte) ([]byte, []byte, error) {
+	var next []byte
+	keys := make([][]byte, 0)
+	values := make([][]byte, 0)
+	size := 0
+	err := snapshot.Range(start, func(key []byte, value []byte) bool {
+		if size >= stateChunkSize {
+			next = key
+			return false
+		}
+		keys = append(keys, key)
+		values = append(values, value)
+		size += len(key) + len(value)
+		return true
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	se := common.NewSimpleEncoder()
+	se.WriteInt32(int32(len(keys)))
+	for i := range keys {
+		se.WriteBytes(keys[i])
+		se.WriteBytes(values[i])
+	}
+	return se.Bytes(), next, nil
+}
+
+// writeChunk puts the key value pairs of the chunk into the state db, and adds them into the state hash.
+// The keys are in the form of table/key.
+func writeChunk(stateDB db.MVCCDB, data []byte, stateHash *db.StateHash) error {
+	sd := common.NewSimpleDecoder(data)
+	n, err := sd.ParseInt32()
+	if err != nil {
+		return err
+	}
+	for i := int32(0); i < n; i++ {
+		key, err := sd.ParseBytes()
+		if err != nil {
+			return err
+		}
+		value, err := sd.ParseBytes()
+		if err != nil {
+			return err
+		}
+		sep := bytes.IndexByte(key, db.SEPARATOR)
+		if sep <= 0 {
+			return fmt.Errorf("invalid state key %v", string(key))
+		}
+		if err := stateDB.Put(string(key[:sep]), string(key[sep+1:]), string(value)); err != nil {
+			return err
+		}
+		stateHash.Add(key, value)
+	}
+	return nil
+}
+
+// export is the state snapshot at an irreversible block served to the neighbor nodes.
+type export struct {
+	snapshot  *db.Snapshot
+	manifest  []byte
+	blockHash []byte
+	root      []byte
+	starts    [][]byte
+}
+
+func newExport(snapshot *db.Snapshot, blk *block.Block) (*export, error) {
+	e := &export{
+		snapshot:  snapshot,
+		blockHash: blk.HeadHash(),
+		starts:    make([][]byte, 0),
+	}
+	hashes := make([][]byte, 0)
+	var start []byte
+	for {
+		data, next, err := readChunk(snapshot, start)
+		if err != nil {
+			return nil, fmt.Errorf("fail to read chunk: %v", err)
+		}
+		e.starts = append(e.starts, start)
+		hashes = append(hashes, common.Sha3(data))
+		if next == nil {
+			break
+		}
+		start = next
+	}
+
+	manifest := &Manifest{
+		Block:       blk,
+		StateRoot:   StateRoot(hashes),
+		ChunkHashes: hashes,
+	}
+	var err error
+	e.manifest, err = manifest.Encode()
+	if err != nil {
+		return nil, err
+	}
+	e.root = manifest.StateRoot
+	return e, nil
+}
+
+func (e *export) chunk(index int) ([]byte, error) {
+	if index < 0 || index >= len(e.starts) {
+		return nil, fmt.Errorf("chunk %v out of range", index)
+	}
+	data, _, err := readChunk(e.snapshot, e.starts[index])
+	return data, err
+}
+
+func (e *export) release() {
+	e.snapshot.Release()
+}
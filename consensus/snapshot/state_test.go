@@ -0,0 +1,117 @@
+package snapshot
+
+import (
+	"os"
+	"testing"
+
+	"github.com/iost-official/go-iost/core/block"
+	"github.com/iost-official/go-iost/core/tx"
+	"github.com/iost-official/go-iost/crypto"
+	"github.com/iost-official/go-iost/db"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestStateExport(t *testing.T) {
+	Convey("Test of state export", t, func() {
+		os.RemoveAll("ExportDB")
+		defer os.RemoveAll("ExportDB")
+
+		chunkSize := stateChunkSize
+		stateChunkSize = 1024
+		defer func() { stateChunkSize = chunkSize }()
+
+		src, err := db.NewMVCCDB("ExportDB/Src")
+		So(err, ShouldBeNil)
+		defer src.Close()
+		state := make(map[string]string)
+		stateHash := db.NewStateHash()
+		for i := 0; i < 200; i++ {
+			key, value := randString(16), randString(32)
+			So(src.Put("state", key, value), ShouldBeNil)
+			state[key] = value
+			stateHash.Add([]byte("state/"+key), []byte(value))
+		}
+
+		blk := &block.Block{
+			Head: &block.BlockHead{
+				Version:   block.V1,
+				Witness:   "w0",
+				Number:    100,
+				Time:      100,
+				StateRoot: stateHash.Root(),
+			},
+			Sign:     &crypto.Signature{},
+			Txs:      []*tx.Tx{},
+			Receipts: []*tx.TxReceipt{},
+		}
+		blk.Head.TxMerkleHash = blk.CalculateTxMerkleHash()
+		blk.Head.TxReceiptMerkleHash = blk.CalculateTxReceiptMerkleHash()
+		blk.CalculateHeadHash()
+
+		src.Commit(string(blk.HeadHash()))
+		So(src.Flush(string(blk.HeadHash())), ShouldBeNil)
+
+		snapshot, err := src.Snapshot()
+		So(err, ShouldBeNil)
+		So(snapshot.Tag(), ShouldEqual, string(blk.HeadHash()))
+
+		// The state flushed after the snapshot is not exported.
+		So(src.Put("state", "later", "value"), ShouldBeNil)
+		src.Commit("later")
+		So(src.Flush("later"), ShouldBeNil)
+
+		e, err := newExport(snapshot, blk)
+		So(err, ShouldBeNil)
+		defer e.release()
+		So(len(e.starts), ShouldBeGreaterThan, 1)
+
+		manifest := &Manifest{}
+		So(manifest.Decode(e.manifest), ShouldBeNil)
+		So(manifest.Verify(), ShouldBeNil)
+		So(manifest.VerifyCheckpoint(blk.HeadHash()), ShouldBeNil)
+		So(manifest.VerifyCheckpoint([]byte("other")), ShouldNotBeNil)
+		So(manifest.Block.HeadHash(), ShouldResemble, blk.HeadHash())
+		So(manifest.StateRoot, ShouldResemble, e.root)
+
+		Convey("import the chunks", func() {
+			dst, err := db.NewMVCCDB("ExportDB/Dst")
+			So(err, ShouldBeNil)
+			defer dst.Close()
+			h := db.NewStateHash()
+			for i := range manifest.ChunkHashes {
+				data, err := e.chunk(i)
+				So(err, ShouldBeNil)
+				So(manifest.VerifyChunk(i, data), ShouldBeNil)
+				So(writeChunk(dst, data, h), ShouldBeNil)
+			}
+			So(h.Root(), ShouldResemble, blk.Head.StateRoot)
+			dst.Commit(string(blk.HeadHash()))
+			So(dst.Flush(string(blk.HeadHash())), ShouldBeNil)
+
+			for key, value := range state {
+				v, err := dst.Get("state", key)
+				So(err, ShouldBeNil)
+				So(v, ShouldEqual, value)
+			}
+			has, err := dst.Has("state", "later")
+			So(err, ShouldBeNil)
+			So(has, ShouldBeFalse)
+		})
+
+		Convey("reject the tampered data", func() {
+			data, err := e.chunk(0)
+			So(err, ShouldBeNil)
+			data[len(data)-1]++
+			So(manifest.VerifyChunk(0, data), ShouldEqual, ErrInvalidChunk)
+			So(manifest.VerifyChunk(len(manifest.ChunkHashes), data), ShouldEqual, ErrInvalidChunk)
+
+			manifest.ChunkHashes[0] = manifest.ChunkHashes[1]
+			So(manifest.Verify(), ShouldEqual, ErrInvalidManifest)
+
+			blk.Head.StateRoot = nil
+			blk.CalculateHeadHash()
+			manifest.Block = blk
+			So(manifest.VerifyCheckpoint(blk.HeadHash()), ShouldNotBeNil)
+		})
+	})
+}
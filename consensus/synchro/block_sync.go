@@ -10,50 +10,54 @@ import (
 	"github.com/iost-official/go-iost/core/block"
 	"github.com/iost-official/go-iost/ilog"
 	"github.com/iost-official/go-iost/p2p"
-	"github.com/patrickmn/go-cache"
 )
 
-const (
-	requestCacheExpiration     = 10 * time.Second
-	requestCachePurgeInterval  = 2 * time.Second
-	responseCacheExpiration    = 5 * time.Minute
-	responseCachePurgeInterval = 1 * time.Minute
+var (
+	blockRequestTimeout = 10 * time.Second
+	// blockVerifyTimeout is the time to keep the responded block requested until the consensus verifies it.
+	blockVerifyTimeout = 2 * time.Minute
+	maxPeerRequests    = 20
 )
 
-// BlockMessage define a block from a neighbor node.
-type BlockMessage struct {
-	Blk     *block.Block
-	P2PType p2p.MessageType
-	From    string
+type blockRequest struct {
+	peerID   p2p.PeerID
+	deadline time.Time
+	received bool
 }
 
+// blockSync requests the blocks from the neighbor nodes and tracks the responses.
+// The blocks themselves are handled by the consensus, which subscribes the responses from p2p,
+// and the peer is scored when the consensus verifies the block it responded, see OnVerify.
 type blockSync struct {
-	p             p2p.Service
-	requestCache  *cache.Cache
-	responseCache *cache.Cache
+	p        p2p.Service
+	score    *peerScore
+	requests map[string]*blockRequest
+	inflight map[p2p.PeerID]int
+	mutex    *sync.Mutex
 
-	msgCh   chan p2p.IncomingMessage
-	blockCh chan *BlockMessage
+	msgCh chan p2p.IncomingMessage
 
 	quitCh chan struct{}
 	done   *sync.WaitGroup
 }
 
-func newBlockSync(p p2p.Service) *blockSync {
+func newBlockSync(p p2p.Service, score *peerScore) *blockSync {
 	b := &blockSync{
-		p:             p,
-		requestCache:  cache.New(requestCacheExpiration, requestCachePurgeInterval),
-		responseCache: cache.New(responseCacheExpiration, responseCachePurgeInterval),
+		p:        p,
+		score:    score,
+		requests: make(map[string]*blockRequest),
+		inflight: make(map[p2p.PeerID]int),
+		mutex:    new(sync.Mutex),
 
-		msgCh:   p.Register("block from other nodes", p2p.SyncBlockResponse, p2p.NewBlock),
-		blockCh: make(chan *BlockMessage, 1024),
+		msgCh: p.Register("sync block response", p2p.SyncBlockResponse),
 
 		quitCh: make(chan struct{}),
 		done:   new(sync.WaitGroup),
 	}
 
-	b.done.Add(1)
+	b.done.Add(2)
 	go b.controller()
+	go b.expirationController()
 
 	return b
 }
@@ -64,18 +68,35 @@ func (b *blockSync) Close() {
 	ilog.Infof("Stopped block sync.")
 }
 
-func (b *blockSync) IncommingBlock() <-chan *BlockMessage {
-	return b.blockCh
+// Requested will return whether the block is being requested.
+func (b *blockSync) Requested(hash []byte) bool {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	_, ok := b.requests[string(hash)]
+	return ok
 }
 
-func (b *blockSync) RequestBlock(hash []byte, peerID p2p.PeerID) {
+// RequestBlock will request the block from the peer,
+// and return false if the peer has too many requests in flight.
+func (b *blockSync) RequestBlock(hash []byte, peerID p2p.PeerID) bool {
+	b.mutex.Lock()
 	// Filter duplicate requests in the short term
-	_, found := b.requestCache.Get(string(hash))
-	if found {
+	if _, ok := b.requests[string(hash)]; ok {
+		b.mutex.Unlock()
 		ilog.Debugf("Discard the duplicate request block %v", common.Base58Encode(hash))
-		return
+		return true
+	}
+	if b.inflight[peerID] >= maxPeerRequests {
+		b.mutex.Unlock()
+		return false
 	}
-	b.requestCache.Set(string(hash), "", cache.DefaultExpiration)
+	b.requests[string(hash)] = &blockRequest{
+		peerID:   peerID,
+		deadline: time.Now().Add(blockRequestTimeout),
+	}
+	b.inflight[peerID]++
+	b.mutex.Unlock()
 
 	// Historical issues cause number to be useless.
 	blockInfo := &msgpb.BlockInfo{
@@ -85,15 +106,57 @@ func (b *blockSync) RequestBlock(hash []byte, peerID p2p.PeerID) {
 	msg, err := proto.Marshal(blockInfo)
 	if err != nil {
 		ilog.Errorf("Marshal sync block message failed: %v", err)
-		return
+		return true
 	}
 
 	b.p.SendToPeer(peerID, msg, p2p.SyncBlockRequest, p2p.UrgentMessage)
+	return true
+}
+
+func (b *blockSync) release(r *blockRequest) {
+	b.inflight[r.peerID]--
+	if b.inflight[r.peerID] <= 0 {
+		delete(b.inflight, r.peerID)
+	}
+}
+
+// receive keeps the responded block requested until it is verified, but frees the request slot of the peer.
+func (b *blockSync) receive(r *blockRequest) {
+	r.received = true
+	r.deadline = time.Now().Add(blockVerifyTimeout)
+	b.release(r)
+}
+
+func (b *blockSync) finish(hash string, r *blockRequest) {
+	delete(b.requests, hash)
+	if !r.received {
+		b.release(r)
+	}
+}
+
+// OnVerify is called with the block once the consensus verifies it,
+// and scores the peer which responded the block by the result.
+func (b *blockSync) OnVerify(blk *block.Block, err error) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	hash := string(blk.HeadHash())
+	r, ok := b.requests[hash]
+	if !ok || !r.received {
+		return
+	}
+	b.finish(hash, r)
+	if err != nil {
+		ilog.Warnf("Block %v from peer %v failed to verify: %v", common.Base58Encode(blk.HeadHash()), r.peerID.Pretty(), err)
+		b.score.Invalid(r.peerID)
+		return
+	}
+	b.score.Good(r.peerID)
 }
 
 func (b *blockSync) handleBlock(msg *p2p.IncomingMessage) {
-	if (msg.Type() != p2p.SyncBlockResponse) || (msg.Type() != p2p.NewBlock) {
-		ilog.Warnf("Expect the type %v and %v, but get a unexpected type %v", p2p.SyncBlockResponse, p2p.NewBlock, msg.Type())
+	if msg.Type() != p2p.SyncBlockResponse {
+		ilog.Warnf("Expect the type %v, but get a unexpected type %v", p2p.SyncBlockResponse, msg.Type())
 		return
 	}
 
@@ -101,23 +164,20 @@ func (b *blockSync) handleBlock(msg *p2p.IncomingMessage) {
 	err := blk.Decode(msg.Data())
 	if err != nil {
 		ilog.Warnf("Decode block failed: %v", err)
+		b.score.Invalid(msg.From())
 		return
 	}
 
-	// Discard the most recently received duplicate block by hash
-	_, found := b.responseCache.Get(string(blk.HeadHash()))
-	if found {
-		ilog.Debugf("Discard the duplicate received block %v", common.Base58Encode(blk.HeadHash()))
-		return
-	}
-	b.responseCache.Set(string(blk.HeadHash()), "", cache.DefaultExpiration)
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
 
-	blockMessage := &BlockMessage{
-		Blk:     blk,
-		P2PType: msg.Type(),
-		From:    msg.From().Pretty(),
+	hash := string(blk.HeadHash())
+	r, ok := b.requests[hash]
+	if !ok || r.received || r.peerID != msg.From() {
+		ilog.Debugf("Discard the unrequested block %v", common.Base58Encode(blk.HeadHash()))
+		return
 	}
-	b.blockCh <- blockMessage
+	b.receive(r)
 }
 
 func (b *blockSync) controller() {
@@ -131,3 +191,32 @@ func (b *blockSync) controller() {
 		}
 	}
 }
+
+func (b *blockSync) doExpiration() {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	now := time.Now()
+	for hash, r := range b.requests {
+		if !now.After(r.deadline) {
+			continue
+		}
+		b.finish(hash, r)
+		// The responded block may be dropped by the consensus, which is not the fault of the peer.
+		if !r.received {
+			b.score.Slow(r.peerID)
+		}
+	}
+}
+
+func (b *blockSync) expirationController() {
+	for {
+		select {
+		case <-time.After(expirationInterval):
+			b.doExpiration()
+		case <-b.quitCh:
+			b.done.Done()
+			return
+		}
+	}
+}
@@ -16,6 +16,10 @@ const (
 	BlockHashExpiredSeconds      = 60
 )
 
+var (
+	blockHashRequestTimeout = 5 * time.Second
+)
+
 // BlockHash return the block hash with the Peers that have it.
 type BlockHash struct {
 	Hash   []byte
@@ -27,8 +31,17 @@ type blockHashs struct {
 	time  int64
 }
 
+type blockHashRequest struct {
+	start    int64
+	end      int64
+	deadline time.Time
+}
+
 type blockHashSync struct {
+	p                  p2p.Service
+	score              *peerScore
 	neighborBlockHashs map[p2p.PeerID]*blockHashs
+	requests           map[p2p.PeerID][]*blockHashRequest
 	mutex              *sync.RWMutex
 
 	msgCh chan p2p.IncomingMessage
@@ -37,8 +50,13 @@ type blockHashSync struct {
 	done   *sync.WaitGroup
 }
 
-func newBlockHashSync(p p2p.Service) *blockHashSync {
+func newBlockHashSync(p p2p.Service, score *peerScore) *blockHashSync {
 	b := &blockHashSync{
+		p:                  p,
+		score:              score,
+		neighborBlockHashs: make(map[p2p.PeerID]*blockHashs),
+		requests:           make(map[p2p.PeerID][]*blockHashRequest),
+		mutex:              new(sync.RWMutex),
 
 		msgCh: p.Register("sync block hash response", p2p.SyncBlockHashResponse),
 
@@ -59,21 +77,51 @@ func (b *blockHashSync) Close() {
 	ilog.Infof("Stopped block hash sync.")
 }
 
+// RequestBlockHash will request the block hashs between start height and end height from the peer.
+func (b *blockHashSync) RequestBlockHash(start, end int64, peerID p2p.PeerID) {
+	blockHashQuery := &msgpb.BlockHashQuery{
+		ReqType: msgpb.RequireType_GETBLOCKHASHES,
+		Start:   start,
+		End:     end,
+		Nums:    nil,
+	}
+	msg, err := proto.Marshal(blockHashQuery)
+	if err != nil {
+		ilog.Errorf("Marshal sync block hash message failed: %v", err)
+		return
+	}
+
+	b.mutex.Lock()
+	b.requests[peerID] = append(b.requests[peerID], &blockHashRequest{
+		start:    start,
+		end:      end,
+		deadline: time.Now().Add(blockHashRequestTimeout),
+	})
+	b.mutex.Unlock()
+
+	b.p.SendToPeer(peerID, msg, p2p.SyncBlockHashRequest, p2p.UrgentMessage)
+}
+
 // NeighborBlockHashs will return all block hashs of neighbor nodes between start height and end height.
 // Both start and end are included.
 func (b *blockHashSync) NeighborBlockHashs(start, end int64) <-chan *BlockHash {
 	ch := make(chan *BlockHash, 1024)
 	go func() {
+		defer close(ch)
 		for num := start; num <= end; num++ {
 			hashs := make(map[string]*BlockHash)
 			b.mutex.RLock()
 			for peerID, blockHashs := range b.neighborBlockHashs {
-				key := string(blockHashs.hashs[num])
+				hash, ok := blockHashs.hashs[num]
+				if !ok {
+					continue
+				}
+				key := string(hash)
 				if blockHash, ok := hashs[key]; ok {
 					blockHash.PeerID = append(blockHash.PeerID, peerID)
 				} else {
 					hashs[key] = &BlockHash{
-						Hash:   blockHashs.hashs[num],
+						Hash:   hash,
 						PeerID: []p2p.PeerID{peerID},
 					}
 				}
@@ -90,6 +138,35 @@ func (b *blockHashSync) NeighborBlockHashs(start, end int64) <-chan *BlockHash {
 	return ch
 }
 
+// Prune will remove the block hashs lower than the height, which are not needed any more.
+func (b *blockHashSync) Prune(height int64) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	for _, blockHashs := range b.neighborBlockHashs {
+		for num := range blockHashs.hashs {
+			if num < height {
+				delete(blockHashs.hashs, num)
+			}
+		}
+	}
+}
+
+// takeRequest removes and returns the request of the peer which covers the range.
+func (b *blockHashSync) takeRequest(peerID p2p.PeerID, start, end int64) *blockHashRequest {
+	requests := b.requests[peerID]
+	for i, r := range requests {
+		if r.start <= start && end <= r.end {
+			b.requests[peerID] = append(requests[:i], requests[i+1:]...)
+			if len(b.requests[peerID]) == 0 {
+				delete(b.requests, peerID)
+			}
+			return r
+		}
+	}
+	return nil
+}
+
 func (b *blockHashSync) handleSyncBlockHashResponse(msg *p2p.IncomingMessage) {
 	if msg.Type() != p2p.SyncBlockHashResponse {
 		ilog.Warnf("Expect the type %v, but get a unexpected type %v", p2p.SyncBlockHashResponse, msg.Type())
@@ -100,28 +177,51 @@ func (b *blockHashSync) handleSyncBlockHashResponse(msg *p2p.IncomingMessage) {
 	err := proto.Unmarshal(msg.Data(), blockHashResponse)
 	if err != nil {
 		ilog.Warnf("Unmarshal block hash response failed: %v", err)
+		b.score.Invalid(msg.From())
 		return
 	}
 
-	// TODO: Prevent neighbor node attacks
-
-	if len(blockHashResponse.BlockInfos) > maxSyncRange {
+	if int64(len(blockHashResponse.BlockInfos)) > maxSyncRange {
 		ilog.Warnf("BlockInfos length %v exceed maxSyncRange %v", len(blockHashResponse.BlockInfos), maxSyncRange)
+		b.score.Invalid(msg.From())
+		return
+	}
+	if len(blockHashResponse.BlockInfos) == 0 {
 		return
 	}
 
-	hashs := make(map[int64][]byte)
+	start, end := blockHashResponse.BlockInfos[0].Number, blockHashResponse.BlockInfos[0].Number
 	for _, blockInfo := range blockHashResponse.BlockInfos {
-		hashs[blockInfo.Number] = blockInfo.Hash
+		if blockInfo.Number < start {
+			start = blockInfo.Number
+		}
+		if blockInfo.Number > end {
+			end = blockInfo.Number
+		}
 	}
 
 	b.mutex.Lock()
 	defer b.mutex.Unlock()
 
-	b.neighborBlockHashs[msg.From()] = &blockHashs{
-		hashs: hashs,
-		time:  time.Now().Unix(),
+	// Only the responses of our requests are accepted, so that the neighbor nodes can not fill in
+	// the hashs of any height.
+	if b.takeRequest(msg.From(), start, end) == nil {
+		ilog.Debugf("Discard the unrequested block hashs from %v, start: %v, end: %v.", msg.From().Pretty(), start, end)
+		return
+	}
+	b.score.Good(msg.From())
+
+	neighbor, ok := b.neighborBlockHashs[msg.From()]
+	if !ok {
+		neighbor = &blockHashs{
+			hashs: make(map[int64][]byte),
+		}
+		b.neighborBlockHashs[msg.From()] = neighbor
+	}
+	for _, blockInfo := range blockHashResponse.BlockInfos {
+		neighbor.hashs[blockInfo.Number] = blockInfo.Hash
 	}
+	neighbor.time = time.Now().Unix()
 }
 
 func (b *blockHashSync) syncBlockHashResponseController() {
@@ -140,18 +240,33 @@ func (b *blockHashSync) doExpiration() {
 	b.mutex.Lock()
 	defer b.mutex.Unlock()
 
-	now := time.Now().Unix()
+	now := time.Now()
 	for k, v := range b.neighborBlockHashs {
-		if v.time+BlockHashExpiredSeconds < now {
+		if v.time+BlockHashExpiredSeconds < now.Unix() {
 			delete(b.neighborBlockHashs, k)
 		}
 	}
+	for peerID, requests := range b.requests {
+		alive := requests[:0]
+		for _, r := range requests {
+			if now.After(r.deadline) {
+				b.score.Slow(peerID)
+				continue
+			}
+			alive = append(alive, r)
+		}
+		if len(alive) == 0 {
+			delete(b.requests, peerID)
+		} else {
+			b.requests[peerID] = alive
+		}
+	}
 }
 
 func (b *blockHashSync) expirationController() {
 	for {
 		select {
-		case <-time.After(2 * time.Second):
+		case <-time.After(expirationInterval):
 			b.doExpiration()
 		case <-b.quitCh:
 			b.done.Done()
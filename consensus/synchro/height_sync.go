@@ -70,6 +70,20 @@ func (h *heightSync) NeighborHeight() int64 {
 	return t[len(t)/2]
 }
 
+// Neighbors will return the neighbor nodes whose head height is not lower than the height.
+func (h *heightSync) Neighbors(height int64) []p2p.PeerID {
+	h.mutex.RLock()
+	defer h.mutex.RUnlock()
+
+	peers := make([]p2p.PeerID, 0, len(h.neighborHeight))
+	for peerID, v := range h.neighborHeight {
+		if v.Height >= height {
+			peers = append(peers, peerID)
+		}
+	}
+	return peers
+}
+
 func (h *heightSync) handleHeightSync(msg *p2p.IncomingMessage) {
 	if msg.Type() != p2p.SyncHeight {
 		ilog.Warnf("Expect the type %v, but get a unexpected type %v", p2p.SyncHeight, msg.Type())
@@ -0,0 +1,106 @@
+package synchro
+
+import (
+	"math/rand"
+	"sort"
+	"sync"
+
+	"github.com/iost-official/go-iost/ilog"
+	"github.com/iost-official/go-iost/p2p"
+)
+
+// Score changes of the neighbor nodes.
+const (
+	maxPeerScore     int64 = 100
+	minPeerScore     int64 = -100
+	bannedPeerScore  int64 = -50
+	goodResponse     int64 = 1
+	slowResponse     int64 = -5
+	invalidResponse  int64 = -20
+	peerScoreRecover int64 = 1
+)
+
+// peerScore scores the neighbor nodes by their responses.
+// A slow or invalid response lowers the score, and the peers whose score is lower than bannedPeerScore
+// are not requested any more until the score recovers.
+type peerScore struct {
+	scores map[p2p.PeerID]int64
+	mutex  *sync.RWMutex
+}
+
+func newPeerScore() *peerScore {
+	return &peerScore{
+		scores: make(map[p2p.PeerID]int64),
+		mutex:  new(sync.RWMutex),
+	}
+}
+
+func (p *peerScore) add(peerID p2p.PeerID, delta int64) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	score := p.scores[peerID] + delta
+	if score > maxPeerScore {
+		score = maxPeerScore
+	}
+	if score < minPeerScore {
+		score = minPeerScore
+	}
+	p.scores[peerID] = score
+}
+
+// Good is called when the peer responds in time.
+func (p *peerScore) Good(peerID p2p.PeerID) {
+	p.add(peerID, goodResponse)
+}
+
+// Slow is called when the peer does not respond in time.
+func (p *peerScore) Slow(peerID p2p.PeerID) {
+	ilog.Debugf("Peer %v responds slowly.", peerID.Pretty())
+	p.add(peerID, slowResponse)
+}
+
+// Invalid is called when the peer responds an invalid message.
+func (p *peerScore) Invalid(peerID p2p.PeerID) {
+	ilog.Warnf("Peer %v responds an invalid message.", peerID.Pretty())
+	p.add(peerID, invalidResponse)
+}
+
+// Score returns the score of the peer.
+func (p *peerScore) Score(peerID p2p.PeerID) int64 {
+	p.mutex.RLock()
+	defer p.mutex.RUnlock()
+
+	return p.scores[peerID]
+}
+
+// Pick returns at most n peers which are not banned, the peers with higher score are picked first.
+func (p *peerScore) Pick(peers []p2p.PeerID, n int) []p2p.PeerID {
+	p.mutex.RLock()
+	defer p.mutex.RUnlock()
+
+	picked := make([]p2p.PeerID, 0, len(peers))
+	for _, peerID := range peers {
+		if p.scores[peerID] > bannedPeerScore {
+			picked = append(picked, peerID)
+		}
+	}
+	rand.Shuffle(len(picked), func(i, j int) { picked[i], picked[j] = picked[j], picked[i] })
+	sort.SliceStable(picked, func(i, j int) bool { return p.scores[picked[i]] > p.scores[picked[j]] })
+	if len(picked) > n {
+		picked = picked[:n]
+	}
+	return picked
+}
+
+// Recover moves the negative scores towards zero, so that the banned peers can be requested again later.
+func (p *peerScore) Recover() {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	for peerID, score := range p.scores {
+		if score < 0 {
+			p.scores[peerID] = score + peerScoreRecover
+		}
+	}
+}
@@ -60,9 +60,9 @@ func (r *requestHandler) getBlockByHash(hash []byte) *block.Block {
 	return block
 }
 
-func (r *requestHandler) getBlockHashResponse(start int64, end int64) *msgpb.BlockHashResponse {
+func (r *requestHandler) getBlockHashResponse(nums []int64) *msgpb.BlockHashResponse {
 	blockInfos := make([]*msgpb.BlockInfo, 0)
-	for num := start; num <= end; num++ {
+	for _, num := range nums {
 		// This code is ugly and then optimize the bCache and bChain.
 		var hash []byte
 		if blk, err := r.bCache.GetBlockByNumber(num); err != nil {
@@ -93,14 +93,32 @@ func (r *requestHandler) handleBlockHashRequest(request *p2p.IncomingMessage) {
 		return
 	}
 
-	if (blockHashQuery.Start < 0) ||
-		(blockHashQuery.Start > blockHashQuery.End) ||
-		(blockHashQuery.End-blockHashQuery.Start+1 > maxSyncRange) {
-		ilog.Warnf("Receive attack request from peer %v, start: %v, end: %v.", request.From().Pretty(), blockHashQuery.Start, blockHashQuery.End)
-		return
+	var nums []int64
+	switch blockHashQuery.ReqType {
+	case msgpb.RequireType_GETBLOCKHASHES:
+		if (blockHashQuery.Start < 0) ||
+			(blockHashQuery.Start > blockHashQuery.End) ||
+			(blockHashQuery.End-blockHashQuery.Start+1 > maxSyncRange) {
+			ilog.Warnf("Receive attack request from peer %v, start: %v, end: %v.", request.From().Pretty(), blockHashQuery.Start, blockHashQuery.End)
+			return
+		}
+		head := r.bCache.Head().Head.Number
+		for num := blockHashQuery.Start; num <= blockHashQuery.End && num <= head; num++ {
+			nums = append(nums, num)
+		}
+	case msgpb.RequireType_GETBLOCKHASHESBYNUMBER:
+		// The requests of the legacy synchronizer.
+		if int64(len(blockHashQuery.Nums)) > maxSyncRange {
+			ilog.Warnf("Receive attack request from peer %v, nums length: %v.", request.From().Pretty(), len(blockHashQuery.Nums))
+			return
+		}
+		nums = blockHashQuery.Nums
 	}
 
-	blockHashResponse := r.getBlockHashResponse(blockHashQuery.Start, blockHashQuery.End)
+	blockHashResponse := r.getBlockHashResponse(nums)
+	if len(blockHashResponse.BlockInfos) == 0 {
+		return
+	}
 
 	msg, err := proto.Marshal(blockHashResponse)
 	if err != nil {
@@ -144,13 +162,9 @@ func (r *requestHandler) controller() {
 			default:
 				ilog.Warnf("Unexcept request type: %v", request.Type())
 			}
-		default:
-		}
-		select {
 		case <-r.quitCh:
 			r.done.Done()
 			return
-		default:
 		}
 	}
 }
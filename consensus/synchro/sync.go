@@ -1,7 +1,6 @@
 package synchro
 
 import (
-	"math/rand"
 	"sync"
 	"time"
 
@@ -9,12 +8,24 @@ import (
 	"github.com/iost-official/go-iost/consensus/synchronizer/pb"
 	"github.com/iost-official/go-iost/core/block"
 	"github.com/iost-official/go-iost/core/blockcache"
+	"github.com/iost-official/go-iost/core/global"
 	"github.com/iost-official/go-iost/ilog"
 	"github.com/iost-official/go-iost/p2p"
 )
 
-const (
-	maxSyncRange = 1000
+var (
+	maxSyncRange int64 = 1000
+	// maxSyncRanges is the number of ranges synced in parallel.
+	maxSyncRanges int64 = 4
+	// syncRangePeers is the number of neighbor nodes requested for the block hashs of each range.
+	syncRangePeers = 3
+
+	heightSyncInterval    = 1 * time.Second
+	blockhashSyncInterval = 2 * time.Second
+	blockSyncInterval     = 2 * time.Second
+	expirationInterval    = 2 * time.Second
+	scoreRecoverInterval  = 10 * time.Second
+	initInterval          = 5 * time.Second
 )
 
 // Sync is the synchronizer of blockchain.
@@ -23,7 +34,9 @@ type Sync struct {
 	p      p2p.Service
 	bCache blockcache.BlockCache
 	bChain block.Chain
+	bv     global.BaseVariable
 
+	score         *peerScore
 	handler       *requestHandler
 	heightSync    *heightSync
 	blockhashSync *blockHashSync
@@ -34,31 +47,40 @@ type Sync struct {
 }
 
 // New will return a new synchronizer of blockchain.
-func New(p p2p.Service, bCache blockcache.BlockCache, bChain block.Chain) *Sync {
+func New(p p2p.Service, bCache blockcache.BlockCache, bv global.BaseVariable) *Sync {
+	score := newPeerScore()
 	sync := &Sync{
 		p:      p,
 		bCache: bCache,
-		bChain: bChain,
+		bChain: bv.BlockChain(),
+		bv:     bv,
 
-		handler:       newRequestHandler(p, bCache, bChain),
+		score:         score,
+		handler:       newRequestHandler(p, bCache, bv.BlockChain()),
 		heightSync:    newHeightSync(p),
-		blockhashSync: newBlockHashSync(p),
-		blockSync:     newBlockSync(p),
+		blockhashSync: newBlockHashSync(p, score),
+		blockSync:     newBlockSync(p, score),
 
 		quitCh: make(chan struct{}),
 		done:   new(sync.WaitGroup),
 	}
 
-	sync.done.Add(3)
-	go sync.heightSyncController()
-	go sync.blockhashSyncController()
-	go sync.blockSyncController()
-
 	return sync
 }
 
-// Close will close the synchronizer of blockchain.
-func (s *Sync) Close() {
+// Start will start the synchronizer of blockchain.
+func (s *Sync) Start() error {
+	s.done.Add(5)
+	go s.initializer()
+	go s.heightSyncController()
+	go s.blockhashSyncController()
+	go s.blockSyncController()
+	go s.scoreController()
+	return nil
+}
+
+// Stop will stop the synchronizer of blockchain.
+func (s *Sync) Stop() {
 	s.handler.Close()
 	s.heightSync.Close()
 	s.blockhashSync.Close()
@@ -69,10 +91,48 @@ func (s *Sync) Close() {
 	ilog.Infof("Stopped sync.")
 }
 
-// IncommingBlock will return the blocks from other nodes.
-// Including passive request and active broadcast.
-func (s *Sync) IncommingBlock() <-chan *BlockMessage {
-	return s.blockSync.IncommingBlock()
+// OnVerify should be called with the block once the consensus verifies it,
+// the neighbor node which responded the block is scored by the result.
+func (s *Sync) OnVerify(blk *block.Block, err error) {
+	s.blockSync.OnVerify(blk, err)
+}
+
+func (s *Sync) initializer() {
+	defer s.done.Done()
+	if s.bv.Mode() != global.ModeInit {
+		return
+	}
+	select {
+	case <-time.After(initInterval):
+		if s.bChain.Length() == 0 {
+			ilog.Errorf("block chain is empty")
+			return
+		}
+		s.bv.SetMode(global.ModeNormal)
+	case <-s.quitCh:
+	}
+}
+
+// syncThreshold returns the number of blocks behind the neighbor nodes, over which the node switches to sync mode.
+func (s *Sync) syncThreshold() int64 {
+	return int64(len(s.bCache.LinkedRoot().Active()) * s.bv.Continuous())
+}
+
+func (s *Sync) checkMode() {
+	head := s.bCache.Head().Head.Number
+	neighborHeight := s.heightSync.NeighborHeight()
+	switch s.bv.Mode() {
+	case global.ModeNormal:
+		if neighborHeight > head+s.syncThreshold() {
+			ilog.Infof("Start syncing blocks, head: %v, neighbor height: %v.", head, neighborHeight)
+			s.bv.SetMode(global.ModeSync)
+		}
+	case global.ModeSync:
+		if neighborHeight <= head {
+			ilog.Infof("Finish syncing blocks, head: %v, neighbor height: %v.", head, neighborHeight)
+			s.bv.SetMode(global.ModeNormal)
+		}
+	}
 }
 
 func (s *Sync) doHeightSync() {
@@ -91,8 +151,9 @@ func (s *Sync) doHeightSync() {
 func (s *Sync) heightSyncController() {
 	for {
 		select {
-		case <-time.After(1 * time.Second):
+		case <-time.After(heightSyncInterval):
 			s.doHeightSync()
+			s.checkMode()
 		case <-s.quitCh:
 			s.done.Done()
 			return
@@ -100,34 +161,39 @@ func (s *Sync) heightSyncController() {
 	}
 }
 
-func (s *Sync) doBlockhashSync() {
+// syncRange returns the heights to be synced, which may be split into at most maxSyncRanges ranges.
+func (s *Sync) syncRange() (int64, int64) {
 	start := s.bCache.LinkedRoot().Head.Number + 1
 	end := s.heightSync.NeighborHeight()
+	if end-start+1 > maxSyncRange*maxSyncRanges {
+		end = start + maxSyncRange*maxSyncRanges - 1
+	}
+	return start, end
+}
+
+func (s *Sync) doBlockhashSync() {
+	start, end := s.syncRange()
 	if start > end {
 		return
 	}
-	if end-start+1 > maxSyncRange {
-		end = start + maxSyncRange - 1
-	}
+	s.blockhashSync.Prune(start)
 
-	blockHashQuery := &msgpb.BlockHashQuery{
-		ReqType: msgpb.RequireType_GETBLOCKHASHES,
-		Start:   start,
-		End:     end,
-		Nums:    nil,
-	}
-	msg, err := proto.Marshal(blockHashQuery)
-	if err != nil {
-		ilog.Errorf("Marshal sync block hash message failed: %v", err)
-		return
+	// Different ranges are requested from different neighbor nodes in parallel if possible.
+	for from := start; from <= end; from += maxSyncRange {
+		to := from + maxSyncRange - 1
+		if to > end {
+			to = end
+		}
+		for _, peerID := range s.score.Pick(s.heightSync.Neighbors(from), syncRangePeers) {
+			s.blockhashSync.RequestBlockHash(from, to, peerID)
+		}
 	}
-	s.p.Broadcast(msg, p2p.SyncBlockHashRequest, p2p.UrgentMessage)
 }
 
 func (s *Sync) blockhashSyncController() {
 	for {
 		select {
-		case <-time.After(2 * time.Second):
+		case <-time.After(blockhashSyncInterval):
 			s.doBlockhashSync()
 		case <-s.quitCh:
 			s.done.Done()
@@ -137,30 +203,31 @@ func (s *Sync) blockhashSyncController() {
 }
 
 func (s *Sync) doBlockSync() {
-	start := s.bCache.LinkedRoot().Head.Number + 1
-	end := s.heightSync.NeighborHeight()
+	start, end := s.syncRange()
 	if start > end {
 		return
 	}
-	if end-start+1 > maxSyncRange {
-		end = start + maxSyncRange - 1
-	}
 
 	for blockHash := range s.blockhashSync.NeighborBlockHashs(start, end) {
 		if block, err := s.bCache.GetBlockByHash(blockHash.Hash); err == nil && block != nil {
 			continue
 		}
+		if s.blockSync.Requested(blockHash.Hash) {
+			continue
+		}
 
-		rand.Seed(time.Now().UnixNano())
-		peerID := blockHash.PeerID[rand.Int()%len(blockHash.PeerID)]
-		s.blockSync.RequestBlock(blockHash.Hash, peerID)
+		for _, peerID := range s.score.Pick(blockHash.PeerID, len(blockHash.PeerID)) {
+			if s.blockSync.RequestBlock(blockHash.Hash, peerID) {
+				break
+			}
+		}
 	}
 }
 
 func (s *Sync) blockSyncController() {
 	for {
 		select {
-		case <-time.After(2 * time.Second):
+		case <-time.After(blockSyncInterval):
 			s.doBlockSync()
 		case <-s.quitCh:
 			s.done.Done()
@@ -168,3 +235,15 @@ func (s *Sync) blockSyncController() {
 		}
 	}
 }
+
+func (s *Sync) scoreController() {
+	for {
+		select {
+		case <-time.After(scoreRecoverInterval):
+			s.score.Recover()
+		case <-s.quitCh:
+			s.done.Done()
+			return
+		}
+	}
+}
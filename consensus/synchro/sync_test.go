@@ -0,0 +1,378 @@
+package synchro
+
+import (
+	"encoding/json"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/golang/protobuf/proto"
+	"github.com/iost-official/go-iost/common"
+	"github.com/iost-official/go-iost/consensus/cverifier"
+	"github.com/iost-official/go-iost/consensus/synchronizer/pb"
+	"github.com/iost-official/go-iost/core/block"
+	"github.com/iost-official/go-iost/core/blockcache"
+	"github.com/iost-official/go-iost/core/global"
+	core_mock "github.com/iost-official/go-iost/core/mocks"
+	"github.com/iost-official/go-iost/core/tx"
+	"github.com/iost-official/go-iost/crypto"
+	db_mock "github.com/iost-official/go-iost/db/mocks"
+	"github.com/iost-official/go-iost/ilog"
+	"github.com/iost-official/go-iost/p2p"
+	p2p_mock "github.com/iost-official/go-iost/p2p/mocks"
+	"github.com/iost-official/go-iost/vm/database"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// Behaviours of the neighbor nodes in the test network.
+const (
+	goodPeer = iota
+	silentPeer
+	badPeer
+	// forgedPeer responds the requested block head with other transactions.
+	forgedPeer
+)
+
+// testNetwork simulates the neighbor nodes behind a mock p2p.Service.
+type testNetwork struct {
+	mu        sync.Mutex
+	chs       map[p2p.MessageType]chan p2p.IncomingMessage
+	blocks    []*block.Block
+	peers     map[p2p.PeerID]int
+	hashReqs  map[p2p.PeerID][]*msgpb.BlockHashQuery
+	blockReqs map[p2p.PeerID]int
+	served    map[p2p.PeerID][]byte
+}
+
+func genChain(n int) []*block.Block {
+	blocks := make([]*block.Block, 0, n+1)
+	var parent []byte
+	for i := 0; i <= n; i++ {
+		blk := &block.Block{
+			Head: &block.BlockHead{
+				ParentHash: parent,
+				Witness:    "w0",
+				Number:     int64(i),
+				Time:       int64(i),
+			},
+			Sign:     &crypto.Signature{},
+			Txs:      []*tx.Tx{},
+			Receipts: []*tx.TxReceipt{},
+		}
+		blk.Head.TxMerkleHash = blk.CalculateTxMerkleHash()
+		blk.Head.TxReceiptMerkleHash = blk.CalculateTxReceiptMerkleHash()
+		blk.CalculateHeadHash()
+		parent = blk.HeadHash()
+		blocks = append(blocks, blk)
+	}
+	return blocks
+}
+
+func (n *testNetwork) send(from p2p.PeerID, data []byte, typ p2p.MessageType) {
+	n.mu.Lock()
+	ch := n.chs[typ]
+	n.mu.Unlock()
+	ch <- *p2p.NewIncomingMessage(from, data, typ)
+}
+
+func (n *testNetwork) sendToPeer(peerID p2p.PeerID, data []byte, typ p2p.MessageType) {
+	n.mu.Lock()
+	behaviour := n.peers[peerID]
+	var resp []byte
+	var respType p2p.MessageType
+	switch typ {
+	case p2p.SyncBlockHashRequest:
+		query := &msgpb.BlockHashQuery{}
+		proto.Unmarshal(data, query)
+		n.hashReqs[peerID] = append(n.hashReqs[peerID], query)
+		blockHashResponse := &msgpb.BlockHashResponse{}
+		for num := query.Start; num <= query.End && num < int64(len(n.blocks)); num++ {
+			blockHashResponse.BlockInfos = append(blockHashResponse.BlockInfos, &msgpb.BlockInfo{
+				Number: num,
+				Hash:   n.blocks[num].HeadHash(),
+			})
+		}
+		resp, _ = proto.Marshal(blockHashResponse)
+		respType = p2p.SyncBlockHashResponse
+	case p2p.SyncBlockRequest:
+		n.blockReqs[peerID]++
+		blockInfo := &msgpb.BlockInfo{}
+		proto.Unmarshal(data, blockInfo)
+		for _, blk := range n.blocks {
+			if string(blk.HeadHash()) == string(blockInfo.Hash) {
+				if behaviour == forgedPeer {
+					forged := *blk
+					t := tx.NewTx(nil, nil, 1000, 1, 300, 0, 0)
+					forged.Txs = []*tx.Tx{t}
+					forged.Receipts = []*tx.TxReceipt{tx.NewTxReceipt(t.Hash())}
+					blk = &forged
+				}
+				resp, _ = blk.Encode()
+			}
+		}
+		n.served[peerID] = resp
+		respType = p2p.SyncBlockResponse
+	}
+	n.mu.Unlock()
+
+	switch behaviour {
+	case goodPeer, forgedPeer:
+		go n.send(peerID, resp, respType)
+	case badPeer:
+		go n.send(peerID, []byte("invalid"), respType)
+	}
+}
+
+func (n *testNetwork) hashRequests(peerID p2p.PeerID) []*msgpb.BlockHashQuery {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return n.hashReqs[peerID]
+}
+
+// servedBlock returns the last block responded by the peer.
+func (n *testNetwork) servedBlock(peerID p2p.PeerID) *block.Block {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	blk := &block.Block{}
+	blk.Decode(n.served[peerID])
+	return blk
+}
+
+func (n *testNetwork) blockRequests(peerID p2p.PeerID) int {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return n.blockReqs[peerID]
+}
+
+func newTestNetwork(ctl *gomock.Controller, blocks []*block.Block, peers map[p2p.PeerID]int) (*testNetwork, *p2p_mock.MockService) {
+	n := &testNetwork{
+		chs:       make(map[p2p.MessageType]chan p2p.IncomingMessage),
+		blocks:    blocks,
+		peers:     peers,
+		hashReqs:  make(map[p2p.PeerID][]*msgpb.BlockHashQuery),
+		blockReqs: make(map[p2p.PeerID]int),
+		served:    make(map[p2p.PeerID][]byte),
+	}
+	p := p2p_mock.NewMockService(ctl)
+	p.EXPECT().Register(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(func(id string, typs ...p2p.MessageType) chan p2p.IncomingMessage {
+		return n.register(typs...)
+	})
+	p.EXPECT().Register(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(func(id string, typs ...p2p.MessageType) chan p2p.IncomingMessage {
+		return n.register(typs...)
+	})
+	p.EXPECT().Broadcast(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	p.EXPECT().SendToPeer(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().Do(func(peerID p2p.PeerID, data []byte, typ p2p.MessageType, _ p2p.MessagePriority) {
+		n.sendToPeer(peerID, data, typ)
+	})
+	return n, p
+}
+
+func (n *testNetwork) register(typs ...p2p.MessageType) chan p2p.IncomingMessage {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	ch := make(chan p2p.IncomingMessage, 1024)
+	for _, typ := range typs {
+		n.chs[typ] = ch
+	}
+	return ch
+}
+
+// announce sends the head height of all the neighbor nodes.
+func (n *testNetwork) announce() {
+	for peerID := range n.peers {
+		msg, _ := proto.Marshal(&msgpb.SyncHeight{
+			Height: int64(len(n.blocks) - 1),
+			Time:   time.Now().Unix(),
+		})
+		n.send(peerID, msg, p2p.SyncHeight)
+	}
+}
+
+func newTestBaseVariable(ctl *gomock.Controller, genesis *block.Block) global.BaseVariable {
+	base := core_mock.NewMockChain(ctl)
+	base.EXPECT().Top().AnyTimes().Return(genesis, nil)
+	base.EXPECT().Length().AnyTimes().Return(int64(1))
+	base.EXPECT().GetBlockByHash(gomock.Any()).AnyTimes().Return(nil, os.ErrNotExist)
+	statedb := db_mock.NewMockMVCCDB(ctl)
+	statedb.EXPECT().Get("state", "b-vote_producer.iost-"+"pendingBlockNumber").AnyTimes().DoAndReturn(func(table string, key string) (string, error) {
+		return database.MustMarshal("0"), nil
+	})
+	statedb.EXPECT().Get("state", "b-vote_producer.iost-"+"pendingProducerList").AnyTimes().DoAndReturn(func(table string, key string) (string, error) {
+		return database.MustMarshal(`["w0"]`), nil
+	})
+	statedb.EXPECT().Get("snapshot", "blockHead").AnyTimes().DoAndReturn(func(table string, key string) (string, error) {
+		bhJSON, _ := json.Marshal(genesis.Head)
+		return string(bhJSON), nil
+	})
+	statedb.EXPECT().Get("state", gomock.Any()).AnyTimes().DoAndReturn(func(table string, key string) (string, error) {
+		return database.MustMarshal(`{"loc":"11","url":"22","netId":"33","online":true,"score":0,"votes":0}`), nil
+	})
+	statedb.EXPECT().Fork().AnyTimes().Return(statedb)
+	statedb.EXPECT().Checkout(gomock.Any()).AnyTimes().Return(true)
+	bv := core_mock.NewMockBaseVariable(ctl)
+	bv.EXPECT().StateDB().AnyTimes().Return(statedb)
+	bv.EXPECT().BlockChain().AnyTimes().Return(base)
+	bv.EXPECT().Config().AnyTimes().Return(&common.Config{
+		DB: &common.DBConfig{
+			LdbPath: "SyncTestDB/",
+		},
+		Snapshot: &common.SnapshotConfig{
+			Enable: false,
+		},
+	})
+	bv.EXPECT().Continuous().AnyTimes().Return(1)
+	bv.EXPECT().Mode().AnyTimes().Return(global.ModeNormal)
+	bv.EXPECT().SetMode(gomock.Any()).AnyTimes()
+	return bv
+}
+
+func waitFor(cond func() bool) bool {
+	for i := 0; i < 100; i++ {
+		if cond() {
+			return true
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	return false
+}
+
+func TestSync(t *testing.T) {
+	ilog.Stop()
+	oldMaxSyncRange, oldExpirationInterval := maxSyncRange, expirationInterval
+	maxSyncRange, expirationInterval = 10, time.Hour
+	defer func() {
+		maxSyncRange, expirationInterval = oldMaxSyncRange, oldExpirationInterval
+	}()
+
+	Convey("Test Sync", t, func() {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		defer os.RemoveAll("SyncTestDB/")
+
+		blocks := genChain(25)
+		peers := map[p2p.PeerID]int{
+			"good0":  goodPeer,
+			"good1":  goodPeer,
+			"good2":  goodPeer,
+			"silent": silentPeer,
+			"bad":    badPeer,
+			"forged": forgedPeer,
+		}
+		network, p := newTestNetwork(ctl, blocks, peers)
+		bv := newTestBaseVariable(ctl, blocks[0])
+		blockcache.CleanBlockCacheWAL()
+		bCache, err := blockcache.NewBlockCache(bv)
+		So(err, ShouldBeNil)
+
+		s := New(p, bCache, bv)
+		defer s.Stop()
+		network.announce()
+		So(waitFor(func() bool { return s.heightSync.NeighborHeight() == 25 }), ShouldBeTrue)
+
+		Convey("Parallel ranges", func() {
+			s.doBlockhashSync()
+			ranges := make(map[int64]int)
+			for peerID := range peers {
+				for _, q := range network.hashRequests(peerID) {
+					So(q.End-q.Start+1, ShouldBeLessThanOrEqualTo, maxSyncRange)
+					ranges[q.Start]++
+				}
+			}
+			So(ranges, ShouldResemble, map[int64]int{1: syncRangePeers, 11: syncRangePeers, 21: syncRangePeers})
+		})
+
+		Convey("Download blocks", func() {
+			s.score.add("silent", bannedPeerScore)
+			s.score.add("bad", bannedPeerScore)
+			s.score.add("forged", bannedPeerScore)
+			s.doBlockhashSync()
+			So(len(network.hashRequests("silent")), ShouldEqual, 0)
+			So(len(network.hashRequests("bad")), ShouldEqual, 0)
+			So(waitFor(func() bool {
+				count := 0
+				for blockHash := range s.blockhashSync.NeighborBlockHashs(1, 25) {
+					So(blockHash.Hash, ShouldResemble, blocks[findNumber(blocks, blockHash.Hash)].HeadHash())
+					count++
+				}
+				return count == 25
+			}), ShouldBeTrue)
+
+			s.doBlockSync()
+			So(network.blockRequests("silent"), ShouldEqual, 0)
+			So(network.blockRequests("bad"), ShouldEqual, 0)
+			So(waitFor(func() bool {
+				s.blockSync.mutex.Lock()
+				defer s.blockSync.mutex.Unlock()
+				return len(s.blockSync.inflight) == 0
+			}), ShouldBeTrue)
+			total := 0
+			for _, peerID := range []p2p.PeerID{"good0", "good1", "good2"} {
+				total += network.blockRequests(peerID)
+			}
+			So(total, ShouldEqual, 25)
+		})
+
+		Convey("Verify blocks", func() {
+			So(s.blockSync.RequestBlock(blocks[1].HeadHash(), "good0"), ShouldBeTrue)
+			So(s.blockSync.RequestBlock(blocks[2].HeadHash(), "forged"), ShouldBeTrue)
+			So(waitFor(func() bool {
+				s.blockSync.mutex.Lock()
+				defer s.blockSync.mutex.Unlock()
+				return len(s.blockSync.inflight) == 0
+			}), ShouldBeTrue)
+
+			// The peers are not scored until the consensus verifies the blocks.
+			So(s.score.Score("good0"), ShouldEqual, int64(0))
+			So(s.score.Score("forged"), ShouldEqual, int64(0))
+			So(s.blockSync.Requested(blocks[2].HeadHash()), ShouldBeTrue)
+
+			for _, peerID := range []p2p.PeerID{"good0", "forged"} {
+				blk := network.servedBlock(peerID)
+				s.OnVerify(blk, cverifier.VerifyBlockHead(blk, blocks[blk.Head.Number-1]))
+			}
+			So(s.score.Score("good0"), ShouldEqual, goodResponse)
+			So(s.score.Score("forged"), ShouldEqual, invalidResponse)
+			So(s.blockSync.Requested(blocks[1].HeadHash()), ShouldBeFalse)
+			So(s.blockSync.Requested(blocks[2].HeadHash()), ShouldBeFalse)
+
+			// The block is only scored once.
+			s.OnVerify(blocks[1], nil)
+			So(s.score.Score("good0"), ShouldEqual, goodResponse)
+		})
+
+		Convey("Peer score", func() {
+			blockHashRequestTimeout = 0
+			defer func() { blockHashRequestTimeout = 5 * time.Second }()
+			for i := 0; i < 3; i++ {
+				for peerID := range peers {
+					s.blockhashSync.RequestBlockHash(1, 10, peerID)
+				}
+			}
+			So(waitFor(func() bool { return s.score.Score("bad") <= bannedPeerScore }), ShouldBeTrue)
+			time.Sleep(10 * time.Millisecond)
+			s.blockhashSync.doExpiration()
+			So(s.score.Score("good0"), ShouldBeGreaterThan, 0)
+			So(s.score.Score("silent"), ShouldBeLessThan, 0)
+
+			picked := s.score.Pick([]p2p.PeerID{"good0", "good1", "good2", "silent", "bad"}, 5)
+			So(len(picked), ShouldEqual, 4)
+			So(picked[3], ShouldEqual, p2p.PeerID("silent"))
+
+			for i := int64(0); i < -invalidResponse*3; i++ {
+				s.score.Recover()
+			}
+			So(len(s.score.Pick([]p2p.PeerID{"bad"}, 1)), ShouldEqual, 1)
+		})
+	})
+}
+
+func findNumber(blocks []*block.Block, hash []byte) int64 {
+	for _, blk := range blocks {
+		if string(blk.HeadHash()) == string(hash) {
+			return blk.Head.Number
+		}
+	}
+	return -1
+}
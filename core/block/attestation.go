@@ -0,0 +1,404 @@
+package block
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/iost-official/go-iost/account"
+	"github.com/iost-official/go-iost/common"
+	blockpb "github.com/iost-official/go-iost/core/block/pb"
+	"github.com/iost-official/go-iost/crypto"
+	"github.com/iost-official/go-iost/crypto/bls"
+)
+
+// An attestation proves that a block is irreversible with the votes of more than 2/3 of the witnesses.
+// The voted witnesses are marked by a bitmap over the witness list, and the attestation is verified as a unit.
+//
+// In the aggregate mode, the witnesses with a BLS key also sign their votes by it, and the BLS signatures
+// aggregate into a single signature of constant size, verified by one pairing check against the BLS keys
+// of the voted witnesses. Otherwise the attestation carries the signature of every voted witness in order.
+
+var (
+	attestationDomain = []byte("LIBAttestation")
+	witnessKeyDomain  = []byte("WitnessBLSKey")
+)
+
+// AttestationMessage returns the message signed by the witnesses to vote for the irreversible block.
+func AttestationMessage(number int64, blockHash []byte) []byte {
+	se := common.NewSimpleEncoder()
+	se.WriteBytes(attestationDomain)
+	se.WriteInt64(number)
+	se.WriteBytes(blockHash)
+	return common.Sha3(se.Bytes())
+}
+
+// WitnessKeyMessage returns the message signed by the witness to bind its BLS public key.
+func WitnessKeyMessage(pubkey []byte) []byte {
+	se := common.NewSimpleEncoder()
+	se.WriteBytes(witnessKeyDomain)
+	se.WriteBytes(pubkey)
+	return common.Sha3(se.Bytes())
+}
+
+// WitnessKey is the BLS public key of a witness for the aggregate attestations. It is signed by the witness
+// and carries the proof of possession of the BLS secret key, so that it is verified without trusting its source.
+type WitnessKey struct {
+	Pubkey []byte
+	Proof  []byte
+	Sign   *crypto.Signature
+}
+
+// NewWitnessKey returns the witness key of the BLS secret key, the sign function returns the signature of the witness
+// for the BLS public key, such as Signer.SignWitnessKey.
+func NewWitnessKey(blsSeckey []byte, sign func(blsPubkey []byte) (*crypto.Signature, error)) (*WitnessKey, error) {
+	pubkey, err := bls.GetPubkey(blsSeckey)
+	if err != nil {
+		return nil, err
+	}
+	proof, err := bls.Prove(blsSeckey)
+	if err != nil {
+		return nil, err
+	}
+	sig, err := sign(pubkey)
+	if err != nil {
+		return nil, err
+	}
+	return &WitnessKey{
+		Pubkey: pubkey,
+		Proof:  proof,
+		Sign:   sig,
+	}, nil
+}
+
+// Witness returns the readable public key of the witness.
+func (k *WitnessKey) Witness() string {
+	return account.EncodePubkey(k.Sign.Pubkey)
+}
+
+// Verify verifies the signature of the witness and the proof of possession of the BLS key.
+func (k *WitnessKey) Verify() error {
+	if k.Sign == nil || !k.Sign.Verify(WitnessKeyMessage(k.Pubkey)) {
+		return errors.New("wrong signature of witness key")
+	}
+	if !bls.VerifyProof(k.Pubkey, k.Proof) {
+		return errors.New("wrong proof of witness key")
+	}
+	return nil
+}
+
+func (k *WitnessKey) toPb() *blockpb.WitnessKey {
+	kr := &blockpb.WitnessKey{
+		Pubkey: k.Pubkey,
+		Proof:  k.Proof,
+	}
+	if k.Sign != nil {
+		kr.Sign = k.Sign.ToPb()
+	}
+	return kr
+}
+
+func (k *WitnessKey) fromPb(kr *blockpb.WitnessKey) *WitnessKey {
+	k.Pubkey = kr.Pubkey
+	k.Proof = kr.Proof
+	if kr.Sign != nil {
+		k.Sign = (&crypto.Signature{}).FromPb(kr.Sign)
+	}
+	return k
+}
+
+// Encode is marshal
+func (k *WitnessKey) Encode() ([]byte, error) {
+	b, err := proto.Marshal(k.toPb())
+	if err != nil {
+		return nil, errors.New("fail to encode witness key")
+	}
+	return b, nil
+}
+
+// Decode is unmarshal
+func (k *WitnessKey) Decode(b []byte) error {
+	kr := &blockpb.WitnessKey{}
+	err := proto.Unmarshal(b, kr)
+	if err != nil || kr.Sign == nil {
+		return errors.New("fail to decode witness key")
+	}
+	k.fromPb(kr)
+	return nil
+}
+
+// AttestationVote is the vote of a witness for the irreversible block.
+type AttestationVote struct {
+	Number    int64
+	BlockHash []byte
+	Sign      *crypto.Signature
+	// BLSSign is the BLS signature of the vote by the witness key Key, set by the witnesses in the aggregate mode.
+	BLSSign []byte
+	Key     *WitnessKey
+}
+
+// NewAttestationVote returns a vote for the irreversible block signed by the key pair.
+func NewAttestationVote(number int64, blockHash []byte, kp *account.KeyPair) *AttestationVote {
+	return &AttestationVote{
+		Number:    number,
+		BlockHash: blockHash,
+		Sign:      kp.Sign(AttestationMessage(number, blockHash)),
+	}
+}
+
+// Witness returns the readable public key of the voter.
+func (v *AttestationVote) Witness() string {
+	return account.EncodePubkey(v.Sign.Pubkey)
+}
+
+// SignBLS signs the vote by the BLS secret key of the witness key, for the aggregate attestation.
+func (v *AttestationVote) SignBLS(blsSeckey []byte, key *WitnessKey) error {
+	sig, err := bls.Sign(AttestationMessage(v.Number, v.BlockHash), blsSeckey)
+	if err != nil {
+		return err
+	}
+	v.BLSSign = sig
+	v.Key = key
+	return nil
+}
+
+// Verify verifies the signatures of the vote. The witness key itself is verified apart by WitnessKey.Verify,
+// as it is the same for all the votes of the witness.
+func (v *AttestationVote) Verify() bool {
+	msg := AttestationMessage(v.Number, v.BlockHash)
+	if v.Sign == nil || !v.Sign.Verify(msg) {
+		return false
+	}
+	if v.BLSSign == nil && v.Key == nil {
+		return true
+	}
+	return v.Key != nil && v.Key.Sign != nil && v.Key.Witness() == v.Witness() && bls.Verify(msg, v.Key.Pubkey, v.BLSSign)
+}
+
+// Encode is marshal
+func (v *AttestationVote) Encode() ([]byte, error) {
+	vr := &blockpb.AttestationVote{
+		Number:    v.Number,
+		BlockHash: v.BlockHash,
+		BlsSign:   v.BLSSign,
+	}
+	if v.Sign != nil {
+		vr.Sign = v.Sign.ToPb()
+	}
+	if v.Key != nil {
+		vr.Key = v.Key.toPb()
+	}
+	b, err := proto.Marshal(vr)
+	if err != nil {
+		return nil, errors.New("fail to encode attestation vote")
+	}
+	return b, nil
+}
+
+// Decode is unmarshal
+func (v *AttestationVote) Decode(b []byte) error {
+	vr := &blockpb.AttestationVote{}
+	err := proto.Unmarshal(b, vr)
+	if err != nil || vr.Sign == nil {
+		return errors.New("fail to decode attestation vote")
+	}
+	v.Number = vr.Number
+	v.BlockHash = vr.BlockHash
+	v.Sign = (&crypto.Signature{}).FromPb(vr.Sign)
+	v.BLSSign = vr.BlsSign
+	v.Key = nil
+	if vr.Key != nil {
+		v.Key = (&WitnessKey{}).fromPb(vr.Key)
+	}
+	return nil
+}
+
+// Attestation is the collected votes of the witnesses for an irreversible block.
+type Attestation struct {
+	Number    int64
+	BlockHash []byte
+	Witnesses []string
+	Bitmap    []byte
+	Signs     []*crypto.Signature
+	// Aggregate is the aggregate BLS signature of the voted witnesses in the aggregate mode, Signs is empty then.
+	Aggregate []byte
+}
+
+// AttestationThreshold returns the number of votes needed by the witness list.
+func AttestationThreshold(witnessNum int) int {
+	return witnessNum*2/3 + 1
+}
+
+// NewAttestation collects the votes of the witnesses, and returns nil if the votes are not enough.
+func NewAttestation(number int64, blockHash []byte, witnesses []string, votes map[string]*AttestationVote) *Attestation {
+	a := &Attestation{
+		Number:    number,
+		BlockHash: blockHash,
+		Witnesses: witnesses,
+		Bitmap:    make([]byte, (len(witnesses)+7)/8),
+		Signs:     make([]*crypto.Signature, 0),
+	}
+	for i, w := range witnesses {
+		v, ok := votes[w]
+		if !ok {
+			continue
+		}
+		a.Bitmap[i/8] |= 1 << uint(i%8)
+		a.Signs = append(a.Signs, &crypto.Signature{
+			Algorithm: v.Sign.Algorithm,
+			Sig:       v.Sign.Sig,
+		})
+	}
+	if len(a.Signs) < AttestationThreshold(len(witnesses)) {
+		return nil
+	}
+	return a
+}
+
+// NewAggregateAttestation aggregates the BLS signatures of the votes of the witnesses,
+// and returns nil if the votes signed by BLS keys are not enough.
+func NewAggregateAttestation(number int64, blockHash []byte, witnesses []string, votes map[string]*AttestationVote) *Attestation {
+	a := &Attestation{
+		Number:    number,
+		BlockHash: blockHash,
+		Witnesses: witnesses,
+		Bitmap:    make([]byte, (len(witnesses)+7)/8),
+	}
+	sigs := make([][]byte, 0)
+	for i, w := range witnesses {
+		v, ok := votes[w]
+		if !ok || v.BLSSign == nil {
+			continue
+		}
+		a.Bitmap[i/8] |= 1 << uint(i%8)
+		sigs = append(sigs, v.BLSSign)
+	}
+	if len(sigs) < AttestationThreshold(len(witnesses)) {
+		return nil
+	}
+	agg, err := bls.Aggregate(sigs)
+	if err != nil {
+		return nil
+	}
+	a.Aggregate = agg
+	return a
+}
+
+// IsAggregate returns whether the attestation is in the aggregate mode.
+func (a *Attestation) IsAggregate() bool {
+	return len(a.Aggregate) > 0
+}
+
+// Voted returns the voted witnesses marked by the bitmap, in the order of the witness list,
+// the witness list must not contain duplicates.
+func (a *Attestation) Voted() ([]string, error) {
+	if len(a.Witnesses) == 0 {
+		return nil, errors.New("empty witness list")
+	}
+	if len(a.Bitmap) != (len(a.Witnesses)+7)/8 {
+		return nil, errors.New("wrong bitmap length")
+	}
+	seen := make(map[string]bool, len(a.Witnesses))
+	voted := make([]string, 0, len(a.Witnesses))
+	for i, w := range a.Witnesses {
+		if seen[w] {
+			return nil, fmt.Errorf("duplicate witness %v", w)
+		}
+		seen[w] = true
+		if a.Bitmap[i/8]&(1<<uint(i%8)) != 0 {
+			voted = append(voted, w)
+		}
+	}
+	if len(voted) < AttestationThreshold(len(a.Witnesses)) {
+		return nil, fmt.Errorf("votes not enough, got %v, need %v", len(voted), AttestationThreshold(len(a.Witnesses)))
+	}
+	return voted, nil
+}
+
+// Verify verifies the votes of the attestation against its witness list as a unit.
+// An aggregate attestation is verified by VerifyAggregate with the keys of the witnesses instead.
+func (a *Attestation) Verify() error {
+	if a.IsAggregate() {
+		return errors.New("aggregate attestation needs the witness keys")
+	}
+	voted, err := a.Voted()
+	if err != nil {
+		return err
+	}
+	if len(voted) > len(a.Signs) {
+		return errors.New("votes less than bitmap")
+	}
+	if len(voted) < len(a.Signs) {
+		return errors.New("votes more than bitmap")
+	}
+	msg := AttestationMessage(a.Number, a.BlockHash)
+	for i, w := range voted {
+		s := a.Signs[i]
+		if !s.Algorithm.Verify(msg, account.DecodePubkey(w), s.Sig) {
+			return fmt.Errorf("wrong vote of witness %v", w)
+		}
+	}
+	return nil
+}
+
+// VerifyAggregate verifies the aggregate signature of the attestation with one pairing check, against
+// the BLS public keys of the voted witnesses. The keys are indexed by witness, and must be verified witness keys.
+func (a *Attestation) VerifyAggregate(keys map[string][]byte) error {
+	if !a.IsAggregate() || len(a.Signs) > 0 {
+		return errors.New("not an aggregate attestation")
+	}
+	voted, err := a.Voted()
+	if err != nil {
+		return err
+	}
+	pubkeys := make([][]byte, 0, len(voted))
+	for _, w := range voted {
+		k, ok := keys[w]
+		if !ok {
+			return fmt.Errorf("unknown key of witness %v", w)
+		}
+		pubkeys = append(pubkeys, k)
+	}
+	if !bls.VerifyAggregate(AttestationMessage(a.Number, a.BlockHash), pubkeys, a.Aggregate) {
+		return errors.New("wrong aggregate signature")
+	}
+	return nil
+}
+
+// Encode is marshal
+func (a *Attestation) Encode() ([]byte, error) {
+	ar := &blockpb.Attestation{
+		Number:    a.Number,
+		BlockHash: a.BlockHash,
+		Witnesses: a.Witnesses,
+		Bitmap:    a.Bitmap,
+		Aggregate: a.Aggregate,
+	}
+	for _, s := range a.Signs {
+		ar.Signs = append(ar.Signs, s.ToPb())
+	}
+	b, err := proto.Marshal(ar)
+	if err != nil {
+		return nil, errors.New("fail to encode attestation")
+	}
+	return b, nil
+}
+
+// Decode is unmarshal
+func (a *Attestation) Decode(b []byte) error {
+	ar := &blockpb.Attestation{}
+	err := proto.Unmarshal(b, ar)
+	if err != nil {
+		return errors.New("fail to decode attestation")
+	}
+	a.Number = ar.Number
+	a.BlockHash = ar.BlockHash
+	a.Witnesses = ar.Witnesses
+	a.Bitmap = ar.Bitmap
+	a.Aggregate = ar.Aggregate
+	a.Signs = make([]*crypto.Signature, 0, len(ar.Signs))
+	for _, s := range ar.Signs {
+		a.Signs = append(a.Signs, (&crypto.Signature{}).FromPb(s))
+	}
+	return nil
+}
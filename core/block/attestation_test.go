@@ -0,0 +1,148 @@
+package block
+
+import (
+	"testing"
+
+	"github.com/iost-official/go-iost/account"
+	"github.com/iost-official/go-iost/crypto"
+	"github.com/iost-official/go-iost/crypto/bls"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func TestAttestation(t *testing.T) {
+	convey.Convey("Test of attestation", t, func() {
+		hash := []byte("blockhash")
+		kps := make([]*account.KeyPair, 0)
+		witnesses := make([]string, 0)
+		for i := 0; i < 10; i++ {
+			kp, err := account.NewKeyPair(nil, crypto.Ed25519)
+			convey.So(err, convey.ShouldBeNil)
+			kps = append(kps, kp)
+			witnesses = append(witnesses, kp.ReadablePubkey())
+		}
+		votes := make(map[string]*AttestationVote)
+		for i := 0; i < 6; i++ {
+			v := NewAttestationVote(100, hash, kps[i])
+			b, err := v.Encode()
+			convey.So(err, convey.ShouldBeNil)
+			var vRead AttestationVote
+			convey.So(vRead.Decode(b), convey.ShouldBeNil)
+			convey.So(vRead.Verify(), convey.ShouldBeTrue)
+			votes[vRead.Witness()] = &vRead
+		}
+		convey.So(NewAttestation(100, hash, witnesses, votes), convey.ShouldBeNil)
+
+		v := NewAttestationVote(100, hash, kps[9])
+		votes[v.Witness()] = v
+		a := NewAttestation(100, hash, witnesses, votes)
+		convey.So(a, convey.ShouldNotBeNil)
+		convey.So(a.Bitmap, convey.ShouldResemble, []byte{0x3f, 0x02})
+		convey.So(a.Verify(), convey.ShouldBeNil)
+
+		b, err := a.Encode()
+		convey.So(err, convey.ShouldBeNil)
+		var aRead Attestation
+		convey.So(aRead.Decode(b), convey.ShouldBeNil)
+		convey.So(aRead.Verify(), convey.ShouldBeNil)
+
+		aRead.Number = 101
+		convey.So(aRead.Verify(), convey.ShouldNotBeNil)
+		aRead.Number = 100
+		aRead.Bitmap = []byte{0x7f, 0x02}
+		convey.So(aRead.Verify(), convey.ShouldNotBeNil)
+		aRead.Bitmap = []byte{0x3f, 0x00}
+		convey.So(aRead.Verify(), convey.ShouldNotBeNil)
+		aRead.Bitmap = []byte{0x3f, 0x00}
+		aRead.Signs = aRead.Signs[:6]
+		convey.So(aRead.Verify(), convey.ShouldNotBeNil)
+
+		repeated := &Attestation{
+			Number:    100,
+			BlockHash: hash,
+			Witnesses: []string{witnesses[0], witnesses[0], witnesses[0]},
+			Bitmap:    []byte{0x07},
+		}
+		for i := 0; i < 3; i++ {
+			repeated.Signs = append(repeated.Signs, votes[witnesses[0]].Sign)
+		}
+		convey.So(repeated.Verify(), convey.ShouldNotBeNil)
+	})
+}
+
+func signWitnessKey(kp *account.KeyPair) func([]byte) (*crypto.Signature, error) {
+	return func(pubkey []byte) (*crypto.Signature, error) {
+		return kp.Sign(WitnessKeyMessage(pubkey)), nil
+	}
+}
+
+func TestAggregateAttestation(t *testing.T) {
+	convey.Convey("Test of aggregate attestation", t, func() {
+		hash := []byte("blockhash")
+		kps := make([]*account.KeyPair, 0)
+		witnesses := make([]string, 0)
+		keys := make(map[string][]byte)
+		votes := make(map[string]*AttestationVote)
+		for i := 0; i < 4; i++ {
+			kp, err := account.NewKeyPair(nil, crypto.Ed25519)
+			convey.So(err, convey.ShouldBeNil)
+			kps = append(kps, kp)
+			witnesses = append(witnesses, kp.ReadablePubkey())
+
+			seckey := bls.GenSeckey()
+			key, err := NewWitnessKey(seckey, signWitnessKey(kp))
+			convey.So(err, convey.ShouldBeNil)
+			b, err := key.Encode()
+			convey.So(err, convey.ShouldBeNil)
+			var kRead WitnessKey
+			convey.So(kRead.Decode(b), convey.ShouldBeNil)
+			convey.So(kRead.Verify(), convey.ShouldBeNil)
+			convey.So(kRead.Witness(), convey.ShouldEqual, kp.ReadablePubkey())
+			keys[kRead.Witness()] = kRead.Pubkey
+
+			if i == 1 {
+				continue
+			}
+			v := NewAttestationVote(100, hash, kp)
+			convey.So(v.SignBLS(seckey, key), convey.ShouldBeNil)
+			b, err = v.Encode()
+			convey.So(err, convey.ShouldBeNil)
+			var vRead AttestationVote
+			convey.So(vRead.Decode(b), convey.ShouldBeNil)
+			convey.So(vRead.Verify(), convey.ShouldBeTrue)
+			votes[vRead.Witness()] = &vRead
+		}
+
+		a := NewAggregateAttestation(100, hash, witnesses, votes)
+		convey.So(a, convey.ShouldNotBeNil)
+		convey.So(a.Bitmap, convey.ShouldResemble, []byte{0x0d})
+		convey.So(len(a.Signs), convey.ShouldEqual, 0)
+		convey.So(a.Verify(), convey.ShouldNotBeNil)
+		convey.So(a.VerifyAggregate(keys), convey.ShouldBeNil)
+
+		b, err := a.Encode()
+		convey.So(err, convey.ShouldBeNil)
+		var aRead Attestation
+		convey.So(aRead.Decode(b), convey.ShouldBeNil)
+		convey.So(aRead.VerifyAggregate(keys), convey.ShouldBeNil)
+
+		aRead.Number = 101
+		convey.So(aRead.VerifyAggregate(keys), convey.ShouldNotBeNil)
+		aRead.Number = 100
+		aRead.Bitmap = []byte{0x0f}
+		convey.So(aRead.VerifyAggregate(keys), convey.ShouldNotBeNil)
+		aRead.Bitmap = []byte{0x0d}
+		delete(keys, witnesses[0])
+		convey.So(aRead.VerifyAggregate(keys), convey.ShouldNotBeNil)
+
+		// a vote without BLS signature is not aggregated
+		votes[witnesses[2]].BLSSign = nil
+		convey.So(NewAggregateAttestation(100, hash, witnesses, votes), convey.ShouldBeNil)
+
+		// the key of a vote must be the key of its witness
+		v := NewAttestationVote(100, hash, kps[1])
+		other, err := NewWitnessKey(bls.GenSeckey(), signWitnessKey(kps[0]))
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(v.SignBLS(bls.GenSeckey(), other), convey.ShouldBeNil)
+		convey.So(v.Verify(), convey.ShouldBeFalse)
+	})
+}
@@ -11,6 +11,13 @@ import (
 	"github.com/iost-official/go-iost/crypto"
 )
 
+// Versions of the block head.
+const (
+	V0 int64 = iota
+	// V1 commits to the state root.
+	V1
+)
+
 // BlockHead is the struct of block head.
 type BlockHead struct { // nolint
 	Version             int64
@@ -22,6 +29,7 @@ type BlockHead struct { // nolint
 	Witness             string
 	Time                int64
 	GasUsage            int64
+	StateRoot           []byte
 }
 
 // ToPb convert BlockHead to proto buf data structure.
@@ -35,6 +43,7 @@ func (b *BlockHead) ToPb() *blockpb.BlockHead {
 		Number:              b.Number,
 		Witness:             b.Witness,
 		Time:                b.Time,
+		StateRoot:           b.StateRoot,
 	}
 }
 
@@ -49,6 +58,10 @@ func (b *BlockHead) ToBytes() []byte {
 	se.WriteInt64(b.Number)
 	se.WriteString(b.Witness)
 	se.WriteInt64(b.Time)
+	// The state root is only hashed since V1, so that the hashes of the old blocks do not change.
+	if b.Version >= V1 {
+		se.WriteBytes(b.StateRoot)
+	}
 	return se.Bytes()
 }
 
@@ -62,6 +75,7 @@ func (b *BlockHead) FromPb(bh *blockpb.BlockHead) *BlockHead {
 	b.Number = bh.Number
 	b.Witness = bh.Witness
 	b.Time = bh.Time
+	b.StateRoot = bh.StateRoot
 	return b
 }
 
@@ -24,6 +24,33 @@ func TestBlockHeadSerialize(t *testing.T) {
 		convey.So(bytes.Equal(head.ParentHash, headRead.ParentHash), convey.ShouldBeTrue)
 		convey.So(headRead.Number == head.Number, convey.ShouldBeTrue)
 	})
+
+	convey.Convey("Test of block head state root", t, func() {
+		head := BlockHead{
+			Number:     1,
+			ParentHash: []byte("parent"),
+		}
+		hash, err := head.hash()
+		convey.So(err, convey.ShouldBeNil)
+		head.StateRoot = []byte("root")
+		v0Hash, err := head.hash()
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(v0Hash, convey.ShouldResemble, hash)
+
+		head.Version = V1
+		v1Hash, err := head.hash()
+		convey.So(err, convey.ShouldBeNil)
+		head.StateRoot = []byte("another root")
+		anotherHash, err := head.hash()
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(anotherHash, convey.ShouldNotResemble, v1Hash)
+
+		b, err := head.Encode()
+		convey.So(err, convey.ShouldBeNil)
+		var headRead BlockHead
+		convey.So(headRead.Decode(b), convey.ShouldBeNil)
+		convey.So(headRead.StateRoot, convey.ShouldResemble, head.StateRoot)
+	})
 }
 
 func TestBlockSerialize(t *testing.T) {
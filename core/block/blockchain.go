@@ -1,8 +1,10 @@
 package block
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
 	"sync"
 
 	"strconv"
@@ -10,19 +12,26 @@ import (
 	"github.com/iost-official/go-iost/common"
 	"github.com/iost-official/go-iost/core/tx"
 	"github.com/iost-official/go-iost/db/kv"
+	"github.com/iost-official/go-iost/ilog"
 )
 
+// backfillBatchSize is the number of blocks indexed in one batch when backfilling an index.
+const backfillBatchSize = 1000
+
 // BlockChain is the implementation of chain
 type BlockChain struct { //nolint:golint
 	blockChainDB *kv.Storage
 	rw           sync.RWMutex
 	length       int64
 	txTotal      int64
+	accountIndex bool
 }
 
 var (
 	blockLength       = []byte("BlockLength")
 	blockTxTotal      = []byte("BlockTxTotal")
+	receiptIndexed    = []byte("ReceiptIndexed") // blocks below the number have their receipts indexed under cReceiptPrefix
+	tokenIndexed      = []byte("TokenIndexed")   // blocks below the number have their created tokens indexed under tokenPrefix
 	blockNumberPrefix = []byte("n")
 	blockPrefix       = []byte("H")
 	txPrefix          = []byte("t")      // txPrefix + tx hash -> block hash + tx hash
@@ -31,6 +40,11 @@ var (
 	receiptPrefix     = []byte("r")      // receiptPrefix + receipt hash -> block hash + receipt hash
 	bReceiptPrefix    = []byte("b")      // bReceiptPrefix + block hash + receipt hash -> receipt data
 	delaytxPrefix     = []byte("delay-") // delaytxPrefix + tx hash -> tx data
+	cReceiptPrefix    = []byte("e")      // cReceiptPrefix + contract id + "/" + block number + tx index + receipt index -> tx hash
+	aTxPrefix         = []byte("a")      // aTxPrefix + account + "/" + ^block number + ^tx index -> tx hash
+	attestationPrefix = []byte("L")      // attestationPrefix + block number -> attestation data
+	tokenPrefix       = []byte("k")      // tokenPrefix + token symbol -> number of the block creating the token
+	witnessKeyPrefix  = []byte("w")      // witnessKeyPrefix + witness pubkey -> witness key data
 )
 
 // NewBlockChain returns a Chain instance
@@ -72,7 +86,15 @@ func NewBlockChain(path string) (Chain, error) {
 		txTotal:      txTotal,
 	}
 	BC.CheckLength()
-	return BC, err
+	if err := BC.backfillIndex(receiptIndexed, "receipts", BC.indexBlockReceipts); err != nil {
+		BC.Close()
+		return nil, err
+	}
+	if err := BC.backfillIndex(tokenIndexed, "tokens", BC.indexBlockTokens); err != nil {
+		BC.Close()
+		return nil, err
+	}
+	return BC, nil
 }
 
 // SetLength sets blockchain's length.
@@ -89,6 +111,20 @@ func (bc *BlockChain) SetTxTotal(i int64) {
 	bc.rw.Unlock()
 }
 
+// SetAccountIndex sets whether the txs of every account are indexed when pushing blocks.
+func (bc *BlockChain) SetAccountIndex(enabled bool) {
+	bc.rw.Lock()
+	bc.accountIndex = enabled
+	bc.rw.Unlock()
+}
+
+// AccountIndex returns whether the txs of every account are indexed.
+func (bc *BlockChain) AccountIndex() bool {
+	bc.rw.RLock()
+	defer bc.rw.RUnlock()
+	return bc.accountIndex
+}
+
 // Length return length of block chain
 func (bc *BlockChain) Length() int64 {
 	bc.rw.RLock()
@@ -113,6 +149,7 @@ func (bc *BlockChain) Push(block *Block) error {
 	hash := block.HeadHash()
 	number := block.Head.Number
 	txTotal := bc.TxTotal()
+	accountIndex := bc.AccountIndex()
 	bc.blockChainDB.Put(append(blockNumberPrefix, common.Int64ToBytes(number)...), hash)
 	blockByte, err := block.EncodeM()
 	if err != nil {
@@ -144,7 +181,19 @@ func (bc *BlockChain) Push(block *Block) error {
 		for _, canceledHash := range canceledDelayHashes {
 			bc.blockChainDB.Delete(append(delaytxPrefix, canceledHash...))
 		}
+
+		bc.indexReceipts(number, int32(i), tHash, block.Receipts[i])
+
+		if accountIndex {
+			for _, name := range accountsOfTx(t, block.Receipts[i]) {
+				bc.blockChainDB.Put(accountTxKey(name, number, int32(i)), tHash)
+			}
+		}
+
+		bc.indexTokens(number, block.Receipts[i])
 	}
+	bc.blockChainDB.Put(receiptIndexed, common.Int64ToBytes(number+1))
+	bc.blockChainDB.Put(tokenIndexed, common.Int64ToBytes(number+1))
 	err = bc.blockChainDB.CommitBatch()
 	if err != nil {
 		return fmt.Errorf("fail to put block, err:%s", err)
@@ -306,6 +355,18 @@ func (bc *BlockChain) GetTx(hash []byte) (*tx.Tx, error) {
 	return &tx, nil
 }
 
+// GetBlockByTxHash gets the block which contains the tx.
+func (bc *BlockChain) GetBlockByTxHash(hash []byte) (*Block, error) {
+	bTx, err := bc.blockChainDB.Get(append(txPrefix, hash...))
+	if err != nil {
+		return nil, fmt.Errorf("failed to Get the tx: %v", err)
+	}
+	if len(bTx) <= len(hash) {
+		return nil, fmt.Errorf("failed to Get the tx: not found")
+	}
+	return bc.GetBlockByHash(bTx[:len(bTx)-len(hash)])
+}
+
 // HasTx checks if database has tx.
 func (bc *BlockChain) HasTx(hash []byte) (bool, error) {
 	return bc.blockChainDB.Has(append(txPrefix, hash...))
@@ -383,6 +444,292 @@ func (bc *BlockChain) AllDelaytx() ([]*tx.Tx, error) {
 	return ret, nil
 }
 
+func contractReceiptPrefix(contractID string) []byte {
+	key := make([]byte, 0, len(cReceiptPrefix)+len(contractID)+1)
+	key = append(key, cReceiptPrefix...)
+	key = append(key, contractID...)
+	return append(key, '/')
+}
+
+func contractReceiptKey(contractID string, number int64, txIndex int32, index int32) []byte {
+	key := contractReceiptPrefix(contractID)
+	key = append(key, common.Int64ToBytes(number)...)
+	key = append(key, common.Int32ToBytes(txIndex)...)
+	return append(key, common.Int32ToBytes(index)...)
+}
+
+func (bc *BlockChain) indexReceipts(number int64, txIndex int32, txHash []byte, r *tx.TxReceipt) {
+	for j, rr := range r.Receipts {
+		bc.blockChainDB.Put(contractReceiptKey(rr.ContractID(), number, txIndex, int32(j)), txHash)
+	}
+}
+
+func (bc *BlockChain) indexBlockReceipts(number int64, blk *Block) {
+	for i, r := range blk.Receipts {
+		bc.indexReceipts(number, int32(i), blk.Txs[i].Hash(), r)
+	}
+}
+
+// backfillIndex indexes the blocks pushed before the index was introduced, the marker records the number of
+// the first block not indexed yet, so that an interrupted backfill resumes where it stopped.
+func (bc *BlockChain) backfillIndex(marker []byte, name string, index func(number int64, blk *Block)) error {
+	var from int64
+	if b, err := bc.blockChainDB.Get(marker); err == nil && len(b) == 8 {
+		from = common.BytesToInt64(b)
+	}
+	length := bc.Length()
+	if from >= length {
+		return nil
+	}
+	ilog.Infof("indexing the %s of blocks [%d, %d)", name, from, length)
+	for number := from; number < length; {
+		if err := bc.blockChainDB.BeginBatch(); err != nil {
+			return errors.New("fail to begin batch")
+		}
+		for end := number + backfillBatchSize; number < length && number < end; number++ {
+			blk, err := bc.GetBlockByNumber(number)
+			if err != nil {
+				// the blocks below a restored snapshot are absent
+				ilog.Warnf("skip indexing the %s of block %d: %v", name, number, err)
+				continue
+			}
+			index(number, blk)
+		}
+		bc.blockChainDB.Put(marker, common.Int64ToBytes(number))
+		if err := bc.blockChainDB.CommitBatch(); err != nil {
+			return fmt.Errorf("fail to index %s: %v", name, err)
+		}
+		ilog.Infof("indexed the %s of blocks below %d", name, number)
+	}
+	return nil
+}
+
+// IterateContractReceipts calls f with the position of every receipt of the contract in blocks [from, to]
+// in order, until f returns false.
+func (bc *BlockChain) IterateContractReceipts(contractID string, from int64, to int64, f func(number int64, txHash []byte, index int32) bool) error {
+	prefix := contractReceiptPrefix(contractID)
+	start := append(prefix, common.Int64ToBytes(from)...)
+	limit := append(contractReceiptPrefix(contractID), common.Int64ToBytes(to+1)...)
+	iter := bc.blockChainDB.NewIteratorByRange(start, limit)
+	for iter.Next() {
+		key := iter.Key()
+		if len(key) != len(prefix)+16 {
+			continue
+		}
+		number := common.BytesToInt64(key[len(prefix) : len(prefix)+8])
+		index := common.BytesToInt32(key[len(prefix)+12:])
+		txHash := make([]byte, len(iter.Value()))
+		copy(txHash, iter.Value())
+		if !f(number, txHash, index) {
+			break
+		}
+	}
+	iter.Release()
+	if err := iter.Error(); err != nil {
+		return fmt.Errorf("fail to iterate contract receipts: %v", err)
+	}
+	return nil
+}
+
+// accountsOfTx returns the accounts involved in the tx, which are the publisher, the signers,
+// and the parties of the token transfers in the receipts.
+func accountsOfTx(t *tx.Tx, r *tx.TxReceipt) []string {
+	names := make([]string, 0)
+	seen := make(map[string]bool)
+	add := func(name string) {
+		if name != "" && !seen[name] {
+			seen[name] = true
+			names = append(names, name)
+		}
+	}
+	add(t.Publisher)
+	for _, signer := range t.Signers {
+		add(strings.Split(signer, "@")[0])
+	}
+	if r == nil || r.Status.Code != tx.Success {
+		return names
+	}
+	for _, rr := range r.Receipts {
+		var args []interface{}
+		switch rr.FuncName {
+		case "token.iost/transfer", "token.iost/transferFreeze":
+			if json.Unmarshal([]byte(rr.Content), &args) == nil && len(args) > 2 {
+				from, _ := args[1].(string)
+				to, _ := args[2].(string)
+				add(from)
+				add(to)
+			}
+		case "token.iost/transferFrom":
+			if json.Unmarshal([]byte(rr.Content), &args) == nil && len(args) > 3 {
+				from, _ := args[2].(string)
+				to, _ := args[3].(string)
+				add(from)
+				add(to)
+			}
+		case "token.iost/issue", "token.iost/destroy":
+			if json.Unmarshal([]byte(rr.Content), &args) == nil && len(args) > 1 {
+				name, _ := args[1].(string)
+				add(name)
+			}
+		}
+	}
+	return names
+}
+
+func accountTxPrefix(name string) []byte {
+	key := make([]byte, 0, len(aTxPrefix)+len(name)+1)
+	key = append(key, aTxPrefix...)
+	key = append(key, name...)
+	return append(key, '/')
+}
+
+// accountTxKey inverts the position of the tx so that the newer txs come first in the index.
+func accountTxKey(name string, number int64, txIndex int32) []byte {
+	key := accountTxPrefix(name)
+	key = append(key, common.Int64ToBytes(^number)...)
+	return append(key, common.Int32ToBytes(^txIndex)...)
+}
+
+// IterateAccountTxs calls f with the position of every tx involving the account from the newest to the oldest,
+// starting from the tx at (number, txIndex), until f returns false.
+func (bc *BlockChain) IterateAccountTxs(name string, number int64, txIndex int32, f func(number int64, txIndex int32, txHash []byte) bool) error {
+	if !bc.AccountIndex() {
+		return errors.New("account index is not enabled")
+	}
+	prefix := accountTxPrefix(name)
+	start := accountTxKey(name, number, txIndex)
+	limit := accountTxPrefix(name)
+	limit[len(limit)-1]++
+	iter := bc.blockChainDB.NewIteratorByRange(start, limit)
+	for iter.Next() {
+		key := iter.Key()
+		if len(key) != len(prefix)+12 {
+			continue
+		}
+		number := ^common.BytesToInt64(key[len(prefix) : len(prefix)+8])
+		txIndex := ^common.BytesToInt32(key[len(prefix)+8:])
+		txHash := make([]byte, len(iter.Value()))
+		copy(txHash, iter.Value())
+		if !f(number, txIndex, txHash) {
+			break
+		}
+	}
+	iter.Release()
+	if err := iter.Error(); err != nil {
+		return fmt.Errorf("fail to iterate account txs: %v", err)
+	}
+	return nil
+}
+
+// tokensOfTx returns the symbols of the tokens created by the tx.
+func tokensOfTx(r *tx.TxReceipt) []string {
+	symbols := make([]string, 0)
+	if r == nil || r.Status.Code != tx.Success {
+		return symbols
+	}
+	for _, rr := range r.Receipts {
+		if rr.FuncName != "token.iost/create" {
+			continue
+		}
+		var args []interface{}
+		if json.Unmarshal([]byte(rr.Content), &args) == nil && len(args) > 0 {
+			if symbol, ok := args[0].(string); ok && symbol != "" {
+				symbols = append(symbols, symbol)
+			}
+		}
+	}
+	return symbols
+}
+
+func (bc *BlockChain) indexTokens(number int64, r *tx.TxReceipt) {
+	for _, symbol := range tokensOfTx(r) {
+		bc.blockChainDB.Put(tokenKey(symbol), common.Int64ToBytes(number))
+	}
+}
+
+func (bc *BlockChain) indexBlockTokens(number int64, blk *Block) {
+	for _, r := range blk.Receipts {
+		bc.indexTokens(number, r)
+	}
+}
+
+func tokenKey(symbol string) []byte {
+	key := make([]byte, 0, len(tokenPrefix)+len(symbol))
+	key = append(key, tokenPrefix...)
+	return append(key, symbol...)
+}
+
+// IterateTokens calls f with the symbol of every created token in lexicographical order,
+// starting from the symbol from, until f returns false.
+func (bc *BlockChain) IterateTokens(from string, f func(symbol string) bool) error {
+	start := tokenKey(from)
+	limit := tokenKey("")
+	limit[len(limit)-1]++
+	iter := bc.blockChainDB.NewIteratorByRange(start, limit)
+	for iter.Next() {
+		if !f(string(iter.Key()[len(tokenPrefix):])) {
+			break
+		}
+	}
+	iter.Release()
+	if err := iter.Error(); err != nil {
+		return fmt.Errorf("fail to iterate tokens: %v", err)
+	}
+	return nil
+}
+
+// PutAttestation saves the attestation of the irreversible block
+func (bc *BlockChain) PutAttestation(a *Attestation) error {
+	b, err := a.Encode()
+	if err != nil {
+		return err
+	}
+	err = bc.blockChainDB.Put(append(attestationPrefix, common.Int64ToBytes(a.Number)...), b)
+	if err != nil {
+		return fmt.Errorf("fail to put attestation: %v", err)
+	}
+	return nil
+}
+
+// GetAttestation returns the attestation of the irreversible block by number
+func (bc *BlockChain) GetAttestation(number int64) (*Attestation, error) {
+	b, err := bc.blockChainDB.Get(append(attestationPrefix, common.Int64ToBytes(number)...))
+	if err != nil || len(b) == 0 {
+		return nil, errors.New("fail to get attestation by number")
+	}
+	var a Attestation
+	if err := a.Decode(b); err != nil {
+		return nil, err
+	}
+	return &a, nil
+}
+
+// PutWitnessKey saves the verified BLS key of the witness
+func (bc *BlockChain) PutWitnessKey(k *WitnessKey) error {
+	b, err := k.Encode()
+	if err != nil {
+		return err
+	}
+	err = bc.blockChainDB.Put(append(witnessKeyPrefix, k.Witness()...), b)
+	if err != nil {
+		return fmt.Errorf("fail to put witness key: %v", err)
+	}
+	return nil
+}
+
+// GetWitnessKey returns the BLS key of the witness
+func (bc *BlockChain) GetWitnessKey(witness string) (*WitnessKey, error) {
+	b, err := bc.blockChainDB.Get(append(witnessKeyPrefix, witness...))
+	if err != nil || len(b) == 0 {
+		return nil, errors.New("fail to get witness key")
+	}
+	var k WitnessKey
+	if err := k.Decode(b); err != nil {
+		return nil, err
+	}
+	return &k, nil
+}
+
 // Draw the graph about blockchain
 func (bc *BlockChain) Draw(start int64, end int64) string {
 	ret := ""
@@ -86,6 +86,235 @@ func TestChainImpl(t *testing.T) {
 	})
 }
 
+func TestIterateContractReceipts(t *testing.T) {
+	Convey("test IterateContractReceipts", t, func() {
+		bc, err := NewBlockChain("./BlockChainDB/")
+		So(err, ShouldBeNil)
+		defer os.RemoveAll("./BlockChainDB/")
+
+		length := bc.Length()
+		for i := int64(0); i < 3; i++ {
+			txn := tx.NewTx(nil, nil, 9999, 1, 1, 0, 0)
+			txn.Time = i
+			tr := tx.NewTxReceipt(txn.Hash())
+			tr.Receipts = append(tr.Receipts,
+				&tx.Receipt{FuncName: "token.iost/transfer", Content: "[]"},
+				&tx.Receipt{FuncName: "Contractabc/event", Content: "{}"},
+			)
+			blk := &Block{
+				Head: &BlockHead{
+					Version:    2,
+					ParentHash: []byte("parent Hash"),
+					Number:     length + i,
+				},
+				Sign:     &crypto.Signature{},
+				Txs:      []*tx.Tx{txn},
+				Receipts: []*tx.TxReceipt{tr},
+			}
+			blk.CalculateHeadHash()
+			So(bc.Push(blk), ShouldBeNil)
+		}
+
+		numbers := []int64{}
+		err = bc.IterateContractReceipts("Contractabc", length+1, length+2, func(number int64, txHash []byte, index int32) bool {
+			So(index, ShouldEqual, 1)
+			numbers = append(numbers, number)
+			return true
+		})
+		So(err, ShouldBeNil)
+		So(numbers, ShouldResemble, []int64{length + 1, length + 2})
+
+		count := 0
+		err = bc.IterateContractReceipts("token.iost", length, length+2, func(number int64, txHash []byte, index int32) bool {
+			count++
+			return false
+		})
+		So(err, ShouldBeNil)
+		So(count, ShouldEqual, 1)
+	})
+}
+
+func TestBackfillReceiptIndex(t *testing.T) {
+	Convey("test backfilling the receipt index", t, func() {
+		chain, err := NewBlockChain("./BlockChainDB/")
+		So(err, ShouldBeNil)
+		defer os.RemoveAll("./BlockChainDB/")
+
+		length := chain.Length()
+		for i := int64(0); i < 3; i++ {
+			txn := tx.NewTx(nil, nil, 9999, 1, 1, 0, 0)
+			txn.Time = i
+			tr := tx.NewTxReceipt(txn.Hash())
+			tr.Receipts = append(tr.Receipts, &tx.Receipt{FuncName: "Contractabc/event", Content: "{}"})
+			blk := &Block{
+				Head: &BlockHead{
+					Version:    2,
+					ParentHash: []byte("parent Hash"),
+					Number:     length + i,
+				},
+				Sign:     &crypto.Signature{},
+				Txs:      []*tx.Tx{txn},
+				Receipts: []*tx.TxReceipt{tr},
+			}
+			blk.CalculateHeadHash()
+			So(chain.Push(blk), ShouldBeNil)
+		}
+
+		// drop the index as if the blocks were pushed before it was introduced
+		bc := chain.(*BlockChain)
+		iter := bc.blockChainDB.NewIteratorByPrefix(cReceiptPrefix)
+		keys := [][]byte{}
+		for iter.Next() {
+			keys = append(keys, append([]byte{}, iter.Key()...))
+		}
+		iter.Release()
+		So(len(keys), ShouldEqual, 3)
+		for _, key := range keys {
+			So(bc.blockChainDB.Delete(key), ShouldBeNil)
+		}
+		So(bc.blockChainDB.Delete(receiptIndexed), ShouldBeNil)
+		bc.Close()
+
+		chain, err = NewBlockChain("./BlockChainDB/")
+		So(err, ShouldBeNil)
+		defer chain.Close()
+		numbers := []int64{}
+		err = chain.IterateContractReceipts("Contractabc", length, length+2, func(number int64, txHash []byte, index int32) bool {
+			numbers = append(numbers, number)
+			return true
+		})
+		So(err, ShouldBeNil)
+		So(numbers, ShouldResemble, []int64{length, length + 1, length + 2})
+	})
+}
+
+func TestIterateAccountTxs(t *testing.T) {
+	Convey("test IterateAccountTxs", t, func() {
+		bc, err := NewBlockChain("./BlockChainDB/")
+		So(err, ShouldBeNil)
+		defer os.RemoveAll("./BlockChainDB/")
+
+		err = bc.IterateAccountTxs("alice", 0, 0, func(number int64, txIndex int32, txHash []byte) bool { return true })
+		So(err, ShouldNotBeNil)
+		bc.SetAccountIndex(true)
+
+		length := bc.Length()
+		hashes := make([][]byte, 0)
+		for i := int64(0); i < 3; i++ {
+			txn := tx.NewTx(nil, []string{"bob@active"}, 9999, 1, 1, 0, 0)
+			txn.Publisher = "alice"
+			txn.Time = i
+			tr := tx.NewTxReceipt(txn.Hash())
+			tr.Receipts = append(tr.Receipts, &tx.Receipt{
+				FuncName: "token.iost/transfer",
+				Content:  `["iost","alice","carol","1",""]`,
+			})
+			blk := &Block{
+				Head: &BlockHead{
+					Version:    2,
+					ParentHash: []byte("parent Hash"),
+					Number:     length + i,
+				},
+				Sign:     &crypto.Signature{},
+				Txs:      []*tx.Tx{txn},
+				Receipts: []*tx.TxReceipt{tr},
+			}
+			blk.CalculateHeadHash()
+			So(bc.Push(blk), ShouldBeNil)
+			hashes = append(hashes, txn.Hash())
+		}
+
+		for _, name := range []string{"alice", "bob", "carol"} {
+			numbers := []int64{}
+			err = bc.IterateAccountTxs(name, length+1, 0, func(number int64, txIndex int32, txHash []byte) bool {
+				So(txIndex, ShouldEqual, 0)
+				So(txHash, ShouldResemble, hashes[number-length])
+				numbers = append(numbers, number)
+				return true
+			})
+			So(err, ShouldBeNil)
+			So(numbers, ShouldResemble, []int64{length + 1, length})
+		}
+
+		count := 0
+		err = bc.IterateAccountTxs("ali", length+2, 0, func(number int64, txIndex int32, txHash []byte) bool {
+			count++
+			return true
+		})
+		So(err, ShouldBeNil)
+		So(count, ShouldEqual, 0)
+	})
+}
+
+func TestIterateTokens(t *testing.T) {
+	Convey("test IterateTokens", t, func() {
+		bc, err := NewBlockChain("./BlockChainDB/")
+		So(err, ShouldBeNil)
+		defer os.RemoveAll("./BlockChainDB/")
+
+		length := bc.Length()
+		for i, symbol := range []string{"iost", "abc", "xyz"} {
+			txn := tx.NewTx(nil, nil, 9999, 1, 1, 0, 0)
+			txn.Time = int64(i)
+			tr := tx.NewTxReceipt(txn.Hash())
+			tr.Receipts = append(tr.Receipts, &tx.Receipt{
+				FuncName: "token.iost/create",
+				Content:  `["` + symbol + `","admin",1000,"e30="]`,
+			})
+			if symbol == "xyz" {
+				tr.Status.Code = tx.ErrorRuntime
+			}
+			blk := &Block{
+				Head: &BlockHead{
+					Version:    2,
+					ParentHash: []byte("parent Hash"),
+					Number:     length + int64(i),
+				},
+				Sign:     &crypto.Signature{},
+				Txs:      []*tx.Tx{txn},
+				Receipts: []*tx.TxReceipt{tr},
+			}
+			blk.CalculateHeadHash()
+			So(bc.Push(blk), ShouldBeNil)
+		}
+
+		symbols := []string{}
+		err = bc.IterateTokens("", func(symbol string) bool {
+			symbols = append(symbols, symbol)
+			return true
+		})
+		So(err, ShouldBeNil)
+		So(symbols, ShouldResemble, []string{"abc", "iost"})
+
+		symbols = []string{}
+		err = bc.IterateTokens("b", func(symbol string) bool {
+			symbols = append(symbols, symbol)
+			return true
+		})
+		So(err, ShouldBeNil)
+		So(symbols, ShouldResemble, []string{"iost"})
+
+		// drop the index as if the blocks were pushed before it was introduced
+		chain := bc.(*BlockChain)
+		for _, symbol := range []string{"abc", "iost"} {
+			So(chain.blockChainDB.Delete(tokenKey(symbol)), ShouldBeNil)
+		}
+		So(chain.blockChainDB.Delete(tokenIndexed), ShouldBeNil)
+		chain.Close()
+
+		bc, err = NewBlockChain("./BlockChainDB/")
+		So(err, ShouldBeNil)
+		defer bc.Close()
+		symbols = []string{}
+		err = bc.IterateTokens("", func(symbol string) bool {
+			symbols = append(symbols, symbol)
+			return true
+		})
+		So(err, ShouldBeNil)
+		So(symbols, ShouldResemble, []string{"abc", "iost"})
+	})
+}
+
 func BenchmarkBlock(b *testing.B) {
 	a1, _ := account.NewKeyPair(nil, crypto.Secp256k1)
 	a2, _ := account.NewKeyPair(nil, crypto.Secp256k1)
@@ -0,0 +1,130 @@
+package block
+
+import (
+	"bytes"
+	"errors"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/iost-official/go-iost/account"
+	"github.com/iost-official/go-iost/common"
+	blockpb "github.com/iost-official/go-iost/core/block/pb"
+	"github.com/iost-official/go-iost/crypto"
+)
+
+// A witness produces the blocks of its slot one after another on its own head, so two different blocks of
+// the same number in the same slot can only be signed by a witness who forks the chain on purpose.
+// The two signed heads are the evidence of the double sign, which can be verified by anyone without the blocks.
+// The heads do not commit to the chain, so the chain accepts the evidence only if one of the heads is its own
+// block, and only within DoubleSignEvidenceWindow blocks after the double sign.
+
+// DoubleSignEvidenceWindow is the number of blocks after the double sign, within which the evidence is accepted.
+var DoubleSignEvidenceWindow int64 = 172800
+
+// DoubleSignEvidence is the two conflicting block heads signed by the same witness.
+type DoubleSignEvidence struct {
+	Head1 *BlockHead
+	Sign1 *crypto.Signature
+	Head2 *BlockHead
+	Sign2 *crypto.Signature
+}
+
+// SlotOfHead returns the slot of the block head.
+func SlotOfHead(head *BlockHead) int64 {
+	return head.Time / 1e9 / common.SlotLength
+}
+
+// NewDoubleSignEvidence returns the evidence of the two blocks, the heads are ordered by hash so that
+// the same double sign always makes the same evidence.
+func NewDoubleSignEvidence(blk1, blk2 *Block) *DoubleSignEvidence {
+	if bytes.Compare(blk1.HeadHash(), blk2.HeadHash()) > 0 {
+		blk1, blk2 = blk2, blk1
+	}
+	return &DoubleSignEvidence{
+		Head1: blk1.Head,
+		Sign1: blk1.Sign,
+		Head2: blk2.Head,
+		Sign2: blk2.Sign,
+	}
+}
+
+// Witness returns the witness who signed the heads.
+func (e *DoubleSignEvidence) Witness() string {
+	return e.Head1.Witness
+}
+
+// Number returns the block number of the heads.
+func (e *DoubleSignEvidence) Number() int64 {
+	return e.Head1.Number
+}
+
+// Hashes returns the hashes of the two heads.
+func (e *DoubleSignEvidence) Hashes() ([]byte, []byte) {
+	hash1, _ := e.Head1.hash()
+	hash2, _ := e.Head2.hash()
+	return hash1, hash2
+}
+
+// VerifyRecent checks that the double sign happened before the block of the number and within the evidence window.
+func (e *DoubleSignEvidence) VerifyRecent(number int64) error {
+	if e.Number() >= number {
+		return errors.New("evidence of a future block")
+	}
+	if e.Number()+DoubleSignEvidenceWindow < number {
+		return errors.New("evidence expired")
+	}
+	return nil
+}
+
+// Verify checks that the heads conflict with each other and both of them are signed by the witness.
+func (e *DoubleSignEvidence) Verify() error {
+	if e.Head1 == nil || e.Head2 == nil || e.Sign1 == nil || e.Sign2 == nil {
+		return errors.New("incomplete evidence")
+	}
+	if e.Head1.Witness != e.Head2.Witness {
+		return errors.New("different witnesses")
+	}
+	if e.Head1.Number != e.Head2.Number {
+		return errors.New("different block numbers")
+	}
+	if SlotOfHead(e.Head1) != SlotOfHead(e.Head2) {
+		return errors.New("different slots")
+	}
+	hash1, hash2 := e.Hashes()
+	if bytes.Equal(hash1, hash2) {
+		return errors.New("same block")
+	}
+	pubkey := account.DecodePubkey(e.Head1.Witness)
+	if !e.Sign1.Algorithm.Verify(hash1, pubkey, e.Sign1.Sig) || !e.Sign2.Algorithm.Verify(hash2, pubkey, e.Sign2.Sig) {
+		return errors.New("wrong signature")
+	}
+	return nil
+}
+
+// Encode is marshal
+func (e *DoubleSignEvidence) Encode() ([]byte, error) {
+	er := &blockpb.DoubleSignEvidence{
+		Head1: e.Head1.ToPb(),
+		Sign1: e.Sign1.ToPb(),
+		Head2: e.Head2.ToPb(),
+		Sign2: e.Sign2.ToPb(),
+	}
+	b, err := proto.Marshal(er)
+	if err != nil {
+		return nil, errors.New("fail to encode double sign evidence")
+	}
+	return b, nil
+}
+
+// Decode is unmarshal
+func (e *DoubleSignEvidence) Decode(b []byte) error {
+	er := &blockpb.DoubleSignEvidence{}
+	err := proto.Unmarshal(b, er)
+	if err != nil || er.Head1 == nil || er.Head2 == nil || er.Sign1 == nil || er.Sign2 == nil {
+		return errors.New("fail to decode double sign evidence")
+	}
+	e.Head1 = (&BlockHead{}).FromPb(er.Head1)
+	e.Sign1 = (&crypto.Signature{}).FromPb(er.Sign1)
+	e.Head2 = (&BlockHead{}).FromPb(er.Head2)
+	e.Sign2 = (&crypto.Signature{}).FromPb(er.Sign2)
+	return nil
+}
@@ -0,0 +1,62 @@
+package block
+
+import (
+	"testing"
+
+	"github.com/iost-official/go-iost/account"
+	"github.com/iost-official/go-iost/crypto"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func signedBlock(kp *account.KeyPair, number, time int64, parent string) *Block {
+	blk := &Block{
+		Head: &BlockHead{
+			ParentHash: []byte(parent),
+			Number:     number,
+			Witness:    kp.ReadablePubkey(),
+			Time:       time,
+		},
+	}
+	blk.CalculateHeadHash()
+	blk.Sign = kp.Sign(blk.HeadHash())
+	return blk
+}
+
+func TestDoubleSignEvidence(t *testing.T) {
+	convey.Convey("Test of double sign evidence", t, func() {
+		kp, err := account.NewKeyPair(nil, crypto.Ed25519)
+		convey.So(err, convey.ShouldBeNil)
+		other, err := account.NewKeyPair(nil, crypto.Ed25519)
+		convey.So(err, convey.ShouldBeNil)
+
+		blk1 := signedBlock(kp, 100, 3e9, "parent1")
+		blk2 := signedBlock(kp, 100, 3e9+5e8, "parent2")
+		e := NewDoubleSignEvidence(blk1, blk2)
+		convey.So(e.Verify(), convey.ShouldBeNil)
+		convey.So(e.Witness(), convey.ShouldEqual, kp.ReadablePubkey())
+		convey.So(e.Number(), convey.ShouldEqual, 100)
+		convey.So(NewDoubleSignEvidence(blk2, blk1), convey.ShouldResemble, e)
+
+		hash1, hash2 := e.Hashes()
+		convey.So([][]byte{hash1, hash2}, convey.ShouldContain, blk1.HeadHash())
+		convey.So([][]byte{hash1, hash2}, convey.ShouldContain, blk2.HeadHash())
+		convey.So(e.VerifyRecent(101), convey.ShouldBeNil)
+		convey.So(e.VerifyRecent(100+DoubleSignEvidenceWindow), convey.ShouldBeNil)
+		convey.So(e.VerifyRecent(100), convey.ShouldNotBeNil)
+		convey.So(e.VerifyRecent(101+DoubleSignEvidenceWindow), convey.ShouldNotBeNil)
+
+		b, err := e.Encode()
+		convey.So(err, convey.ShouldBeNil)
+		var eRead DoubleSignEvidence
+		convey.So(eRead.Decode(b), convey.ShouldBeNil)
+		convey.So(eRead.Verify(), convey.ShouldBeNil)
+
+		convey.So(NewDoubleSignEvidence(blk1, blk1).Verify(), convey.ShouldNotBeNil)
+		convey.So(NewDoubleSignEvidence(blk1, signedBlock(kp, 101, 3e9, "parent2")).Verify(), convey.ShouldNotBeNil)
+		convey.So(NewDoubleSignEvidence(blk1, signedBlock(kp, 100, 6e9, "parent2")).Verify(), convey.ShouldNotBeNil)
+		convey.So(NewDoubleSignEvidence(blk1, signedBlock(other, 100, 3e9, "parent2")).Verify(), convey.ShouldNotBeNil)
+
+		eRead.Head2.Info = []byte("tampered")
+		convey.So(eRead.Verify(), convey.ShouldNotBeNil)
+	})
+}
@@ -11,11 +11,13 @@ type Chain interface {
 	TxTotal() int64
 	CheckLength()
 	SetLength(i int64)
+	SetAccountIndex(enabled bool)
 	Top() (*Block, error)
 	GetHashByNumber(number int64) ([]byte, error)
 	GetBlockByNumber(number int64) (*Block, error)
 	GetBlockByHash(blockHash []byte) (*Block, error)
 	GetTx(hash []byte) (*tx.Tx, error)
+	GetBlockByTxHash(hash []byte) (*Block, error)
 	HasTx(hash []byte) (bool, error)
 	GetReceipt(Hash []byte) (*tx.TxReceipt, error)
 	GetReceiptByTxHash(Hash []byte) (*tx.TxReceipt, error)
@@ -23,5 +25,12 @@ type Chain interface {
 	Size() (int64, error)
 	Close()
 	AllDelaytx() ([]*tx.Tx, error)
+	IterateContractReceipts(contractID string, from int64, to int64, f func(number int64, txHash []byte, index int32) bool) error
+	IterateAccountTxs(name string, number int64, txIndex int32, f func(number int64, txIndex int32, txHash []byte) bool) error
+	IterateTokens(from string, f func(symbol string) bool) error
+	PutAttestation(a *Attestation) error
+	GetAttestation(number int64) (*Attestation, error)
+	PutWitnessKey(k *WitnessKey) error
+	GetWitnessKey(witness string) (*WitnessKey, error)
 	Draw(int64, int64) string
 }
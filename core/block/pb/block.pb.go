@@ -56,6 +56,7 @@ type BlockHead struct {
 	Number               int64    `protobuf:"varint,6,opt,name=number,proto3" json:"number,omitempty"`
 	Witness              string   `protobuf:"bytes,7,opt,name=witness,proto3" json:"witness,omitempty"`
 	Time                 int64    `protobuf:"varint,8,opt,name=time,proto3" json:"time,omitempty"`
+	StateRoot            []byte   `protobuf:"bytes,9,opt,name=stateRoot,proto3" json:"stateRoot,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -142,6 +143,13 @@ func (m *BlockHead) GetTime() int64 {
 	return 0
 }
 
+func (m *BlockHead) GetStateRoot() []byte {
+	if m != nil {
+		return m.StateRoot
+	}
+	return nil
+}
+
 type Block struct {
 	Head                 *BlockHead       `protobuf:"bytes,1,opt,name=head,proto3" json:"head,omitempty"`
 	Sign                 *pb.Signature    `protobuf:"bytes,2,opt,name=sign,proto3" json:"sign,omitempty"`
@@ -229,41 +237,327 @@ func (m *Block) GetBlockType() BlockType {
 	return BlockType_NORMAL
 }
 
+type WitnessKey struct {
+	Pubkey               []byte        `protobuf:"bytes,1,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	Proof                []byte        `protobuf:"bytes,2,opt,name=proof,proto3" json:"proof,omitempty"`
+	Sign                 *pb.Signature `protobuf:"bytes,3,opt,name=sign,proto3" json:"sign,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *WitnessKey) Reset()         { *m = WitnessKey{} }
+func (m *WitnessKey) String() string { return proto.CompactTextString(m) }
+func (*WitnessKey) ProtoMessage()    {}
+func (*WitnessKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc6664e18d413fc7, []int{2}
+}
+
+func (m *WitnessKey) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WitnessKey.Unmarshal(m, b)
+}
+func (m *WitnessKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WitnessKey.Marshal(b, m, deterministic)
+}
+func (m *WitnessKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WitnessKey.Merge(m, src)
+}
+func (m *WitnessKey) XXX_Size() int {
+	return xxx_messageInfo_WitnessKey.Size(m)
+}
+func (m *WitnessKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_WitnessKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WitnessKey proto.InternalMessageInfo
+
+func (m *WitnessKey) GetPubkey() []byte {
+	if m != nil {
+		return m.Pubkey
+	}
+	return nil
+}
+
+func (m *WitnessKey) GetProof() []byte {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+func (m *WitnessKey) GetSign() *pb.Signature {
+	if m != nil {
+		return m.Sign
+	}
+	return nil
+}
+
+type AttestationVote struct {
+	Number               int64         `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"`
+	BlockHash            []byte        `protobuf:"bytes,2,opt,name=blockHash,proto3" json:"blockHash,omitempty"`
+	Sign                 *pb.Signature `protobuf:"bytes,3,opt,name=sign,proto3" json:"sign,omitempty"`
+	BlsSign              []byte        `protobuf:"bytes,4,opt,name=blsSign,proto3" json:"blsSign,omitempty"`
+	Key                  *WitnessKey   `protobuf:"bytes,5,opt,name=key,proto3" json:"key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *AttestationVote) Reset()         { *m = AttestationVote{} }
+func (m *AttestationVote) String() string { return proto.CompactTextString(m) }
+func (*AttestationVote) ProtoMessage()    {}
+func (*AttestationVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc6664e18d413fc7, []int{3}
+}
+
+func (m *AttestationVote) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AttestationVote.Unmarshal(m, b)
+}
+func (m *AttestationVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AttestationVote.Marshal(b, m, deterministic)
+}
+func (m *AttestationVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttestationVote.Merge(m, src)
+}
+func (m *AttestationVote) XXX_Size() int {
+	return xxx_messageInfo_AttestationVote.Size(m)
+}
+func (m *AttestationVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttestationVote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AttestationVote proto.InternalMessageInfo
+
+func (m *AttestationVote) GetNumber() int64 {
+	if m != nil {
+		return m.Number
+	}
+	return 0
+}
+
+func (m *AttestationVote) GetBlockHash() []byte {
+	if m != nil {
+		return m.BlockHash
+	}
+	return nil
+}
+
+func (m *AttestationVote) GetSign() *pb.Signature {
+	if m != nil {
+		return m.Sign
+	}
+	return nil
+}
+
+func (m *AttestationVote) GetBlsSign() []byte {
+	if m != nil {
+		return m.BlsSign
+	}
+	return nil
+}
+
+func (m *AttestationVote) GetKey() *WitnessKey {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+type Attestation struct {
+	Number               int64           `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"`
+	BlockHash            []byte          `protobuf:"bytes,2,opt,name=blockHash,proto3" json:"blockHash,omitempty"`
+	Witnesses            []string        `protobuf:"bytes,3,rep,name=witnesses,proto3" json:"witnesses,omitempty"`
+	Bitmap               []byte          `protobuf:"bytes,4,opt,name=bitmap,proto3" json:"bitmap,omitempty"`
+	Signs                []*pb.Signature `protobuf:"bytes,5,rep,name=signs,proto3" json:"signs,omitempty"`
+	Aggregate            []byte          `protobuf:"bytes,6,opt,name=aggregate,proto3" json:"aggregate,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *Attestation) Reset()         { *m = Attestation{} }
+func (m *Attestation) String() string { return proto.CompactTextString(m) }
+func (*Attestation) ProtoMessage()    {}
+func (*Attestation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc6664e18d413fc7, []int{4}
+}
+
+func (m *Attestation) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Attestation.Unmarshal(m, b)
+}
+func (m *Attestation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Attestation.Marshal(b, m, deterministic)
+}
+func (m *Attestation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Attestation.Merge(m, src)
+}
+func (m *Attestation) XXX_Size() int {
+	return xxx_messageInfo_Attestation.Size(m)
+}
+func (m *Attestation) XXX_DiscardUnknown() {
+	xxx_messageInfo_Attestation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Attestation proto.InternalMessageInfo
+
+func (m *Attestation) GetNumber() int64 {
+	if m != nil {
+		return m.Number
+	}
+	return 0
+}
+
+func (m *Attestation) GetBlockHash() []byte {
+	if m != nil {
+		return m.BlockHash
+	}
+	return nil
+}
+
+func (m *Attestation) GetWitnesses() []string {
+	if m != nil {
+		return m.Witnesses
+	}
+	return nil
+}
+
+func (m *Attestation) GetBitmap() []byte {
+	if m != nil {
+		return m.Bitmap
+	}
+	return nil
+}
+
+func (m *Attestation) GetSigns() []*pb.Signature {
+	if m != nil {
+		return m.Signs
+	}
+	return nil
+}
+
+func (m *Attestation) GetAggregate() []byte {
+	if m != nil {
+		return m.Aggregate
+	}
+	return nil
+}
+
+type DoubleSignEvidence struct {
+	Head1                *BlockHead    `protobuf:"bytes,1,opt,name=head1,proto3" json:"head1,omitempty"`
+	Sign1                *pb.Signature `protobuf:"bytes,2,opt,name=sign1,proto3" json:"sign1,omitempty"`
+	Head2                *BlockHead    `protobuf:"bytes,3,opt,name=head2,proto3" json:"head2,omitempty"`
+	Sign2                *pb.Signature `protobuf:"bytes,4,opt,name=sign2,proto3" json:"sign2,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *DoubleSignEvidence) Reset()         { *m = DoubleSignEvidence{} }
+func (m *DoubleSignEvidence) String() string { return proto.CompactTextString(m) }
+func (*DoubleSignEvidence) ProtoMessage()    {}
+func (*DoubleSignEvidence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc6664e18d413fc7, []int{5}
+}
+
+func (m *DoubleSignEvidence) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DoubleSignEvidence.Unmarshal(m, b)
+}
+func (m *DoubleSignEvidence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DoubleSignEvidence.Marshal(b, m, deterministic)
+}
+func (m *DoubleSignEvidence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DoubleSignEvidence.Merge(m, src)
+}
+func (m *DoubleSignEvidence) XXX_Size() int {
+	return xxx_messageInfo_DoubleSignEvidence.Size(m)
+}
+func (m *DoubleSignEvidence) XXX_DiscardUnknown() {
+	xxx_messageInfo_DoubleSignEvidence.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DoubleSignEvidence proto.InternalMessageInfo
+
+func (m *DoubleSignEvidence) GetHead1() *BlockHead {
+	if m != nil {
+		return m.Head1
+	}
+	return nil
+}
+
+func (m *DoubleSignEvidence) GetSign1() *pb.Signature {
+	if m != nil {
+		return m.Sign1
+	}
+	return nil
+}
+
+func (m *DoubleSignEvidence) GetHead2() *BlockHead {
+	if m != nil {
+		return m.Head2
+	}
+	return nil
+}
+
+func (m *DoubleSignEvidence) GetSign2() *pb.Signature {
+	if m != nil {
+		return m.Sign2
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("blockpb.BlockType", BlockType_name, BlockType_value)
 	proto.RegisterType((*BlockHead)(nil), "blockpb.BlockHead")
 	proto.RegisterType((*Block)(nil), "blockpb.Block")
+	proto.RegisterType((*WitnessKey)(nil), "blockpb.WitnessKey")
+	proto.RegisterType((*AttestationVote)(nil), "blockpb.AttestationVote")
+	proto.RegisterType((*Attestation)(nil), "blockpb.Attestation")
+	proto.RegisterType((*DoubleSignEvidence)(nil), "blockpb.DoubleSignEvidence")
 }
 
 func init() { proto.RegisterFile("core/block/pb/block.proto", fileDescriptor_dc6664e18d413fc7) }
 
 var fileDescriptor_dc6664e18d413fc7 = []byte{
-	// 427 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0x4f, 0x6f, 0xd3, 0x40,
-	0x10, 0xc5, 0x71, 0xed, 0x3a, 0xce, 0x24, 0x40, 0x34, 0x48, 0x68, 0xc9, 0x01, 0x59, 0x51, 0x41,
-	0x16, 0xa8, 0x76, 0x15, 0x38, 0x71, 0x2b, 0xa7, 0x1c, 0xfa, 0x47, 0xda, 0xf6, 0xc2, 0xd1, 0x76,
-	0x37, 0xc9, 0xaa, 0x89, 0x77, 0xb5, 0xbb, 0x01, 0xf7, 0x6b, 0xf0, 0x5d, 0xb9, 0xa3, 0x1d, 0x3b,
-	0xa1, 0x45, 0x48, 0xdc, 0xf6, 0xbd, 0xf9, 0xed, 0x78, 0xde, 0x78, 0xe1, 0x4d, 0xad, 0x8c, 0x28,
-	0xaa, 0x8d, 0xaa, 0xef, 0x0b, 0x5d, 0x75, 0x87, 0x5c, 0x1b, 0xe5, 0x14, 0x0e, 0x48, 0xe8, 0x6a,
-	0xfa, 0x65, 0x25, 0xdd, 0x7a, 0x57, 0xe5, 0xb5, 0xda, 0x16, 0x52, 0x59, 0x77, 0xaa, 0x96, 0x4b,
-	0x59, 0xcb, 0x72, 0x53, 0xac, 0xd4, 0xa9, 0x37, 0x8a, 0xda, 0x3c, 0x68, 0xa7, 0x7c, 0x03, 0x2b,
-	0x57, 0x4d, 0xe9, 0x76, 0x46, 0x74, 0x4d, 0xa6, 0x9f, 0xff, 0x7f, 0xd7, 0x0f, 0xe0, 0x5a, 0x7f,
-	0xd9, 0xb5, 0xdd, 0xad, 0xd9, 0xaf, 0x00, 0x86, 0x5f, 0xfd, 0xd7, 0x17, 0xa2, 0xbc, 0x43, 0x06,
-	0x83, 0xef, 0xc2, 0x58, 0xa9, 0x1a, 0x16, 0xa4, 0x41, 0x16, 0xf2, 0xbd, 0xc4, 0xb7, 0x00, 0xba,
-	0x34, 0xa2, 0x71, 0x8b, 0xd2, 0xae, 0xd9, 0x51, 0x1a, 0x64, 0x63, 0xfe, 0xc8, 0xc1, 0x19, 0x8c,
-	0x5d, 0x7b, 0x29, 0xcc, 0xfd, 0x46, 0x10, 0x11, 0x12, 0xf1, 0xc4, 0xc3, 0x33, 0x78, 0xe5, 0x5a,
-	0x2e, 0x6a, 0x21, 0xb5, 0x7b, 0x84, 0x46, 0x84, 0xfe, 0xab, 0x84, 0x08, 0x91, 0x6c, 0x96, 0x8a,
-	0x1d, 0x13, 0x42, 0x67, 0x7c, 0x0d, 0x71, 0xb3, 0xdb, 0x56, 0xc2, 0xb0, 0x98, 0x46, 0xec, 0x95,
-	0x9f, 0xfd, 0x87, 0x74, 0x8d, 0xb0, 0x96, 0x0d, 0xd2, 0x20, 0x1b, 0xf2, 0xbd, 0xf4, 0x5d, 0x9c,
-	0xdc, 0x0a, 0x96, 0x10, 0x4f, 0xe7, 0xd9, 0xcf, 0x23, 0x38, 0xa6, 0xdc, 0xf8, 0x1e, 0xa2, 0xb5,
-	0x28, 0xef, 0x28, 0xf0, 0x68, 0x8e, 0x79, 0xff, 0x2f, 0xf2, 0xc3, 0x56, 0x38, 0xd5, 0xf1, 0x04,
-	0x22, 0xbf, 0x72, 0xca, 0x3e, 0x9a, 0x4f, 0x72, 0x2b, 0x57, 0xba, 0xca, 0x6f, 0xf6, 0x7f, 0x81,
-	0x53, 0x15, 0xa7, 0x10, 0xba, 0xd6, 0xb2, 0x30, 0x0d, 0xb3, 0xd1, 0x3c, 0xc9, 0x5d, 0xab, 0xab,
-	0xfc, 0xb6, 0xe5, 0xde, 0xc4, 0x8f, 0x90, 0x98, 0x2e, 0xa2, 0x65, 0x11, 0x01, 0x2f, 0x0f, 0x40,
-	0xe7, 0xf3, 0x03, 0x80, 0x53, 0x48, 0x5c, 0xeb, 0x97, 0x20, 0x2c, 0x3b, 0x4e, 0xc3, 0x6c, 0xcc,
-	0x0f, 0x1a, 0x4f, 0xe0, 0x79, 0xcf, 0xf5, 0x40, 0x4c, 0xc0, 0x53, 0x13, 0xcf, 0x60, 0x48, 0x59,
-	0x6e, 0x1f, 0xb4, 0xa0, 0x95, 0xbc, 0xf8, 0x3b, 0x9d, 0xaf, 0xf0, 0x3f, 0xd0, 0x87, 0x77, 0xfd,
-	0x5b, 0xf0, 0x02, 0x01, 0xe2, 0xab, 0x6b, 0x7e, 0x79, 0x7e, 0x31, 0x79, 0x86, 0x63, 0x48, 0xae,
-	0xaf, 0x2e, 0xbe, 0x2d, 0xce, 0x6f, 0x16, 0x93, 0xa0, 0x8a, 0xe9, 0xe9, 0x7c, 0xfa, 0x1d, 0x00,
-	0x00, 0xff, 0xff, 0xb4, 0x8b, 0xe6, 0x72, 0xd2, 0x02, 0x00, 0x00,
+	// 642 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x4d, 0x6f, 0xd3, 0x40,
+	0x10, 0xc5, 0x75, 0x9c, 0xc6, 0x93, 0x40, 0xab, 0x2d, 0x42, 0x4b, 0x54, 0xa1, 0xc8, 0x6a, 0xab,
+	0x08, 0x54, 0xa7, 0x35, 0x9c, 0xb8, 0x15, 0x81, 0x14, 0x89, 0x7e, 0x48, 0xdb, 0x0a, 0xc4, 0x0d,
+	0xdb, 0x9d, 0xb8, 0xab, 0x26, 0x5e, 0xcb, 0xde, 0x14, 0xe7, 0x6f, 0xc0, 0xff, 0xe0, 0xcc, 0x8d,
+	0xbf, 0x86, 0x76, 0xbd, 0xce, 0x07, 0x2a, 0x01, 0x71, 0xdb, 0x79, 0xf3, 0x66, 0x32, 0xf3, 0xe6,
+	0xc5, 0xf0, 0x34, 0x16, 0x39, 0x0e, 0xa2, 0xb1, 0x88, 0x6f, 0x07, 0x59, 0x54, 0x3d, 0xfc, 0x2c,
+	0x17, 0x52, 0x90, 0x4d, 0x1d, 0x64, 0x51, 0xf7, 0x75, 0xc2, 0xe5, 0xcd, 0x34, 0xf2, 0x63, 0x31,
+	0x19, 0x70, 0x51, 0xc8, 0x43, 0x31, 0x1a, 0xf1, 0x98, 0x87, 0xe3, 0x41, 0x22, 0x0e, 0x15, 0x30,
+	0x88, 0xf3, 0x59, 0x26, 0x85, 0x6a, 0x50, 0xf0, 0x24, 0x0d, 0xe5, 0x34, 0xc7, 0xaa, 0x49, 0xf7,
+	0xd5, 0xdf, 0x6b, 0xd5, 0x00, 0xb2, 0x54, 0xc5, 0xb2, 0xac, 0xaa, 0xbc, 0x6f, 0x1b, 0xe0, 0xbe,
+	0x51, 0xbf, 0x3e, 0xc4, 0xf0, 0x9a, 0x50, 0xd8, 0xbc, 0xc3, 0xbc, 0xe0, 0x22, 0xa5, 0x56, 0xcf,
+	0xea, 0xdb, 0xac, 0x0e, 0xc9, 0x33, 0x80, 0x2c, 0xcc, 0x31, 0x95, 0xc3, 0xb0, 0xb8, 0xa1, 0x1b,
+	0x3d, 0xab, 0xdf, 0x61, 0x4b, 0x08, 0xf1, 0xa0, 0x23, 0xcb, 0x33, 0xcc, 0x6f, 0xc7, 0xa8, 0x19,
+	0xb6, 0x66, 0xac, 0x60, 0xe4, 0x08, 0x76, 0x64, 0xc9, 0x30, 0x46, 0x9e, 0xc9, 0x25, 0x6a, 0x43,
+	0x53, 0xef, 0x4b, 0x11, 0x02, 0x0d, 0x9e, 0x8e, 0x04, 0x75, 0x34, 0x45, 0xbf, 0xc9, 0x13, 0x68,
+	0xa6, 0xd3, 0x49, 0x84, 0x39, 0x6d, 0xea, 0x11, 0x4d, 0xa4, 0x66, 0xff, 0xc2, 0x65, 0x8a, 0x45,
+	0x41, 0x37, 0x7b, 0x56, 0xdf, 0x65, 0x75, 0xa8, 0xba, 0x48, 0x3e, 0x41, 0xda, 0xd2, 0x7c, 0xfd,
+	0x26, 0xbb, 0xe0, 0x16, 0x32, 0x94, 0xc8, 0x84, 0x90, 0xd4, 0xd5, 0xed, 0x17, 0x80, 0xf7, 0x75,
+	0x03, 0x1c, 0xad, 0x0a, 0x39, 0x80, 0xc6, 0x0d, 0x86, 0xd7, 0x5a, 0x8e, 0x76, 0x40, 0x7c, 0x73,
+	0x29, 0x7f, 0xae, 0x19, 0xd3, 0x79, 0xb2, 0x07, 0x0d, 0x75, 0x10, 0xad, 0x4c, 0x3b, 0xd8, 0xf6,
+	0x0b, 0x9e, 0x64, 0x91, 0x7f, 0x59, 0xdf, 0x88, 0xe9, 0x2c, 0xe9, 0x82, 0x2d, 0xcb, 0x82, 0xda,
+	0x3d, 0xbb, 0xdf, 0x0e, 0x5a, 0xbe, 0x2c, 0xb3, 0xc8, 0xbf, 0x2a, 0x99, 0x02, 0xc9, 0x0b, 0x68,
+	0xe5, 0x95, 0x00, 0x05, 0x6d, 0x68, 0xc2, 0xd6, 0x9c, 0x50, 0xe1, 0x6c, 0x4e, 0x20, 0x5d, 0x68,
+	0xc9, 0x52, 0x49, 0x84, 0x05, 0x75, 0x7a, 0x76, 0xbf, 0xc3, 0xe6, 0x31, 0xd9, 0x83, 0x87, 0x86,
+	0x67, 0x08, 0x4d, 0x4d, 0x58, 0x05, 0xc9, 0x11, 0xb8, 0x7a, 0x97, 0xab, 0x59, 0x86, 0x5a, 0xb0,
+	0x47, 0xbf, 0x6f, 0xa7, 0x32, 0x6c, 0x41, 0xf2, 0x3e, 0x03, 0x7c, 0xac, 0x14, 0x7d, 0x8f, 0x33,
+	0x75, 0x86, 0x6c, 0x1a, 0xdd, 0xe2, 0x4c, 0x4b, 0xd3, 0x61, 0x26, 0x22, 0x8f, 0xc1, 0xc9, 0x72,
+	0x21, 0x46, 0xc6, 0x23, 0x55, 0x30, 0x97, 0xc7, 0x5e, 0x27, 0x8f, 0xf7, 0xdd, 0x82, 0xad, 0x13,
+	0x29, 0x51, 0x1d, 0x82, 0x8b, 0xf4, 0x83, 0x90, 0xb8, 0x74, 0x6e, 0x6b, 0xe5, 0xdc, 0xbb, 0x66,
+	0xfe, 0x25, 0x3f, 0x2e, 0x80, 0x7f, 0xfb, 0x3d, 0x65, 0x99, 0x68, 0x5c, 0x28, 0xd4, 0x98, 0xb0,
+	0x0e, 0xc9, 0x3e, 0xd8, 0x6a, 0x35, 0x47, 0x97, 0xef, 0xcc, 0x75, 0x59, 0xec, 0xcf, 0x54, 0xde,
+	0xfb, 0x69, 0x41, 0x7b, 0x69, 0xe0, 0xff, 0x1c, 0x76, 0x17, 0x5c, 0x63, 0x55, 0xac, 0xbc, 0xe1,
+	0xb2, 0x05, 0xa0, 0x7a, 0x46, 0x5c, 0x4e, 0xc2, 0xcc, 0xcc, 0x68, 0x22, 0x72, 0x00, 0x8e, 0x5a,
+	0xa2, 0xba, 0xff, 0x7d, 0x3b, 0x56, 0x69, 0xd5, 0x3d, 0x4c, 0x92, 0x1c, 0x93, 0x50, 0xa2, 0xfe,
+	0xcb, 0x74, 0xd8, 0x02, 0xf0, 0x7e, 0x58, 0x40, 0xde, 0x8a, 0x69, 0x34, 0x46, 0x55, 0xf8, 0xee,
+	0x8e, 0x5f, 0x63, 0x1a, 0x23, 0xe9, 0x83, 0xa3, 0x6c, 0x7d, 0xbc, 0xc6, 0xf7, 0x15, 0xa1, 0x1e,
+	0xe3, 0xf8, 0x8f, 0xce, 0xaf, 0xd2, 0x75, 0xc7, 0x80, 0xda, 0xeb, 0x3b, 0x06, 0x75, 0xc7, 0x80,
+	0x36, 0xd6, 0x75, 0x0c, 0x9e, 0xef, 0x9b, 0x2f, 0x97, 0x32, 0x27, 0x01, 0x68, 0x9e, 0x5f, 0xb0,
+	0xb3, 0x93, 0xd3, 0xed, 0x07, 0xa4, 0x03, 0xad, 0x8b, 0xf3, 0xd3, 0x4f, 0xc3, 0x93, 0xcb, 0xe1,
+	0xb6, 0x15, 0x35, 0xf5, 0x87, 0xee, 0xe5, 0xaf, 0x01, 0x00, 0x84, 0xd2, 0x34, 0x2f, 0x80, 0x05,
+	0x00, 0x00,
 }
@@ -12,6 +12,7 @@ import (
 	"github.com/golang/protobuf/proto"
 	"github.com/iost-official/go-iost/common"
 	"github.com/iost-official/go-iost/core/block"
+	"github.com/iost-official/go-iost/core/event"
 	"github.com/iost-official/go-iost/core/global"
 	"github.com/iost-official/go-iost/db"
 	"github.com/iost-official/go-iost/db/wal"
@@ -277,6 +278,13 @@ type BlockCacheImpl struct { //nolint:golint
 	blockChain        block.Chain
 	stateDB           db.MVCCDB
 	wal               *wal.WAL
+	flushHooks        []func(*block.Block)
+}
+
+// AddFlushHook adds a hook called with the irreversible block right after its state is flushed into db.
+// The hooks are called in the goroutine of flushing, so they should return quickly.
+func (bc *BlockCacheImpl) AddFlushHook(hook func(*block.Block)) {
+	bc.flushHooks = append(bc.flushHooks, hook)
 }
 
 // CleanDir used in test to clean dir
@@ -511,6 +519,11 @@ func (bc *BlockCacheImpl) updateLib(node *BlockCacheNode, confirmLimit int) {
 		blockList[bc.LinkedRoot().Head.Number+1] != nil {
 		// bc.flush() will change node.ValidWitness, bc.LinkedRoot() and bc.linkedRootWitness
 		bc.flush(blockList[bc.LinkedRoot().Head.Number+1])
+		// post NewLIB for every block becoming irreversible, even if the lib moves several blocks at once
+		if lib := bc.LinkedRoot(); lib != root && lib.Block != nil {
+			event.GetCollector().PostData(event.NewLIB, toBlockEventData(lib), nil)
+			root = lib
+		}
 	}
 }
 
@@ -629,11 +642,34 @@ func (bc *BlockCacheImpl) updateLongest() {
 	if ok {
 		return
 	}
+	if longest := bc.longestLeaf(nil); longest != nil {
+		bc.SetHead(longest)
+	}
+}
+
+// longestLeaf returns the longest leaf under the root, or under any node if root is nil.
+func (bc *BlockCacheImpl) longestLeaf(root *BlockCacheNode) *BlockCacheNode {
+	longest := bc.Head()
+	if root != nil {
+		longest = root
+	}
 	for bcn := range bc.leaf {
-		if bcn.Head.Number > bc.Head().Head.Number || (bcn.Head.Number == bc.Head().Head.Number && bcn.Head.Time < bc.Head().Head.Time) {
-			bc.SetHead(bcn)
+		if root != nil && !isDescendant(bcn, root) {
+			continue
+		}
+		if bcn.Head.Number > longest.Head.Number || (bcn.Head.Number == longest.Head.Number && bcn.Head.Time < longest.Head.Time) {
+			longest = bcn
 		}
 	}
+	return longest
+}
+
+// isDescendant returns whether the node is the root or in the subtree of the root.
+func isDescendant(bcn *BlockCacheNode, root *BlockCacheNode) bool {
+	for bcn != nil && bcn.Head.Number > root.Head.Number {
+		bcn = bcn.GetParent()
+	}
+	return bcn == root
 }
 
 // Add is add a block
@@ -719,6 +755,10 @@ func (bc *BlockCacheImpl) flush(bcn *BlockCacheNode) {
 	if parent != bc.LinkedRoot() {
 		ilog.Errorf("block isn't blockcache root's child")
 	}
+	// switch the head before deleting its branch so that the reverted blocks can be traced
+	if !isDescendant(bc.Head(), bcn) {
+		bc.SetHead(bc.longestLeaf(bcn))
+	}
 	for child := range parent.Children {
 		if child == bcn {
 			continue
@@ -743,6 +783,8 @@ func (bc *BlockCacheImpl) flush(bcn *BlockCacheNode) {
 	err := bc.blockChain.Push(bcn.Block)
 	if err != nil {
 		ilog.Errorf("Database error, BlockChain Push err: %v %v", bcn.HeadHash(), err)
+	} else {
+		postIrreversibleTxs(bcn.Block)
 	}
 
 	err = bc.writeUpdateLinkedRootWitnessWAL()
@@ -755,6 +797,10 @@ func (bc *BlockCacheImpl) flush(bcn *BlockCacheNode) {
 
 	if err != nil {
 		ilog.Errorf("flush mvcc error: %v %v", bcn.HeadHash(), err)
+	} else {
+		for _, hook := range bc.flushHooks {
+			hook(bcn.Block)
+		}
 	}
 
 	metricsTxTotal.Set(float64(bc.blockChain.TxTotal()), nil)
@@ -784,6 +830,21 @@ func (bc *BlockCacheImpl) flush(bcn *BlockCacheNode) {
 	bc.cutWALFiles(bcn)
 }
 
+func postIrreversibleTxs(blk *block.Block) {
+	ec := event.GetCollector()
+	if !ec.HasSubscriber(event.TxIrreversible) {
+		return
+	}
+	blkHash := common.Base58Encode(blk.HeadHash())
+	for _, t := range blk.Txs {
+		ec.PostTx(event.TxIrreversible, &event.TxEventData{
+			Hash:        common.Base58Encode(t.Hash()),
+			BlockNumber: blk.Head.Number,
+			BlockHash:   blkHash,
+		})
+	}
+}
+
 func (bc *BlockCacheImpl) writeUpdateLinkedRootWitnessWAL() (err error) {
 	hb, err := encodeUpdateLinkedRootWitness(bc)
 	if err != nil {
@@ -913,8 +974,67 @@ func (bc *BlockCacheImpl) Head() *BlockCacheNode {
 // SetHead sets head blockcache node.
 func (bc *BlockCacheImpl) SetHead(n *BlockCacheNode) {
 	bc.headRW.Lock()
+	old := bc.head
 	bc.head = n
 	bc.headRW.Unlock()
+	if old != n {
+		postHeadEvents(old, n)
+	}
+}
+
+func toBlockEventData(bcn *BlockCacheNode) *event.BlockEventData {
+	return &event.BlockEventData{
+		Number:     bcn.Head.Number,
+		Hash:       common.Base58Encode(bcn.HeadHash()),
+		ParentHash: common.Base58Encode(bcn.Head.ParentHash),
+		Witness:    bcn.Head.Witness,
+		Time:       bcn.Head.Time,
+	}
+}
+
+// findFork returns the hashes of the blocks on the old branch from the old head to the common ancestor,
+// and the common ancestor of the two heads, which is nil if it is not in block cache.
+func findFork(oldHead *BlockCacheNode, newHead *BlockCacheNode) ([]string, *BlockCacheNode) {
+	reverted := make([]string, 0)
+	for oldHead != nil && newHead != nil {
+		if oldHead == newHead {
+			return reverted, oldHead
+		}
+		if newHead.Head.Number > oldHead.Head.Number {
+			if bytes.Equal(newHead.Head.ParentHash, oldHead.HeadHash()) {
+				return reverted, oldHead
+			}
+			newHead = newHead.GetParent()
+		} else {
+			reverted = append(reverted, common.Base58Encode(oldHead.HeadHash()))
+			oldHead = oldHead.GetParent()
+		}
+	}
+	return reverted, nil
+}
+
+func postHeadEvents(oldHead *BlockCacheNode, newHead *BlockCacheNode) {
+	if newHead == nil || newHead.Block == nil {
+		return
+	}
+	ec := event.GetCollector()
+	ec.PostData(event.NewHead, toBlockEventData(newHead), nil)
+	if oldHead == nil || oldHead.Block == nil || !ec.HasSubscriber(event.ChainReorg) {
+		return
+	}
+	reverted, ancestor := findFork(oldHead, newHead)
+	if len(reverted) == 0 {
+		return
+	}
+	data := &event.ReorgEventData{
+		OldHead:        toBlockEventData(oldHead),
+		NewHead:        toBlockEventData(newHead),
+		RevertedBlocks: reverted,
+	}
+	if ancestor != nil && ancestor.Block != nil {
+		data.CommonAncestor = toBlockEventData(ancestor)
+	}
+	ec.PostData(event.ChainReorg, data, nil)
 }
 
 // Draw returns the linkedroot's and singleroot's tree graph.
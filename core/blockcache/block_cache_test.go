@@ -3,6 +3,7 @@ package blockcache
 import (
 	"encoding/json"
 	"testing"
+	"time"
 
 	. "github.com/golang/mock/gomock"
 	core_mock "github.com/iost-official/go-iost/core/mocks"
@@ -10,6 +11,7 @@ import (
 
 	"github.com/iost-official/go-iost/common"
 	"github.com/iost-official/go-iost/core/block"
+	"github.com/iost-official/go-iost/core/event"
 	"github.com/iost-official/go-iost/vm/database"
 	. "github.com/smartystreets/goconvey/convey"
 )
@@ -223,6 +225,7 @@ func TestVote(t *testing.T) {
 	statedb.EXPECT().Flush(Any()).AnyTimes().Return(nil)
 	statedb.EXPECT().Fork().AnyTimes().Return(statedb)
 	statedb.EXPECT().Checkout(Any()).AnyTimes().Return(true)
+	statedb.EXPECT().Size().AnyTimes().Return(int64(0), nil)
 
 	tpl := "[\"a1\",\"a2\",\"a3\",\"a4\",\"a5\"]"
 	//tpl1 := "[\"b1\",\"b2\",\"b3\",\"b4\",\"b5\"]"
@@ -243,6 +246,8 @@ func TestVote(t *testing.T) {
 	base := core_mock.NewMockChain(ctl)
 	base.EXPECT().Top().AnyTimes().Return(b0, nil)
 	base.EXPECT().Push(Any()).AnyTimes().Return(nil)
+	base.EXPECT().TxTotal().AnyTimes().Return(int64(0))
+	base.EXPECT().Size().AnyTimes().Return(int64(0), nil)
 	global := core_mock.NewMockBaseVariable(ctl)
 	global.EXPECT().BlockChain().AnyTimes().Return(base)
 	global.EXPECT().StateDB().AnyTimes().Return(statedb)
@@ -283,6 +288,34 @@ func TestVote(t *testing.T) {
 		So(StringSliceEqual([]string{"a1", "a2", "a3", "a4", "a5"}, bc.head.Pending()), ShouldBeTrue)
 
 	})
+	Convey("test lib events", t, func() {
+		bc, err := NewBlockCache(global)
+		So(err, ShouldBeNil)
+		defer bc.CleanDir()
+		node1 := NewBCN(bc.linkedRoot, b1)
+		node2 := NewBCN(node1, b2)
+		node3 := NewBCN(node2, b3)
+		bc.Link(node1, false)
+		bc.Link(node2, false)
+		bc.Link(node3, false)
+
+		ch := event.GetCollector().Subscribe(1, []event.Topic{event.NewLIB}, nil)
+		defer event.GetCollector().Unsubscribe(1, []event.Topic{event.NewLIB})
+		node3.ValidWitness = []string{"a1", "a2", "a3", "a4", "a5"}
+		bc.UpdateLib(node3)
+		So(bc.LinkedRoot(), ShouldEqual, node3)
+		numbers := make(map[int64]bool)
+		for i := 0; i < 3; i++ {
+			select {
+			case e := <-ch:
+				var data event.BlockEventData
+				So(json.Unmarshal([]byte(e.Data), &data), ShouldBeNil)
+				numbers[data.Number] = true
+			case <-time.After(time.Second):
+			}
+		}
+		So(numbers, ShouldResemble, map[int64]bool{1: true, 2: true, 3: true})
+	})
 	Convey("test info", t, func() {
 		bc, _ := NewBlockCache(global)
 		defer bc.CleanDir()
@@ -292,6 +325,37 @@ func TestVote(t *testing.T) {
 	})
 }
 
+func TestFindFork(t *testing.T) {
+	Convey("test findFork", t, func() {
+		b0 := genBlock(nil, "w0", 0)
+		b1 := genBlock(b0, "w1", 1)
+		b2 := genBlock(b1, "w2", 2)
+		b3 := genBlock(b2, "w3", 3)
+		b2a := genBlock(b1, "w4", 2)
+		b3a := genBlock(b2a, "w5", 3)
+		b4a := genBlock(b3a, "w6", 4)
+
+		n0 := NewBCN(nil, b0)
+		n1 := NewBCN(n0, b1)
+		n2 := NewBCN(n1, b2)
+		n3 := NewBCN(n2, b3)
+		n2a := NewBCN(n1, b2a)
+		n3a := NewBCN(n2a, b3a)
+		n4a := NewBCN(n3a, b4a)
+
+		reverted, ancestor := findFork(n3, n4a)
+		So(reverted, ShouldResemble, []string{common.Base58Encode(b3.HeadHash()), common.Base58Encode(b2.HeadHash())})
+		So(ancestor, ShouldEqual, n1)
+
+		reverted, ancestor = findFork(n1, n3)
+		So(reverted, ShouldBeEmpty)
+		So(ancestor, ShouldEqual, n1)
+
+		So(isDescendant(n4a, n1), ShouldBeTrue)
+		So(isDescendant(n4a, n2), ShouldBeFalse)
+	})
+}
+
 func StringSliceEqual(a, b []string) bool {
 	if len(a) != len(b) {
 		return false
@@ -1,6 +1,7 @@
 package event
 
 import (
+	"encoding/json"
 	"strconv"
 	"sync"
 	"time"
@@ -18,6 +19,13 @@ type Topic int
 const (
 	ContractReceipt Topic = iota
 	ContractEvent
+	TxAccepted
+	TxPacked
+	TxIrreversible
+	TxDropped
+	NewHead
+	NewLIB
+	ChainReorg
 )
 
 func (t Topic) String() string {
@@ -26,6 +34,20 @@ func (t Topic) String() string {
 		return "ContractReceipt"
 	case ContractEvent:
 		return "ContractEvent"
+	case TxAccepted:
+		return "TxAccepted"
+	case TxPacked:
+		return "TxPacked"
+	case TxIrreversible:
+		return "TxIrreversible"
+	case TxDropped:
+		return "TxDropped"
+	case NewHead:
+		return "NewHead"
+	case NewLIB:
+		return "NewLIB"
+	case ChainReorg:
+		return "ChainReorg"
 	default:
 		return "unknown_topic:" + strconv.Itoa(int(t))
 	}
@@ -47,9 +69,46 @@ func NewEvent(topic Topic, data string) *Event {
 	}
 }
 
+// TxEventData is the data of the tx lifecycle events, which is encoded as json.
+type TxEventData struct {
+	Hash        string `json:"hash"`
+	BlockNumber int64  `json:"block_number,omitempty"`
+	BlockHash   string `json:"block_hash,omitempty"`
+	Reason      string `json:"reason,omitempty"`
+}
+
+// BlockEventData is the data of the NewHead and NewLIB events, which is encoded as json.
+// NewLIB is posted once for every block becoming irreversible, even if the lib moves several blocks at once.
+type BlockEventData struct {
+	Number     int64  `json:"number"`
+	Hash       string `json:"hash"`
+	ParentHash string `json:"parent_hash"`
+	Witness    string `json:"witness"`
+	Time       int64  `json:"time"`
+}
+
+// ReorgEventData is the data of the ChainReorg event, which is encoded as json.
+// RevertedBlocks are the hashes of the blocks leaving the longest chain, from the old head to the oldest one.
+type ReorgEventData struct {
+	OldHead        *BlockEventData `json:"old_head"`
+	NewHead        *BlockEventData `json:"new_head"`
+	CommonAncestor *BlockEventData `json:"common_ancestor,omitempty"`
+	RevertedBlocks []string        `json:"reverted_blocks"`
+}
+
+// NewDataEvent generate new event with topic and data encoded as json
+func NewDataEvent(topic Topic, data interface{}) *Event {
+	b, err := json.Marshal(data)
+	if err != nil {
+		ilog.Errorf("marshal event data failed. topic=%s, err=%v", topic, err)
+	}
+	return NewEvent(topic, string(b))
+}
+
 // Meta is the information abount event.
 type Meta struct {
 	ContractID string
+	TxHash     string
 }
 
 // Match checks whether the given meta argument is matched to self.
@@ -61,6 +120,9 @@ func (m *Meta) Match(meta *Meta) bool {
 	if m.ContractID != "" && m.ContractID != meta.ContractID {
 		return false
 	}
+	if m.TxHash != "" && m.TxHash != meta.TxHash {
+		return false
+	}
 	return true
 }
 
@@ -125,6 +187,33 @@ func (ec *Collector) sendEvent(e *Event, meta *Meta) {
 	}
 }
 
+// HasSubscriber returns whether the topic has any subscriber, so that the poster can skip building events.
+func (ec *Collector) HasSubscriber(topic Topic) bool {
+	m, exist := ec.subMap.Load(topic)
+	if !exist {
+		return false
+	}
+	has := false
+	m.(*sync.Map).Range(func(k, v interface{}) bool {
+		has = true
+		return false
+	})
+	return has
+}
+
+// PostData posts an event with data encoded as json if the topic has any subscriber.
+func (ec *Collector) PostData(topic Topic, data interface{}, meta *Meta) {
+	if !ec.HasSubscriber(topic) {
+		return
+	}
+	ec.Post(NewDataEvent(topic, data), meta)
+}
+
+// PostTx posts a tx lifecycle event if the topic has any subscriber.
+func (ec *Collector) PostTx(topic Topic, data *TxEventData) {
+	ec.PostData(topic, data, &Meta{TxHash: data.Hash})
+}
+
 // Post a event.
 func (ec *Collector) Post(e *Event, meta *Meta) {
 	go ec.sendEvent(e, meta)
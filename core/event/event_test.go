@@ -110,3 +110,31 @@ func TestEventCollectorFullPost(t *testing.T) {
 
 	assert.EqualValues(t, event.EventChSize, atomic.LoadInt32(&count))
 }
+
+func TestEventCollectorPostTx(t *testing.T) {
+	ilog.Stop()
+	ec := event.GetCollector()
+
+	assert.False(t, ec.HasSubscriber(event.TxPacked))
+	ch := ec.Subscribe(10, []event.Topic{event.TxPacked}, &event.Meta{TxHash: "hash1"})
+	assert.True(t, ec.HasSubscriber(event.TxPacked))
+
+	ec.PostTx(event.TxPacked, &event.TxEventData{Hash: "hash0", BlockNumber: 1})
+	ec.PostTx(event.TxPacked, &event.TxEventData{Hash: "hash1", BlockNumber: 2, BlockHash: "block2"})
+
+	select {
+	case e := <-ch:
+		assert.Equal(t, event.TxPacked, e.Topic)
+		assert.Equal(t, `{"hash":"hash1","block_number":2,"block_hash":"block2"}`, e.Data)
+	case <-time.After(time.Millisecond * 100):
+		t.Fatal("expect tx event")
+	}
+	select {
+	case e := <-ch:
+		t.Fatalf("unexpected event %v", e.Data)
+	case <-time.After(time.Millisecond * 100):
+	}
+
+	ec.Unsubscribe(10, []event.Topic{event.TxPacked})
+	assert.False(t, ec.HasSubscriber(event.TxPacked))
+}
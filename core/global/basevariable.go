@@ -73,8 +73,14 @@ func New(conf *common.Config) (*BaseVariableImpl, error) {
 	if err != nil {
 		return nil, fmt.Errorf("new blockchain failed, stop the program. err: %v", err)
 	}
+	blockChain.SetAccountIndex(conf.DB.AccountIndex)
 
-	stateDB, err := db.NewMVCCDB(conf.DB.LdbPath + "StateDB")
+	var stateDB db.MVCCDB
+	if conf.DB.Archive {
+		stateDB, err = db.NewArchiveMVCCDB(conf.DB.LdbPath+"StateDB", conf.DB.ArchiveRetain)
+	} else {
+		stateDB, err = db.NewMVCCDB(conf.DB.LdbPath + "StateDB")
+	}
 	if err != nil {
 		return nil, fmt.Errorf("new statedb failed, stop the program. err: %v", err)
 	}
@@ -84,7 +90,7 @@ func New(conf *common.Config) (*BaseVariableImpl, error) {
 		stateDB:       stateDB,
 		mode:          ModeInit,
 		modeMutex:     new(sync.RWMutex),
-		continuousNum: 6,
+		continuousNum: common.BlocksPerSlot,
 		config:        conf,
 	}, nil
 }
@@ -1,6 +1,7 @@
 package merkletree
 
 import (
+	"bytes"
 	"encoding/hex"
 	"errors"
 	"github.com/iost-official/go-iost/common"
@@ -69,20 +70,46 @@ func (m *MerkleTree) MerklePath(hash []byte) ([][]byte, error) {
 	return mp, nil
 }
 
+// MerkleIndex returns the index of the hash in the leaves
+func (m *MerkleTree) MerkleIndex(hash []byte) (int32, error) {
+	if m.LeafNum == 0 {
+		return 0, errors.New("merkletree hasn't built")
+	}
+	idx, ok := m.Hash2Idx[hex.EncodeToString(hash)]
+	if !ok {
+		return 0, errors.New("hash isn't in the tree")
+	}
+	return idx - m.LeafNum + 1, nil
+}
+
 // MerkleProve is prove of the merkle tree
-//func (m *MerkleTree) MerkleProve(hash []byte, rootHash []byte, mp [][]byte) (bool, error) {
-//	if hash == nil {
-//		return false, errors.New("hash input error")
-//	}
-//	if rootHash == nil {
-//		return false, errors.New("rootHash input error")
-//	}
-//	for _, p := range mp {
-//		if bytes.Compare(hash, p) < 0 {
-//			hash = common.Sha3(append(hash, p...))
-//		} else {
-//			hash = common.Sha3(append(p, hash...))
-//		}
-//	}
-//	return bytes.Equal(hash, rootHash), nil
-//}
+func (m *MerkleTree) MerkleProve(hash []byte, rootHash []byte, mp [][]byte) (bool, error) {
+	index, err := m.MerkleIndex(hash)
+	if err != nil {
+		return false, err
+	}
+	return VerifyMerklePath(hash, index, rootHash, mp), nil
+}
+
+// VerifyMerklePath checks whether the merkle path proves the hash at the index of the leaves, without the tree
+func VerifyMerklePath(hash []byte, index int32, rootHash []byte, mp [][]byte) bool {
+	if hash == nil || rootHash == nil || index < 0 || len(mp) >= 31 {
+		return false
+	}
+	if len(mp) == 0 {
+		return index == 0 && bytes.Equal(common.Sha3(append(append([]byte{}, hash...), hash...)), rootHash)
+	}
+	idx := index + int32(1)<<uint(len(mp)) - 1
+	if index >= int32(1)<<uint(len(mp)) {
+		return false
+	}
+	for _, p := range mp {
+		if idx%2 == 1 {
+			hash = common.Sha3(append(append([]byte{}, hash...), p...))
+		} else {
+			hash = common.Sha3(append(append([]byte{}, p...), hash...))
+		}
+		idx = (idx - 1) / 2
+	}
+	return bytes.Equal(hash, rootHash)
+}
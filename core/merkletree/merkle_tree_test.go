@@ -48,8 +48,21 @@ func TestMerkleTree(t *testing.T) {
 		So(hex.EncodeToString(mp[0]), ShouldEqual, "6e6f646535")
 		So(hex.EncodeToString(mp[1]), ShouldEqual, "d19d621d37ab476679ff47b1e3ab8013c7bef9e7b7b18a392748fc9764d131c8")
 		So(hex.EncodeToString(mp[2]), ShouldEqual, "1d4c19fd3644f573c1c502dd8ebb4ae1f009ccd4b21182383d4f951afbc5f0bf")
-		//success, _ := m.MerkleProve([]byte("node5"), rootHash, mp)
-		//So(success, ShouldBeTrue)
+		success, _ := m.MerkleProve([]byte("node5"), rootHash, mp)
+		So(success, ShouldBeTrue)
+		So(VerifyMerklePath([]byte("node5"), 4, rootHash, mp), ShouldBeTrue)
+		So(VerifyMerklePath([]byte("node5"), 3, rootHash, mp), ShouldBeFalse)
+		So(VerifyMerklePath([]byte("node4"), 4, rootHash, mp), ShouldBeFalse)
+		for i, d := range data {
+			mp, err := m.MerklePath(d)
+			So(err, ShouldBeNil)
+			So(VerifyMerklePath(d, int32(i), rootHash, mp), ShouldBeTrue)
+		}
+		single := MerkleTree{}
+		single.Build(data[:1])
+		mp, err = single.MerklePath(data[0])
+		So(err, ShouldBeNil)
+		So(VerifyMerklePath(data[0], 0, single.RootHash(), mp), ShouldBeTrue)
 		b, err := proto.Marshal(&m)
 		if err != nil {
 			log.Panic(err)
@@ -32,8 +32,7 @@ func (m *TXRMerkleTree) MerklePath(hash []byte) ([][]byte, error) {
 
 // MerkleProve return prove of the merkle tree
 func (m *TXRMerkleTree) MerkleProve(hash []byte, rootHash []byte, mp [][]byte) (bool, error) {
-	//return m.Mt.MerkleProve(hash, rootHash, mp)
-	return false, nil
+	return m.Mt.MerkleProve(hash, rootHash, mp)
 }
 
 // Encode is marshal of the merkle tree
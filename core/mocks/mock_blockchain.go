@@ -79,6 +79,19 @@ func (mr *MockChainMockRecorder) Draw(arg0, arg1 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Draw", reflect.TypeOf((*MockChain)(nil).Draw), arg0, arg1)
 }
 
+// GetAttestation mocks base method
+func (m *MockChain) GetAttestation(arg0 int64) (*block.Attestation, error) {
+	ret := m.ctrl.Call(m, "GetAttestation", arg0)
+	ret0, _ := ret[0].(*block.Attestation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAttestation indicates an expected call of GetAttestation
+func (mr *MockChainMockRecorder) GetAttestation(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttestation", reflect.TypeOf((*MockChain)(nil).GetAttestation), arg0)
+}
+
 // GetBlockByHash mocks base method
 func (m *MockChain) GetBlockByHash(arg0 []byte) (*block.Block, error) {
 	ret := m.ctrl.Call(m, "GetBlockByHash", arg0)
@@ -105,6 +118,19 @@ func (mr *MockChainMockRecorder) GetBlockByNumber(arg0 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockByNumber", reflect.TypeOf((*MockChain)(nil).GetBlockByNumber), arg0)
 }
 
+// GetBlockByTxHash mocks base method
+func (m *MockChain) GetBlockByTxHash(arg0 []byte) (*block.Block, error) {
+	ret := m.ctrl.Call(m, "GetBlockByTxHash", arg0)
+	ret0, _ := ret[0].(*block.Block)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBlockByTxHash indicates an expected call of GetBlockByTxHash
+func (mr *MockChainMockRecorder) GetBlockByTxHash(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockByTxHash", reflect.TypeOf((*MockChain)(nil).GetBlockByTxHash), arg0)
+}
+
 // GetHashByNumber mocks base method
 func (m *MockChain) GetHashByNumber(arg0 int64) ([]byte, error) {
 	ret := m.ctrl.Call(m, "GetHashByNumber", arg0)
@@ -157,6 +183,19 @@ func (mr *MockChainMockRecorder) GetTx(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTx", reflect.TypeOf((*MockChain)(nil).GetTx), arg0)
 }
 
+// GetWitnessKey mocks base method
+func (m *MockChain) GetWitnessKey(arg0 string) (*block.WitnessKey, error) {
+	ret := m.ctrl.Call(m, "GetWitnessKey", arg0)
+	ret0, _ := ret[0].(*block.WitnessKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWitnessKey indicates an expected call of GetWitnessKey
+func (mr *MockChainMockRecorder) GetWitnessKey(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWitnessKey", reflect.TypeOf((*MockChain)(nil).GetWitnessKey), arg0)
+}
+
 // HasReceipt mocks base method
 func (m *MockChain) HasReceipt(arg0 []byte) (bool, error) {
 	ret := m.ctrl.Call(m, "HasReceipt", arg0)
@@ -183,6 +222,42 @@ func (mr *MockChainMockRecorder) HasTx(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasTx", reflect.TypeOf((*MockChain)(nil).HasTx), arg0)
 }
 
+// IterateAccountTxs mocks base method
+func (m *MockChain) IterateAccountTxs(arg0 string, arg1 int64, arg2 int32, arg3 func(int64, int32, []byte) bool) error {
+	ret := m.ctrl.Call(m, "IterateAccountTxs", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// IterateAccountTxs indicates an expected call of IterateAccountTxs
+func (mr *MockChainMockRecorder) IterateAccountTxs(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IterateAccountTxs", reflect.TypeOf((*MockChain)(nil).IterateAccountTxs), arg0, arg1, arg2, arg3)
+}
+
+// IterateContractReceipts mocks base method
+func (m *MockChain) IterateContractReceipts(arg0 string, arg1, arg2 int64, arg3 func(int64, []byte, int32) bool) error {
+	ret := m.ctrl.Call(m, "IterateContractReceipts", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// IterateContractReceipts indicates an expected call of IterateContractReceipts
+func (mr *MockChainMockRecorder) IterateContractReceipts(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IterateContractReceipts", reflect.TypeOf((*MockChain)(nil).IterateContractReceipts), arg0, arg1, arg2, arg3)
+}
+
+// IterateTokens mocks base method
+func (m *MockChain) IterateTokens(arg0 string, arg1 func(string) bool) error {
+	ret := m.ctrl.Call(m, "IterateTokens", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// IterateTokens indicates an expected call of IterateTokens
+func (mr *MockChainMockRecorder) IterateTokens(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IterateTokens", reflect.TypeOf((*MockChain)(nil).IterateTokens), arg0, arg1)
+}
+
 // Length mocks base method
 func (m *MockChain) Length() int64 {
 	ret := m.ctrl.Call(m, "Length")
@@ -207,6 +282,40 @@ func (mr *MockChainMockRecorder) Push(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Push", reflect.TypeOf((*MockChain)(nil).Push), arg0)
 }
 
+// PutAttestation mocks base method
+func (m *MockChain) PutAttestation(arg0 *block.Attestation) error {
+	ret := m.ctrl.Call(m, "PutAttestation", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PutAttestation indicates an expected call of PutAttestation
+func (mr *MockChainMockRecorder) PutAttestation(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutAttestation", reflect.TypeOf((*MockChain)(nil).PutAttestation), arg0)
+}
+
+// PutWitnessKey mocks base method
+func (m *MockChain) PutWitnessKey(arg0 *block.WitnessKey) error {
+	ret := m.ctrl.Call(m, "PutWitnessKey", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PutWitnessKey indicates an expected call of PutWitnessKey
+func (mr *MockChainMockRecorder) PutWitnessKey(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutWitnessKey", reflect.TypeOf((*MockChain)(nil).PutWitnessKey), arg0)
+}
+
+// SetAccountIndex mocks base method
+func (m *MockChain) SetAccountIndex(arg0 bool) {
+	m.ctrl.Call(m, "SetAccountIndex", arg0)
+}
+
+// SetAccountIndex indicates an expected call of SetAccountIndex
+func (mr *MockChainMockRecorder) SetAccountIndex(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAccountIndex", reflect.TypeOf((*MockChain)(nil).SetAccountIndex), arg0)
+}
+
 // SetLength mocks base method
 func (m *MockChain) SetLength(arg0 int64) {
 	m.ctrl.Call(m, "SetLength", arg0)
@@ -105,7 +105,7 @@ func SignTx(tx *Tx, id string, kps []*account.KeyPair, signs ...*crypto.Signatur
 	tx.Signs = append(tx.Signs, signs...)
 	tx.PublishSigns = []*crypto.Signature{}
 	for _, kp := range kps {
-		sig := kp.Sign(tx.publishHash())
+		sig := kp.Sign(tx.PublishHash())
 		tx.PublishSigns = append(tx.PublishSigns, sig)
 	}
 	tx.Publisher = id
@@ -113,8 +113,26 @@ func SignTx(tx *Tx, id string, kps []*account.KeyPair, signs ...*crypto.Signatur
 	return tx, nil
 }
 
+// PublishSigner signs the tx as the publisher, such as a remote signer.
+type PublishSigner interface {
+	SignTx(t *Tx) (*crypto.Signature, error)
+}
+
+// SignTxBySigner sign the whole tx with the signer, such as a remote signer, only publisher should do this
+func SignTxBySigner(tx *Tx, id string, s PublishSigner, signs ...*crypto.Signature) (*Tx, error) {
+	tx.Signs = append(tx.Signs, signs...)
+	sig, err := s.SignTx(tx)
+	if err != nil {
+		return nil, err
+	}
+	tx.PublishSigns = []*crypto.Signature{sig}
+	tx.Publisher = id
+	tx.hash = nil
+	return tx, nil
+}
+
 // publishHash
-func (t *Tx) publishHash() []byte {
+func (t *Tx) PublishHash() []byte {
 	return common.Sha3(t.ToBytes(Publish))
 }
 
@@ -297,7 +315,7 @@ func (t *Tx) VerifySelf() error { // nolint
 		return fmt.Errorf("publisher empty error")
 	}
 	for _, sign := range t.PublishSigns {
-		ok := sign != nil && sign.Verify(t.publishHash())
+		ok := sign != nil && sign.Verify(t.PublishHash())
 		if !ok {
 			return fmt.Errorf("publisher error")
 		}
@@ -2,6 +2,7 @@ package tx
 
 import (
 	"encoding/json"
+	"strings"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/iost-official/go-iost/common"
@@ -88,6 +89,14 @@ func (r *Receipt) FromPb(rp *txpb.Receipt) *Receipt {
 	return r
 }
 
+// ContractID returns the id of the contract which generated the receipt.
+func (r *Receipt) ContractID() string {
+	if idx := strings.Index(r.FuncName, "/"); idx >= 0 {
+		return r.FuncName[:idx]
+	}
+	return r.FuncName
+}
+
 // ToBytes converts Receipt to a specific byte slice.
 func (r *Receipt) ToBytes() []byte {
 	se := common.NewSimpleEncoder()
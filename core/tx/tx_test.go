@@ -239,7 +239,7 @@ func TestTx_Platform(t *testing.T) {
 
 	fmt.Printf(`"tx_bytes_1" : "%x",`+"\n", txx.ToBytes(1))
 
-	fmt.Printf(`"tx_publish_hash" : "%x",`+"\n", txx.publishHash())
+	fmt.Printf(`"tx_publish_hash" : "%x",`+"\n", txx.PublishHash())
 
 	tx2, err := SignTx(txx, "def", []*account.KeyPair{kp})
 
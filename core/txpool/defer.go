@@ -7,6 +7,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/iost-official/go-iost/common"
 	"github.com/iost-official/go-iost/core/tx"
 	"github.com/iost-official/go-iost/ilog"
 
@@ -154,7 +155,7 @@ func (d *DeferServer) restartDeferTicker() {
 
 func (d *DeferServer) deferTicker() {
 	for {
-		scheduled := time.Duration(d.nextScheduleTime.Load() - time.Now().UnixNano())
+		scheduled := time.Duration(d.nextScheduleTime.Load() - common.NowNano())
 		if scheduled < minTickerTime {
 			scheduled = minTickerTime
 		}
@@ -170,7 +171,7 @@ func (d *DeferServer) deferTicker() {
 			d.rw.RUnlock()
 			for ok {
 				idx := iter.Key().(*tx.Tx)
-				if idx.Time > time.Now().UnixNano() {
+				if idx.Time > common.NowNano() {
 					d.nextScheduleTime.Store(idx.Time)
 					break
 				}
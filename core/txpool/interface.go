@@ -4,6 +4,7 @@ import (
 	"github.com/iost-official/go-iost/core/block"
 	"github.com/iost-official/go-iost/core/blockcache"
 	"github.com/iost-official/go-iost/core/tx"
+	"github.com/iost-official/go-iost/crypto"
 )
 
 //go:generate mockgen -destination mock/mock_txpool.go -package txpool_mock github.com/iost-official/go-iost/core/txpool TxPool
@@ -14,6 +15,7 @@ type TxPool interface {
 	Stop()
 	AddLinkedNode(linkedNode *blockcache.BlockCacheNode) error
 	AddTx(tx *tx.Tx) error
+	CancelTx(hash []byte, sign *crypto.Signature) error
 	DelTx(hash []byte) error
 	DelTxList(delList []*tx.Tx)
 	ExistTxs(hash []byte, chainBlock *block.Block) FRet
@@ -22,4 +24,6 @@ type TxPool interface {
 	Lock()
 	Release()
 	PendingTx() (*SortedTxMap, *blockcache.BlockCacheNode)
+	PendingTxs(filter func(t *tx.Tx) bool, limit int) []*PendingTxInfo
+	PendingTxCount() int
 }
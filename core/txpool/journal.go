@@ -0,0 +1,178 @@
+package txpool
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/iost-official/go-iost/core/tx"
+	"github.com/iost-official/go-iost/db/wal"
+	"github.com/iost-official/go-iost/ilog"
+)
+
+var (
+	txJournalDir      = "./TxPoolJournal"
+	txJournalMeta     = []byte("txpool_journal")
+	txJournalInterval = time.Minute
+)
+
+// txJournal records the accepted pending txs in a wal, so that they can be reloaded after restart.
+// The journal only grows between rotations, the removed txs are dropped when it is rotated
+// with the current pending txs.
+type txJournal struct {
+	path string
+	mu   sync.Mutex
+	wal  *wal.WAL
+}
+
+func newTxJournal(path string) *txJournal {
+	return &txJournal{
+		path: path,
+	}
+}
+
+// load reads all the txs in the journal. The journal is not writable until it is rotated.
+func (j *txJournal) load() ([]*tx.Tx, error) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	j.recover()
+	if !wal.Exist(j.path) {
+		return nil, nil
+	}
+	w, err := wal.Create(j.path, txJournalMeta)
+	if err != nil {
+		return nil, fmt.Errorf("fail to open txpool journal: %v", err)
+	}
+	defer w.Close()
+	if !w.HasDecoder() {
+		return nil, nil
+	}
+	_, entries, err := w.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("fail to read txpool journal: %v", err)
+	}
+	txs := make([]*tx.Tx, 0, len(entries))
+	for _, entry := range entries {
+		var t tx.Tx
+		if err := t.Decode(entry.Data); err != nil {
+			ilog.Warnf("fail to decode tx in txpool journal: %v", err)
+			continue
+		}
+		txs = append(txs, &t)
+	}
+	return txs, nil
+}
+
+// insert appends the tx to the journal.
+func (j *txJournal) insert(t *tx.Tx) error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if j.wal == nil {
+		return nil
+	}
+	_, err := j.wal.SaveSingle(wal.Entry{Data: t.Encode()})
+	return err
+}
+
+// rotate regenerates the journal with the txs. The new journal is written and synced in a temporary
+// directory first, and then renamed to replace the old one, so that a crash never loses both of them.
+func (j *txJournal) rotate(txs []*tx.Tx) error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	tmp, old := j.path+".tmp", j.path+".old"
+	if err := os.RemoveAll(tmp); err != nil {
+		return fmt.Errorf("fail to remove temporary txpool journal: %v", err)
+	}
+	w, err := wal.Create(tmp, txJournalMeta)
+	if err != nil {
+		return fmt.Errorf("fail to create txpool journal: %v", err)
+	}
+	ents := make([]wal.Entry, 0, len(txs))
+	for _, t := range txs {
+		ents = append(ents, wal.Entry{Data: t.Encode()})
+	}
+	if _, err := w.Save(ents); err != nil {
+		w.Close()
+		return fmt.Errorf("fail to write txpool journal: %v", err)
+	}
+	// Close flushes and syncs the entries
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("fail to sync txpool journal: %v", err)
+	}
+
+	if j.wal != nil {
+		j.wal.Close()
+		j.wal = nil
+	}
+	if err := os.RemoveAll(old); err != nil {
+		return fmt.Errorf("fail to remove old txpool journal: %v", err)
+	}
+	if err := os.Rename(j.path, old); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("fail to move old txpool journal: %v", err)
+	}
+	if err := os.Rename(tmp, j.path); err != nil {
+		return fmt.Errorf("fail to move txpool journal: %v", err)
+	}
+	syncDir(filepath.Dir(j.path))
+	os.RemoveAll(old)
+
+	j.wal, err = openJournal(j.path)
+	return err
+}
+
+// recover completes the rotation interrupted after the old journal is moved away,
+// the temporary journal is complete in that case.
+func (j *txJournal) recover() {
+	tmp, old := j.path+".tmp", j.path+".old"
+	if _, err := os.Stat(old); err != nil {
+		return
+	}
+	if _, err := os.Stat(j.path); os.IsNotExist(err) {
+		if err := os.Rename(tmp, j.path); err != nil {
+			ilog.Warnf("fail to recover txpool journal: %v", err)
+			return
+		}
+	}
+	os.RemoveAll(old)
+}
+
+// openJournal opens the journal and reads it through, which is needed before appending.
+func openJournal(path string) (*wal.WAL, error) {
+	w, err := wal.Create(path, txJournalMeta)
+	if err != nil {
+		return nil, fmt.Errorf("fail to open txpool journal: %v", err)
+	}
+	if w.HasDecoder() {
+		if _, _, err := w.ReadAll(); err != nil {
+			w.Close()
+			return nil, fmt.Errorf("fail to read txpool journal: %v", err)
+		}
+	}
+	return w, nil
+}
+
+func syncDir(dir string) {
+	d, err := os.Open(dir)
+	if err != nil {
+		return
+	}
+	d.Sync()
+	d.Close()
+}
+
+func (j *txJournal) close() error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if j.wal == nil {
+		return nil
+	}
+	err := j.wal.Close()
+	j.wal = nil
+	return err
+}
@@ -0,0 +1,58 @@
+package txpool
+
+import (
+	"os"
+	"testing"
+
+	"github.com/iost-official/go-iost/account"
+	"github.com/iost-official/go-iost/core/tx"
+	"github.com/iost-official/go-iost/crypto"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestTxJournal(t *testing.T) {
+	Convey("test txJournal", t, func() {
+		path := "TxPoolJournalTest"
+		defer os.RemoveAll(path)
+		a, err := account.NewKeyPair(nil, crypto.Secp256k1)
+		So(err, ShouldBeNil)
+		txs := []*tx.Tx{genTx(a, tx.MaxExpiration), genTx(a, tx.MaxExpiration), genTx(a, tx.MaxExpiration)}
+
+		j := newTxJournal(path)
+		loaded, err := j.load()
+		So(err, ShouldBeNil)
+		So(len(loaded), ShouldEqual, 0)
+		So(j.insert(txs[0]), ShouldBeNil)
+
+		So(j.rotate(txs[:1]), ShouldBeNil)
+		So(j.insert(txs[1]), ShouldBeNil)
+		So(j.insert(txs[2]), ShouldBeNil)
+		So(j.close(), ShouldBeNil)
+
+		j = newTxJournal(path)
+		loaded, err = j.load()
+		So(err, ShouldBeNil)
+		So(len(loaded), ShouldEqual, 3)
+		for i, t := range loaded {
+			So(t.Hash(), ShouldResemble, txs[i].Hash())
+		}
+
+		So(j.rotate(txs[2:]), ShouldBeNil)
+		So(j.close(), ShouldBeNil)
+		loaded, err = newTxJournal(path).load()
+		So(err, ShouldBeNil)
+		So(len(loaded), ShouldEqual, 1)
+		So(loaded[0].Hash(), ShouldResemble, txs[2].Hash())
+
+		// a crash after the old journal is moved away leaves the complete temporary one
+		So(os.Rename(path, path+".tmp"), ShouldBeNil)
+		So(os.MkdirAll(path+".old", 0777), ShouldBeNil)
+		defer os.RemoveAll(path + ".old")
+		loaded, err = newTxJournal(path).load()
+		So(err, ShouldBeNil)
+		So(len(loaded), ShouldEqual, 1)
+		So(loaded[0].Hash(), ShouldResemble, txs[2].Hash())
+		_, err = os.Stat(path + ".old")
+		So(os.IsNotExist(err), ShouldBeTrue)
+	})
+}
@@ -10,6 +10,7 @@ import (
 	blockcache "github.com/iost-official/go-iost/core/blockcache"
 	tx "github.com/iost-official/go-iost/core/tx"
 	txpool "github.com/iost-official/go-iost/core/txpool"
+	crypto "github.com/iost-official/go-iost/crypto"
 	reflect "reflect"
 )
 
@@ -60,6 +61,18 @@ func (mr *MockTxPoolMockRecorder) AddTx(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTx", reflect.TypeOf((*MockTxPool)(nil).AddTx), arg0)
 }
 
+// CancelTx mocks base method
+func (m *MockTxPool) CancelTx(arg0 []byte, arg1 *crypto.Signature) error {
+	ret := m.ctrl.Call(m, "CancelTx", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CancelTx indicates an expected call of CancelTx
+func (mr *MockTxPoolMockRecorder) CancelTx(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelTx", reflect.TypeOf((*MockTxPool)(nil).CancelTx), arg0, arg1)
+}
+
 // DelTx mocks base method
 func (m *MockTxPool) DelTx(arg0 []byte) error {
 	ret := m.ctrl.Call(m, "DelTx", arg0)
@@ -144,6 +157,30 @@ func (mr *MockTxPoolMockRecorder) PendingTx() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PendingTx", reflect.TypeOf((*MockTxPool)(nil).PendingTx))
 }
 
+// PendingTxCount mocks base method
+func (m *MockTxPool) PendingTxCount() int {
+	ret := m.ctrl.Call(m, "PendingTxCount")
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// PendingTxCount indicates an expected call of PendingTxCount
+func (mr *MockTxPoolMockRecorder) PendingTxCount() *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PendingTxCount", reflect.TypeOf((*MockTxPool)(nil).PendingTxCount))
+}
+
+// PendingTxs mocks base method
+func (m *MockTxPool) PendingTxs(arg0 func(*tx.Tx) bool, arg1 int) []*txpool.PendingTxInfo {
+	ret := m.ctrl.Call(m, "PendingTxs", arg0, arg1)
+	ret0, _ := ret[0].([]*txpool.PendingTxInfo)
+	return ret0
+}
+
+// PendingTxs indicates an expected call of PendingTxs
+func (mr *MockTxPoolMockRecorder) PendingTxs(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PendingTxs", reflect.TypeOf((*MockTxPool)(nil).PendingTxs), arg0, arg1)
+}
+
 // Release mocks base method
 func (m *MockTxPool) Release() {
 	m.ctrl.Call(m, "Release")
@@ -1,6 +1,7 @@
 package txpool
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"runtime"
@@ -10,8 +11,10 @@ import (
 	"github.com/iost-official/go-iost/common"
 	"github.com/iost-official/go-iost/core/block"
 	"github.com/iost-official/go-iost/core/blockcache"
+	"github.com/iost-official/go-iost/core/event"
 	"github.com/iost-official/go-iost/core/global"
 	"github.com/iost-official/go-iost/core/tx"
+	"github.com/iost-official/go-iost/crypto"
 	"github.com/iost-official/go-iost/ilog"
 	"github.com/iost-official/go-iost/p2p"
 )
@@ -29,6 +32,13 @@ type TxPImpl struct {
 	deferServer      *DeferServer
 	quitGenerateMode chan struct{}
 	quitCh           chan struct{}
+	loopDone         chan struct{}
+	maxSize          int
+	maxPerPublisher  int
+	minGasRatio      int64
+	fullGasRatio     int64
+	floorThreshold   float64
+	journal          *txJournal
 }
 
 // NewTxPoolImpl returns a default TxPImpl instance.
@@ -40,9 +50,32 @@ func NewTxPoolImpl(global global.BaseVariable, blockCache blockcache.BlockCache,
 		forkChain:        new(forkChain),
 		blockList:        new(sync.Map),
 		pendingTx:        NewSortedTxMap(),
-		chP2PTx:          p2pService.Register("txpool message", p2p.PublishTx),
+		chP2PTx:          p2pService.Register("txpool message", p2p.PublishTx, p2p.CancelTx),
 		quitGenerateMode: make(chan struct{}),
 		quitCh:           make(chan struct{}),
+		maxSize:          maxCacheTxs,
+		minGasRatio:      defaultMinGasRatio,
+		fullGasRatio:     defaultFullGasRatio,
+		floorThreshold:   defaultFloorThreshold,
+	}
+	if conf := global.Config().TxPool; conf != nil {
+		if conf.MaxSize > 0 {
+			p.maxSize = conf.MaxSize
+		}
+		p.maxPerPublisher = conf.MaxPerPublisher
+		if conf.MinGasRatio > 0 {
+			p.minGasRatio = int64(conf.MinGasRatio * 100)
+		}
+		p.fullGasRatio = p.minGasRatio
+		if int64(conf.FullGasRatio*100) > p.minGasRatio {
+			p.fullGasRatio = int64(conf.FullGasRatio * 100)
+		}
+		if conf.FloorThreshold > 0 && conf.FloorThreshold < 1 {
+			p.floorThreshold = conf.FloorThreshold
+		}
+		if conf.Journal && global.Config().DB != nil {
+			p.journal = newTxJournal(global.Config().DB.LdbPath + txJournalDir)
+		}
 	}
 	p.forkChain.SetNewHead(blockCache.Head())
 	deferServer, err := NewDeferServer(p)
@@ -56,20 +89,26 @@ func NewTxPoolImpl(global global.BaseVariable, blockCache blockcache.BlockCache,
 
 // Start starts the jobs.
 func (pool *TxPImpl) Start() error {
+	pool.loopDone = make(chan struct{})
 	go pool.deferServer.Start()
 	go pool.loop()
 	return nil
 }
 
-// Stop stops all the jobs.
+// Stop stops all the jobs, and waits for the journal to be written.
 func (pool *TxPImpl) Stop() {
 	pool.deferServer.Stop()
 	close(pool.quitCh)
+	if pool.loopDone != nil {
+		<-pool.loopDone
+	}
 }
 
 // AddDefertx adds defer transaction.
 func (pool *TxPImpl) AddDefertx(txHash []byte) error {
-	if pool.pendingTx.Size() > maxCacheTxs {
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+	if pool.pendingTx.Size() >= pool.maxSize {
 		return ErrCacheFull
 	}
 	referredTx, err := pool.global.BlockChain().GetTx(txHash)
@@ -86,17 +125,43 @@ func (pool *TxPImpl) AddDefertx(txHash []byte) error {
 		return err
 	}
 	pool.pendingTx.Add(deferTx)
+	postTxEvent(event.TxAccepted, deferTx.Hash(), "")
 	return nil
 }
 
+func postTxEvent(topic event.Topic, hash []byte, reason string) {
+	event.GetCollector().PostTx(topic, &event.TxEventData{
+		Hash:   common.Base58Encode(hash),
+		Reason: reason,
+	})
+}
+
+func postPackedTxs(blk *block.Block) {
+	ec := event.GetCollector()
+	if !ec.HasSubscriber(event.TxPacked) {
+		return
+	}
+	blkHash := common.Base58Encode(blk.HeadHash())
+	for _, t := range blk.Txs {
+		ec.PostTx(event.TxPacked, &event.TxEventData{
+			Hash:        common.Base58Encode(t.Hash()),
+			BlockNumber: blk.Head.Number,
+			BlockHash:   blkHash,
+		})
+	}
+}
+
 func (pool *TxPImpl) loop() {
-	for {
-		if pool.global.Mode() != global.ModeInit {
-			break
+	defer close(pool.loopDone)
+	for pool.global.Mode() == global.ModeInit {
+		select {
+		case <-pool.quitCh:
+			return
+		case <-time.After(time.Second):
 		}
-		time.Sleep(time.Second)
 	}
 	pool.initBlockTx()
+	pool.loadJournal()
 	workerCnt := (runtime.NumCPU() + 1) / 2
 	if workerCnt == 0 {
 		workerCnt = 1
@@ -106,6 +171,8 @@ func (pool *TxPImpl) loop() {
 	}
 	clearTx := time.NewTicker(clearInterval)
 	defer clearTx.Stop()
+	rotateJournal := time.NewTicker(txJournalInterval)
+	defer rotateJournal.Stop()
 	for {
 		select {
 		case <-clearTx.C:
@@ -114,12 +181,79 @@ func (pool *TxPImpl) loop() {
 			pool.clearTimeoutTx()
 			pool.mu.Unlock()
 			metricsTxPoolSize.Set(float64(pool.pendingTx.Size()), nil)
+		case <-rotateJournal.C:
+			pool.rotateJournal()
 		case <-pool.quitCh:
+			if pool.journal != nil {
+				pool.rotateJournal()
+				pool.journal.close()
+			}
 			return
 		}
 	}
 }
 
+// loadJournal reloads the txs in the journal, which are verified again as new txs.
+func (pool *TxPImpl) loadJournal() {
+	if pool.journal == nil {
+		return
+	}
+	txs, err := pool.journal.load()
+	if err != nil {
+		ilog.Errorf("load txpool journal failed, err:%v", err)
+	}
+	pool.mu.Lock()
+	count := 0
+	for _, t := range txs {
+		if err := pool.reloadTx(t); err != nil {
+			ilog.Debugf("drop journaled tx %v, err:%v", common.Base58Encode(t.Hash()), err)
+			continue
+		}
+		count++
+	}
+	pool.mu.Unlock()
+	ilog.Infof("reloaded %v of %v txs from txpool journal", count, len(txs))
+	pool.rotateJournal()
+}
+
+func (pool *TxPImpl) reloadTx(t *tx.Tx) error {
+	if err := pool.verifyDuplicate(t); err != nil {
+		return err
+	}
+	if err := pool.verifyTx(t); err != nil {
+		return err
+	}
+	replaced, err := pool.verifyReplace(t)
+	if err != nil {
+		return err
+	}
+	evicted, err := pool.verifyQuota(t, replaced)
+	if err != nil {
+		return err
+	}
+	pool.addPending(t, replaced, evicted)
+	return nil
+}
+
+// rotateJournal regenerates the journal with the current pending txs.
+func (pool *TxPImpl) rotateJournal() {
+	if pool.journal == nil {
+		return
+	}
+	txs := make([]*tx.Tx, 0, pool.pendingTx.Size())
+	iter := pool.pendingTx.Iter()
+	t, ok := iter.Next()
+	for ok {
+		if !t.IsDefer() {
+			txs = append(txs, t)
+		}
+		t, ok = iter.Next()
+	}
+	if err := pool.journal.rotate(txs); err != nil {
+		ilog.Errorf("rotate txpool journal failed, err:%v", err)
+	}
+}
+
 // Lock lock the txpool
 func (pool *TxPImpl) Lock() {
 	pool.mu.Lock()
@@ -131,6 +265,25 @@ func (pool *TxPImpl) PendingTx() (*SortedTxMap, *blockcache.BlockCacheNode) {
 	return pool.pendingTx, pool.forkChain.NewHead
 }
 
+// PendingTxs returns the pending txs accepted by the filter in packing order, at most limit txs are returned.
+func (pool *TxPImpl) PendingTxs(filter func(t *tx.Tx) bool, limit int) []*PendingTxInfo {
+	ret := make([]*PendingTxInfo, 0)
+	iter := pool.pendingTx.Iter()
+	t, ok := iter.Next()
+	for rank := 0; ok && len(ret) < limit; rank++ {
+		if filter == nil || filter(t) {
+			ret = append(ret, &PendingTxInfo{Tx: t, Rank: rank})
+		}
+		t, ok = iter.Next()
+	}
+	return ret
+}
+
+// PendingTxCount returns the number of pending txs.
+func (pool *TxPImpl) PendingTxCount() int {
+	return pool.pendingTx.Size()
+}
+
 // Release release the txpool
 func (pool *TxPImpl) Release() {
 	close(pool.quitGenerateMode)
@@ -142,6 +295,15 @@ func (pool *TxPImpl) verifyWorkers() {
 		select {
 		case <-pool.quitGenerateMode:
 		}
+		if v.Type() == p2p.CancelTx {
+			hash, sign, err := decodeCancel(v.Data())
+			if err != nil {
+				ilog.Errorf("decode tx cancellation error. err=%v", err)
+				continue
+			}
+			pool.CancelTx(hash, sign)
+			continue
+		}
 		var t tx.Tx
 		err := t.Decode(v.Data())
 		if err != nil {
@@ -159,7 +321,17 @@ func (pool *TxPImpl) verifyWorkers() {
 			pool.mu.Unlock()
 			continue
 		}
-		pool.pendingTx.Add(&t)
+		replaced, ret := pool.verifyReplace(&t)
+		if ret != nil {
+			pool.mu.Unlock()
+			continue
+		}
+		evicted, ret := pool.verifyQuota(&t, replaced)
+		if ret != nil {
+			pool.mu.Unlock()
+			continue
+		}
+		pool.addPending(&t, replaced, evicted)
 		pool.mu.Unlock()
 		metricsReceivedTxCount.Add(1, map[string]string{"from": "p2p"})
 		pool.p2pService.Broadcast(v.Data(), p2p.PublishTx, p2p.NormalMessage)
@@ -190,6 +362,7 @@ func (pool *TxPImpl) AddLinkedNode(linkedNode *blockcache.BlockCacheNode) error
 	if err != nil {
 		return fmt.Errorf("failed to add findBlock: %v", err)
 	}
+	postPackedTxs(linkedNode.Block)
 	var newHead *blockcache.BlockCacheNode
 	h := pool.blockCache.Head()
 	if linkedNode.Head.Number > h.Head.Number {
@@ -212,26 +385,98 @@ func (pool *TxPImpl) AddLinkedNode(linkedNode *blockcache.BlockCacheNode) error
 
 // AddTx add the transaction
 func (pool *TxPImpl) AddTx(t *tx.Tx) error {
-	err := pool.verifyDuplicate(t)
+	err := pool.verifyTx(t)
 	if err != nil {
 		return err
 	}
-	err = pool.verifyTx(t)
+	// the checks and the insertion must be done under the lock, or concurrent txs may all pass the quota
+	pool.mu.Lock()
+	err = pool.verifyDuplicate(t)
 	if err != nil {
+		pool.mu.Unlock()
 		return err
 	}
-	pool.pendingTx.Add(t)
+	replaced, err := pool.verifyReplace(t)
+	if err != nil {
+		pool.mu.Unlock()
+		return err
+	}
+	evicted, err := pool.verifyQuota(t, replaced)
+	if err != nil {
+		pool.mu.Unlock()
+		return err
+	}
+	pool.addPending(t, replaced, evicted)
 	ilog.Debugf(
 		"Added %v to pendingTx, now size is %v.",
 		common.Base58Encode(t.Hash()),
 		pool.pendingTx.Size(),
 	)
+	pool.mu.Unlock()
 
 	pool.p2pService.Broadcast(t.Encode(), p2p.PublishTx, p2p.NormalMessage)
 	metricsReceivedTxCount.Add(1, map[string]string{"from": "rpc"})
 	return nil
 }
 
+// CancelTx removes the pending tx, the sign must be made on CancelMessage by one of the publisher keys of the tx.
+func (pool *TxPImpl) CancelTx(hash []byte, sign *crypto.Signature) error {
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+	t := pool.pendingTx.Get(hash)
+	if t == nil {
+		return ErrTxNotFound
+	}
+	if t.IsDefer() {
+		return errors.New("reject canceling defertx")
+	}
+	signed := false
+	for _, s := range t.PublishSigns {
+		if bytes.Equal(s.Pubkey, sign.Pubkey) {
+			signed = true
+			break
+		}
+	}
+	if !signed || !sign.Verify(CancelMessage(hash)) {
+		return ErrCancelSign
+	}
+	pool.pendingTx.Del(hash)
+	postTxEvent(event.TxDropped, hash, "canceled")
+	metricsCanceledTxCount.Add(1, nil)
+	pool.p2pService.Broadcast(encodeCancel(hash, sign), p2p.CancelTx, p2p.NormalMessage)
+	return nil
+}
+
+// CancelMessage returns the message to be signed by the publisher to cancel the pending tx.
+func CancelMessage(hash []byte) []byte {
+	return common.Sha3(append([]byte("CancelTx"), hash...))
+}
+
+func encodeCancel(hash []byte, sign *crypto.Signature) []byte {
+	sb, _ := sign.Encode()
+	se := common.NewSimpleEncoder()
+	se.WriteBytes(hash)
+	se.WriteBytes(sb)
+	return se.Bytes()
+}
+
+func decodeCancel(b []byte) ([]byte, *crypto.Signature, error) {
+	sd := common.NewSimpleDecoder(b)
+	hash, err := sd.ParseBytes()
+	if err != nil {
+		return nil, nil, err
+	}
+	sb, err := sd.ParseBytes()
+	if err != nil {
+		return nil, nil, err
+	}
+	sign := &crypto.Signature{}
+	if err := sign.Decode(sb); err != nil {
+		return nil, nil, err
+	}
+	return hash, sign, nil
+}
+
 // DelTx del the transaction
 func (pool *TxPImpl) DelTx(hash []byte) error {
 	pool.pendingTx.Del(hash)
@@ -260,7 +505,7 @@ func (pool *TxPImpl) ExistTxs(hash []byte, chainBlock *block.Block) FRet {
 }
 
 func (pool *TxPImpl) initBlockTx() {
-	filterLimit := time.Now().UnixNano() - filterTime
+	filterLimit := common.NowNano() - filterTime
 	for i := pool.global.BlockChain().Length() - 1; i > 0; i-- {
 		blk, err := pool.global.BlockChain().GetBlockByNumber(i)
 		if err != nil {
@@ -274,14 +519,11 @@ func (pool *TxPImpl) initBlockTx() {
 }
 
 func (pool *TxPImpl) verifyTx(t *tx.Tx) error {
-	if pool.pendingTx.Size() > maxCacheTxs {
-		return ErrCacheFull
-	}
 	if t.IsDefer() {
 		return errors.New("reject defertx")
 	}
 	// Add one second delay for tx created time check
-	if !t.IsCreatedBefore(time.Now().UnixNano()+maxTxTimeGap) || t.IsExpired(time.Now().UnixNano()) {
+	if !t.IsCreatedBefore(common.NowNano()+maxTxTimeGap) || t.IsExpired(common.NowNano()) {
 		return fmt.Errorf("TimeError")
 	}
 	if err := t.VerifySelf(); err != nil {
@@ -371,6 +613,76 @@ func (pool *TxPImpl) verifyDuplicate(t *tx.Tx) error {
 	return nil
 }
 
+// verifyReplace returns the pending tx which has the same publisher and time as t and will be replaced by t.
+// The gas ratio of t must be higher than the pending one.
+func (pool *TxPImpl) verifyReplace(t *tx.Tx) (*tx.Tx, error) {
+	old := pool.pendingTx.GetByIdentity(t)
+	if old == nil {
+		return nil, nil
+	}
+	if t.GasRatio <= old.GasRatio {
+		return nil, ErrUnderpriced
+	}
+	return old, nil
+}
+
+// gasRatioFloor returns the minimum gas ratio accepted by the pool, which rises linearly
+// from minGasRatio to fullGasRatio once the pool is filled over floorThreshold.
+func (pool *TxPImpl) gasRatioFloor() int64 {
+	fill := float64(pool.pendingTx.Size()) / float64(pool.maxSize)
+	if fill <= pool.floorThreshold {
+		return pool.minGasRatio
+	}
+	if fill >= 1 {
+		return pool.fullGasRatio
+	}
+	rate := (fill - pool.floorThreshold) / (1 - pool.floorThreshold)
+	return pool.minGasRatio + int64(float64(pool.fullGasRatio-pool.minGasRatio)*rate)
+}
+
+// verifyQuota checks the gas ratio floor and the quota of the publisher, and returns the pending tx
+// with the lowest priority which will be evicted by t if the pool is full.
+// A replacement takes the place of the replaced tx, so it is not limited.
+func (pool *TxPImpl) verifyQuota(t *tx.Tx, replaced *tx.Tx) (*tx.Tx, error) {
+	if replaced != nil {
+		return nil, nil
+	}
+	if t.GasRatio < pool.gasRatioFloor() {
+		return nil, ErrLowGasRatio
+	}
+	if pool.maxPerPublisher > 0 && pool.pendingTx.PublisherSize(t.Publisher) >= pool.maxPerPublisher {
+		return nil, ErrQuotaFull
+	}
+	if pool.pendingTx.Size() < pool.maxSize {
+		return nil, nil
+	}
+	lowest := pool.pendingTx.Lowest()
+	if lowest == nil || lowest.IsDefer() || compareTx(t, lowest) <= 0 {
+		return nil, ErrCacheFull
+	}
+	return lowest, nil
+}
+
+func (pool *TxPImpl) addPending(t *tx.Tx, replaced *tx.Tx, evicted *tx.Tx) {
+	if replaced != nil {
+		pool.pendingTx.Del(replaced.Hash())
+		postTxEvent(event.TxDropped, replaced.Hash(), "replaced")
+		metricsReplacedTxCount.Add(1, nil)
+	}
+	if evicted != nil {
+		pool.pendingTx.Del(evicted.Hash())
+		postTxEvent(event.TxDropped, evicted.Hash(), "evicted")
+		metricsEvictedTxCount.Add(1, nil)
+	}
+	pool.pendingTx.Add(t)
+	postTxEvent(event.TxAccepted, t.Hash(), "")
+	if pool.journal != nil {
+		if err := pool.journal.insert(t); err != nil {
+			ilog.Warnf("fail to journal tx %v, err:%v", common.Base58Encode(t.Hash()), err)
+		}
+	}
+}
+
 func (pool *TxPImpl) existTxInPending(hash []byte) bool {
 	return pool.pendingTx.Get(hash) != nil
 }
@@ -379,8 +691,9 @@ func (pool *TxPImpl) clearTimeoutTx() {
 	iter := pool.pendingTx.Iter()
 	t, ok := iter.Next()
 	for ok {
-		if t.IsExpired(time.Now().UnixNano()) && !t.IsDefer() {
+		if t.IsExpired(common.NowNano()) && !t.IsDefer() {
 			pool.pendingTx.Del(t.Hash())
+			postTxEvent(event.TxDropped, t.Hash(), "expired")
 		}
 		t, ok = iter.Next()
 	}
@@ -424,7 +737,7 @@ func (pool *TxPImpl) doChainChangeByForkBCN() {
 	oldHead := pool.forkChain.GetOldHead()
 	forkBCN := pool.forkChain.GetForkBCN()
 	//add txs
-	filterLimit := time.Now().UnixNano() - filterTime
+	filterLimit := common.NowNano() - filterTime
 	for {
 		if oldHead == nil || oldHead == forkBCN || oldHead.Block.Head.Time < filterLimit {
 			break
@@ -450,7 +763,7 @@ func (pool *TxPImpl) doChainChangeByForkBCN() {
 func (pool *TxPImpl) doChainChangeByTimeout() {
 	newHead := pool.forkChain.GetNewHead()
 	oldHead := pool.forkChain.GetOldHead()
-	filterLimit := time.Now().UnixNano() - filterTime
+	filterLimit := common.NowNano() - filterTime
 	ob, ok := pool.findBlock(oldHead.Block.HeadHash())
 	if ok {
 		for {
@@ -1,7 +1,9 @@
 package txpool
 
 import (
+	"bytes"
 	"encoding/json"
+	"sync"
 	"testing"
 	"time"
 
@@ -191,6 +193,118 @@ func TestNewTxPImpl(t *testing.T) {
 			r1 := txPool.ExistTxs(t.Hash(), nil)
 			So(r1, ShouldEqual, FoundPending)
 		})
+		Convey("PendingTxs", func() {
+
+			t1 := genTx(accountList[0], tx.MaxExpiration)
+			t2 := genTx(accountList[1], tx.MaxExpiration)
+			So(txPool.AddTx(t1), ShouldBeNil)
+			So(txPool.AddTx(t2), ShouldBeNil)
+			So(txPool.PendingTxCount(), ShouldEqual, 2)
+			So(len(txPool.PendingTxs(nil, 10)), ShouldEqual, 2)
+			So(len(txPool.PendingTxs(nil, 1)), ShouldEqual, 1)
+			ret := txPool.PendingTxs(func(t *tx.Tx) bool {
+				return t.Publisher == t2.Publisher
+			}, 10)
+			So(len(ret), ShouldEqual, 1)
+			So(ret[0].Rank, ShouldEqual, 1)
+			So(bytes.Equal(ret[0].Tx.Hash(), t2.Hash()), ShouldBeTrue)
+		})
+		Convey("ReplaceTx", func() {
+
+			t := genTx(accountList[0], tx.MaxExpiration)
+			So(txPool.AddTx(t), ShouldBeNil)
+			replace := func(gasRatio int64) *tx.Tx {
+				r := tx.NewTx(t.Actions, t.Signers, t.GasLimit, gasRatio, t.Expiration, 0, 0)
+				r.Time = t.Time
+				sig, err := tx.SignTxContent(r, accountList[0].ReadablePubkey(), accountList[0])
+				So(err, ShouldBeNil)
+				r.Signs = []*crypto.Signature{sig}
+				r, err = tx.SignTx(r, accountList[0].ReadablePubkey(), []*account.KeyPair{accountList[0]})
+				So(err, ShouldBeNil)
+				return r
+			}
+			r := replace(t.GasRatio + 100)
+			So(txPool.AddTx(r), ShouldBeNil)
+			So(txPool.testPendingTxsNum(), ShouldEqual, 1)
+			So(txPool.ExistTxs(t.Hash(), nil), ShouldEqual, NotFound)
+			So(txPool.ExistTxs(r.Hash(), nil), ShouldEqual, FoundPending)
+			So(txPool.AddTx(replace(t.GasRatio+50)), ShouldEqual, ErrUnderpriced)
+			So(txPool.testPendingTxsNum(), ShouldEqual, 1)
+		})
+		Convey("Quota", func() {
+
+			txPool.maxSize = 4
+			txPool.maxPerPublisher = 2
+			txPool.minGasRatio = 100
+			txPool.fullGasRatio = 300
+			txPool.floorThreshold = 0.5
+			gen := func(a *account.KeyPair, gasRatio int64) *tx.Tx {
+				t := genTx(a, tx.MaxExpiration)
+				r := tx.NewTx(t.Actions, t.Signers, t.GasLimit, gasRatio, t.Expiration, 0, 0)
+				sig, err := tx.SignTxContent(r, a.ReadablePubkey(), a)
+				So(err, ShouldBeNil)
+				r.Signs = []*crypto.Signature{sig}
+				r, err = tx.SignTx(r, a.ReadablePubkey(), []*account.KeyPair{a})
+				So(err, ShouldBeNil)
+				return r
+			}
+			So(txPool.AddTx(gen(accountList[0], 100)), ShouldBeNil)
+			lowest := gen(accountList[0], 100)
+			So(txPool.AddTx(lowest), ShouldBeNil)
+			So(txPool.AddTx(gen(accountList[0], 200)), ShouldEqual, ErrQuotaFull)
+			So(txPool.gasRatioFloor(), ShouldEqual, 100)
+			So(txPool.AddTx(gen(accountList[1], 200)), ShouldBeNil)
+			So(txPool.gasRatioFloor(), ShouldEqual, 200)
+			So(txPool.AddTx(gen(accountList[2], 150)), ShouldEqual, ErrLowGasRatio)
+			So(txPool.AddTx(gen(accountList[2], 250)), ShouldBeNil)
+			So(txPool.gasRatioFloor(), ShouldEqual, 300)
+			So(txPool.AddTx(gen(accountList[2], 300)), ShouldBeNil)
+			So(txPool.testPendingTxsNum(), ShouldEqual, 4)
+			So(txPool.ExistTxs(lowest.Hash(), nil), ShouldEqual, NotFound)
+			So(txPool.pendingTx.PublisherSize(accountList[0].ReadablePubkey()), ShouldEqual, 1)
+		})
+		Convey("ConcurrentAddTx", func() {
+
+			txPool.maxPerPublisher = 2
+			t := genTx(accountList[0], tx.MaxExpiration)
+			txs := make([]*tx.Tx, 0)
+			for i := int64(1); i <= 20; i++ {
+				r := tx.NewTx(t.Actions, t.Signers, t.GasLimit, t.GasRatio+i*10, t.Expiration, 0, 0)
+				r.Time = t.Time
+				sig, err := tx.SignTxContent(r, accountList[0].ReadablePubkey(), accountList[0])
+				So(err, ShouldBeNil)
+				r.Signs = []*crypto.Signature{sig}
+				r, err = tx.SignTx(r, accountList[0].ReadablePubkey(), []*account.KeyPair{accountList[0]})
+				So(err, ShouldBeNil)
+				txs = append(txs, r)
+			}
+			for i := 0; i < 10; i++ {
+				txs = append(txs, genTx(accountList[1], tx.MaxExpiration))
+			}
+			var wg sync.WaitGroup
+			for _, t := range txs {
+				wg.Add(1)
+				go func(t *tx.Tx) {
+					defer wg.Done()
+					txPool.AddTx(t)
+				}(t)
+			}
+			wg.Wait()
+			So(txPool.testPendingTxsNum(), ShouldEqual, 3)
+			So(txPool.ExistTxs(txs[19].Hash(), nil), ShouldEqual, FoundPending)
+			So(txPool.pendingTx.PublisherSize(accountList[0].ReadablePubkey()), ShouldEqual, 1)
+			So(txPool.pendingTx.PublisherSize(accountList[1].ReadablePubkey()), ShouldEqual, 2)
+		})
+		Convey("CancelTx", func() {
+
+			t := genTx(accountList[0], tx.MaxExpiration)
+			So(txPool.AddTx(t), ShouldBeNil)
+			So(txPool.CancelTx(t.Hash(), accountList[1].Sign(CancelMessage(t.Hash()))), ShouldEqual, ErrCancelSign)
+			So(txPool.CancelTx(t.Hash(), accountList[0].Sign(t.Hash())), ShouldEqual, ErrCancelSign)
+			So(txPool.CancelTx(t.Hash(), accountList[0].Sign(CancelMessage(t.Hash()))), ShouldBeNil)
+			So(txPool.testPendingTxsNum(), ShouldEqual, 0)
+			So(txPool.CancelTx(t.Hash(), accountList[0].Sign(CancelMessage(t.Hash()))), ShouldEqual, ErrTxNotFound)
+		})
 		Convey("ExistTxs FoundChain", func() {
 
 			txCnt := 10
@@ -3,6 +3,7 @@ package txpool
 import (
 	"bytes"
 	"errors"
+	"strconv"
 	"sync"
 	"time"
 
@@ -20,13 +21,24 @@ var (
 	maxCacheTxs   = 10000
 	maxTxTimeGap  = 5 * time.Second.Nanoseconds()
 
+	defaultMinGasRatio    int64 = 100
+	defaultFullGasRatio   int64 = 100
+	defaultFloorThreshold       = 1.0
+
 	metricsReceivedTxCount = metrics.NewCounter("iost_tx_received_count", []string{"from"})
 	metricsTxPoolSize      = metrics.NewGauge("iost_txpool_size", nil)
+	metricsReplacedTxCount = metrics.NewCounter("iost_tx_replaced_count", nil)
+	metricsCanceledTxCount = metrics.NewCounter("iost_tx_canceled_count", nil)
+	metricsEvictedTxCount  = metrics.NewCounter("iost_tx_evicted_count", nil)
 
 	ErrDupPendingTx = errors.New("tx exists in pending")
 	ErrDupChainTx   = errors.New("tx exists in chain")
 	ErrCacheFull    = errors.New("txpool is full")
 	ErrTxNotFound   = errors.New("tx not found")
+	ErrUnderpriced  = errors.New("gas ratio of replacement tx is not higher than the pending one")
+	ErrCancelSign   = errors.New("cancellation is not signed by the publisher")
+	ErrQuotaFull    = errors.New("pending txs of the publisher exceed the quota")
+	ErrLowGasRatio  = errors.New("gas ratio is lower than the floor of txpool")
 )
 
 // FRet find the return value of the tx
@@ -136,11 +148,25 @@ func (b *blockTx) getTxAndReceipt(hash []byte) (*tx.Tx, *tx.TxReceipt) {
 	return retTx, nil
 }
 
+// PendingTxInfo is a pending tx with its rank in the pool.
+type PendingTxInfo struct {
+	Tx *tx.Tx
+	// Rank is the position of the tx in packing order, starting from 0
+	Rank int
+}
+
 // SortedTxMap is a red black tree of tx.
 type SortedTxMap struct {
-	tree  *redblacktree.Tree
-	txMap map[string]*tx.Tx
-	rw    *sync.RWMutex
+	tree     *redblacktree.Tree
+	txMap    map[string]*tx.Tx
+	idMap    map[string]*tx.Tx
+	pubCount map[string]int
+	rw       *sync.RWMutex
+}
+
+// txIdentity returns the identity of the tx, a tx replaces the pending one with the same identity.
+func txIdentity(t *tx.Tx) string {
+	return t.Publisher + "/" + strconv.FormatInt(t.Time, 10)
 }
 
 func compareTx(a, b interface{}) int {
@@ -158,9 +184,11 @@ func compareTx(a, b interface{}) int {
 // NewSortedTxMap returns a new SortedTxMap instance.
 func NewSortedTxMap() *SortedTxMap {
 	return &SortedTxMap{
-		tree:  redblacktree.NewWith(compareTx),
-		txMap: make(map[string]*tx.Tx),
-		rw:    new(sync.RWMutex),
+		tree:     redblacktree.NewWith(compareTx),
+		txMap:    make(map[string]*tx.Tx),
+		idMap:    make(map[string]*tx.Tx),
+		pubCount: make(map[string]int),
+		rw:       new(sync.RWMutex),
 	}
 }
 
@@ -171,11 +199,24 @@ func (st *SortedTxMap) Get(hash []byte) *tx.Tx {
 	return st.txMap[string(hash)]
 }
 
+// GetByIdentity returns the tx with the same publisher and time as t.
+func (st *SortedTxMap) GetByIdentity(t *tx.Tx) *tx.Tx {
+	st.rw.RLock()
+	defer st.rw.RUnlock()
+	return st.idMap[txIdentity(t)]
+}
+
 // Add adds a tx in SortedTxMap.
 func (st *SortedTxMap) Add(tx *tx.Tx) {
 	st.rw.Lock()
+	if _, ok := st.txMap[string(tx.Hash())]; !ok {
+		st.pubCount[tx.Publisher]++
+	}
 	st.tree.Put(tx, true)
 	st.txMap[string(tx.Hash())] = tx
+	if !tx.IsDefer() {
+		st.idMap[txIdentity(tx)] = tx
+	}
 	st.rw.Unlock()
 }
 
@@ -190,6 +231,13 @@ func (st *SortedTxMap) Del(hash []byte) {
 	}
 	st.tree.Remove(tx)
 	delete(st.txMap, string(hash))
+	st.pubCount[tx.Publisher]--
+	if st.pubCount[tx.Publisher] <= 0 {
+		delete(st.pubCount, tx.Publisher)
+	}
+	if st.idMap[txIdentity(tx)] == tx {
+		delete(st.idMap, txIdentity(tx))
+	}
 }
 
 // Size returns the size of SortedTxMap.
@@ -200,6 +248,26 @@ func (st *SortedTxMap) Size() int {
 	return len(st.txMap)
 }
 
+// PublisherSize returns the number of txs of the publisher.
+func (st *SortedTxMap) PublisherSize(publisher string) int {
+	st.rw.RLock()
+	defer st.rw.RUnlock()
+
+	return st.pubCount[publisher]
+}
+
+// Lowest returns the tx with the lowest priority.
+func (st *SortedTxMap) Lowest() *tx.Tx {
+	st.rw.RLock()
+	defer st.rw.RUnlock()
+
+	node := st.tree.Left()
+	if node == nil {
+		return nil
+	}
+	return node.Key.(*tx.Tx)
+}
+
 // Iter returns the iterator of SortedTxMap.
 func (st *SortedTxMap) Iter() *Iterator {
 	iter := st.tree.Iterator()
@@ -22,6 +22,7 @@ const (
 	_ Algorithm = iota
 	Secp256k1
 	Ed25519
+	Secp256r1
 )
 
 func (a Algorithm) getBackend() AlgorithmBackend {
@@ -30,6 +31,8 @@ func (a Algorithm) getBackend() AlgorithmBackend {
 		return &backend.Secp256k1{}
 	case Ed25519:
 		return &backend.Ed25519{}
+	case Secp256r1:
+		return &backend.Secp256r1{}
 	default:
 		return &backend.Secp256k1{}
 	}
@@ -42,6 +45,8 @@ func NewAlgorithm(name string) Algorithm {
 		return Secp256k1
 	case "ed25519":
 		return Ed25519
+	case "secp256r1":
+		return Secp256r1
 	default:
 		return Ed25519
 	}
@@ -54,6 +59,8 @@ func (a Algorithm) String() string {
 		return "secp256k1"
 	case Ed25519:
 		return "ed25519"
+	case Secp256r1:
+		return "secp256r1"
 	default:
 		return "secp256k1"
 	}
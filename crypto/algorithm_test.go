@@ -12,11 +12,14 @@ import (
 var algos = []Algorithm{
 	Secp256k1,
 	Ed25519,
+	Secp256r1,
 }
 
 func TestCheckSeckey(t *testing.T) {
 	assert.Nil(t, Ed25519.CheckSeckey(common.Base58Decode("2yquS3ySrGWPEKywCPzX4RTJugqRh7kJSo5aehsLYPEWkUxBWA39oMrZ7ZxuM4fgyXYs2cPwh5n8aNNpH5x2VyK1")))
 	assert.NotNil(t, Ed25519.CheckSeckey(common.Base58Decode("65Rznad6Ko7gPha1Vnbsgu1bS7hYATdtdVp191jwVrMhW3SynSR6R7qzBgM6cFL74spAQnCWXuqze2YME8UfUFiL")))
+	assert.Nil(t, Secp256r1.CheckSeckey(Secp256r1.GenSeckey()))
+	assert.NotNil(t, Secp256r1.CheckSeckey(make([]byte, 32)))
 }
 
 func TestVerify(t *testing.T) {
@@ -33,6 +36,19 @@ func TestVerify(t *testing.T) {
 	}
 }
 
+func TestVerifyExtendedMessage(t *testing.T) {
+	for _, algo := range []Algorithm{Secp256k1, Secp256r1} {
+		seckey := algo.GenSeckey()
+		pubkey := algo.GetPubkey(seckey)
+		msg := make([]byte, 32)
+		rand.Read(msg)
+		sig := algo.Sign(msg, seckey)
+		assert.True(t, algo.Verify(msg, pubkey, sig))
+		assert.False(t, algo.Verify(append(msg, 0x01), pubkey, sig))
+		assert.Nil(t, algo.Sign(append(msg, 0x01), seckey))
+	}
+}
+
 func BenchmarkSign(b *testing.B) {
 	for _, algo := range algos {
 		b.Run(reflect.TypeOf(algo.getBackend()).String(), func(b *testing.B) {
@@ -0,0 +1,133 @@
+package backend
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/iost-official/go-iost/ilog"
+)
+
+// Secp256r1 is the secp256r1 (NIST P-256) crypto algorithm, which is supported by most hardware keys
+type Secp256r1 struct{}
+
+var p256HalfN = new(big.Int).Rsh(elliptic.P256().Params().N, 1)
+
+// Sign will signature the message with seckey by secp256r1, the signature is r || s with low s.
+// The message should be a 32 bytes digest as ecdsa truncates longer messages.
+func (b *Secp256r1) Sign(message []byte, seckey []byte) []byte {
+	if len(message) != 32 {
+		ilog.Errorf("Failed to sign, invalid message length %v", len(message))
+		return nil
+	}
+	priv := p256PrivateKey(seckey)
+	r, s, err := ecdsa.Sign(rand.Reader, priv, message)
+	if err != nil {
+		ilog.Errorf("Failed to sign, %v", err)
+		return nil
+	}
+	if s.Cmp(p256HalfN) > 0 {
+		s.Sub(priv.Curve.Params().N, s)
+	}
+	sig := make([]byte, 64)
+	rb, sb := r.Bytes(), s.Bytes()
+	copy(sig[32-len(rb):32], rb)
+	copy(sig[64-len(sb):], sb)
+	return sig
+}
+
+// Verify will verify the 32 bytes message with compressed pubkey and sig by secp256r1
+func (b *Secp256r1) Verify(message []byte, pubkey []byte, sig []byte) bool {
+	if len(message) != 32 || len(sig) != 64 {
+		return false
+	}
+	x, y, err := p256Decompress(pubkey)
+	if err != nil {
+		return false
+	}
+	r := new(big.Int).SetBytes(sig[:32])
+	s := new(big.Int).SetBytes(sig[32:])
+	if s.Cmp(p256HalfN) > 0 {
+		return false
+	}
+	return ecdsa.Verify(&ecdsa.PublicKey{Curve: elliptic.P256(), X: x, Y: y}, message, r, s)
+}
+
+// GetPubkey will get the compressed public key of the secret key by secp256r1
+func (b *Secp256r1) GetPubkey(seckey []byte) []byte {
+	priv := p256PrivateKey(seckey)
+	return p256Compress(priv.X, priv.Y)
+}
+
+// GenSeckey will generate the secret key by secp256r1
+func (b *Secp256r1) GenSeckey() []byte {
+	priv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		ilog.Errorf("Failed to generate seckey, %v", err)
+		return nil
+	}
+	seckey := make([]byte, 32)
+	d := priv.D.Bytes()
+	copy(seckey[32-len(d):], d)
+	return seckey
+}
+
+// CheckSeckey ...
+func (b *Secp256r1) CheckSeckey(seckey []byte) error {
+	if len(seckey) != 32 {
+		return fmt.Errorf("seckey length error secp256r1 seckey length should not be %v", len(seckey))
+	}
+	d := new(big.Int).SetBytes(seckey)
+	if d.Sign() == 0 || d.Cmp(elliptic.P256().Params().N) >= 0 {
+		return fmt.Errorf("invalid seckey")
+	}
+	return nil
+}
+
+func p256PrivateKey(seckey []byte) *ecdsa.PrivateKey {
+	curve := elliptic.P256()
+	priv := &ecdsa.PrivateKey{
+		PublicKey: ecdsa.PublicKey{Curve: curve},
+		D:         new(big.Int).SetBytes(seckey),
+	}
+	priv.X, priv.Y = curve.ScalarBaseMult(seckey)
+	return priv
+}
+
+func p256Compress(x, y *big.Int) []byte {
+	pubkey := make([]byte, 33)
+	pubkey[0] = byte(2 + y.Bit(0))
+	xb := x.Bytes()
+	copy(pubkey[33-len(xb):], xb)
+	return pubkey
+}
+
+func p256Decompress(pubkey []byte) (*big.Int, *big.Int, error) {
+	if len(pubkey) != 33 || (pubkey[0] != 2 && pubkey[0] != 3) {
+		return nil, nil, errors.New("invalid compressed pubkey")
+	}
+	params := elliptic.P256().Params()
+	x := new(big.Int).SetBytes(pubkey[1:])
+	if x.Cmp(params.P) >= 0 {
+		return nil, nil, errors.New("invalid compressed pubkey")
+	}
+	// y^2 = x^3 - 3x + b
+	y2 := new(big.Int).Mul(x, x)
+	y2.Mul(y2, x)
+	threeX := new(big.Int).Lsh(x, 1)
+	threeX.Add(threeX, x)
+	y2.Sub(y2, threeX)
+	y2.Add(y2, params.B)
+	y2.Mod(y2, params.P)
+	y := new(big.Int).ModSqrt(y2, params.P)
+	if y == nil {
+		return nil, nil, errors.New("invalid compressed pubkey")
+	}
+	if y.Bit(0) != uint(pubkey[0]&1) {
+		y.Sub(params.P, y)
+	}
+	return x, y, nil
+}
@@ -0,0 +1,184 @@
+// Package bls implements the BLS signatures on the BLS12-381 curve. The signatures of the same message aggregate
+// into one signature, which is verified against the aggregated public keys by a single pairing check.
+//
+// The public keys are in G1 and the signatures in G2, as the proof of possession scheme of the IETF BLS signature
+// draft. The public keys to aggregate must have their proofs of possession verified, against rogue key attacks.
+package bls
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"math/big"
+
+	bls12381 "github.com/kilic/bls12-381"
+)
+
+// The lengths of the secret keys, the compressed public keys and the compressed signatures.
+const (
+	SeckeyLength    = 32
+	PubkeyLength    = 48
+	SignatureLength = 96
+)
+
+var (
+	sigDomain = []byte("BLS_SIG_BLS12381G2_XMD:SHA-256_SSWU_RO_POP_")
+	popDomain = []byte("BLS_POP_BLS12381G2_XMD:SHA-256_SSWU_RO_POP_")
+
+	order = bls12381.NewG1().Q()
+)
+
+func seckeyOf(seckey []byte) (*big.Int, error) {
+	if len(seckey) != SeckeyLength {
+		return nil, fmt.Errorf("invalid seckey length %v", len(seckey))
+	}
+	k := new(big.Int).SetBytes(seckey)
+	if k.Sign() == 0 || k.Cmp(order) >= 0 {
+		return nil, errors.New("seckey out of range")
+	}
+	return k, nil
+}
+
+func pubkeyOf(pubkey []byte) (*bls12381.PointG1, error) {
+	g1 := bls12381.NewG1()
+	p, err := g1.FromCompressed(pubkey)
+	if err != nil {
+		return nil, err
+	}
+	if g1.IsZero(p) {
+		return nil, errors.New("pubkey at infinity")
+	}
+	return p, nil
+}
+
+// GenSeckey returns a random secret key.
+func GenSeckey() []byte {
+	for {
+		k, err := rand.Int(rand.Reader, order)
+		if err != nil {
+			panic(err)
+		}
+		if k.Sign() > 0 {
+			seckey := make([]byte, SeckeyLength)
+			b := k.Bytes()
+			copy(seckey[SeckeyLength-len(b):], b)
+			return seckey
+		}
+	}
+}
+
+// CheckSeckey checks that the secret key is a scalar of the curve order.
+func CheckSeckey(seckey []byte) error {
+	_, err := seckeyOf(seckey)
+	return err
+}
+
+// GetPubkey returns the compressed public key of the secret key.
+func GetPubkey(seckey []byte) ([]byte, error) {
+	k, err := seckeyOf(seckey)
+	if err != nil {
+		return nil, err
+	}
+	g1 := bls12381.NewG1()
+	return g1.ToCompressed(g1.MulScalarBig(g1.New(), g1.One(), k)), nil
+}
+
+func sign(message []byte, seckey []byte, domain []byte) ([]byte, error) {
+	k, err := seckeyOf(seckey)
+	if err != nil {
+		return nil, err
+	}
+	g2 := bls12381.NewG2()
+	h, err := g2.HashToCurve(message, domain)
+	if err != nil {
+		return nil, err
+	}
+	return g2.ToCompressed(g2.MulScalarBig(g2.New(), h, k)), nil
+}
+
+func verify(message []byte, pk *bls12381.PointG1, sig []byte, domain []byte) bool {
+	g2 := bls12381.NewG2()
+	s, err := g2.FromCompressed(sig)
+	if err != nil {
+		return false
+	}
+	h, err := g2.HashToCurve(message, domain)
+	if err != nil {
+		return false
+	}
+	// e(pk, H(m)) == e(g1, sig)
+	e := bls12381.NewEngine()
+	e.AddPair(pk, h)
+	e.AddPairInv(e.G1.One(), s)
+	return e.Check()
+}
+
+// Sign returns the compressed signature of the message.
+func Sign(message []byte, seckey []byte) ([]byte, error) {
+	return sign(message, seckey, sigDomain)
+}
+
+// Verify verifies the signature of the message with the compressed public key.
+func Verify(message []byte, pubkey []byte, sig []byte) bool {
+	pk, err := pubkeyOf(pubkey)
+	if err != nil {
+		return false
+	}
+	return verify(message, pk, sig, sigDomain)
+}
+
+// Prove returns the proof of possession of the secret key, which is the signature of its public key.
+func Prove(seckey []byte) ([]byte, error) {
+	pubkey, err := GetPubkey(seckey)
+	if err != nil {
+		return nil, err
+	}
+	return sign(pubkey, seckey, popDomain)
+}
+
+// VerifyProof verifies the proof of possession of the secret key of the public key.
+func VerifyProof(pubkey []byte, proof []byte) bool {
+	pk, err := pubkeyOf(pubkey)
+	if err != nil {
+		return false
+	}
+	return verify(pubkey, pk, proof, popDomain)
+}
+
+// Aggregate returns the aggregate of the signatures.
+func Aggregate(sigs [][]byte) ([]byte, error) {
+	if len(sigs) == 0 {
+		return nil, errors.New("no signature to aggregate")
+	}
+	g2 := bls12381.NewG2()
+	agg := g2.Zero()
+	for _, sig := range sigs {
+		s, err := g2.FromCompressed(sig)
+		if err != nil {
+			return nil, err
+		}
+		g2.Add(agg, agg, s)
+	}
+	return g2.ToCompressed(agg), nil
+}
+
+// VerifyAggregate verifies the aggregate signature of the message by all the public keys with one pairing check.
+// The proofs of possession of the public keys must have been verified by VerifyProof.
+func VerifyAggregate(message []byte, pubkeys [][]byte, sig []byte) bool {
+	if len(pubkeys) == 0 {
+		return false
+	}
+	g1 := bls12381.NewG1()
+	agg := g1.Zero()
+	for _, pubkey := range pubkeys {
+		pk, err := pubkeyOf(pubkey)
+		if err != nil {
+			return false
+		}
+		g1.Add(agg, agg, pk)
+	}
+	if g1.IsZero(agg) {
+		return false
+	}
+	return verify(message, agg, sig, sigDomain)
+}
@@ -0,0 +1,77 @@
+package bls
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSign(t *testing.T) {
+	seckey := GenSeckey()
+	require.Nil(t, CheckSeckey(seckey))
+	pubkey, err := GetPubkey(seckey)
+	require.Nil(t, err)
+	assert.Len(t, pubkey, PubkeyLength)
+
+	sig, err := Sign([]byte("hello"), seckey)
+	require.Nil(t, err)
+	assert.Len(t, sig, SignatureLength)
+	assert.True(t, Verify([]byte("hello"), pubkey, sig))
+	assert.False(t, Verify([]byte("hello!"), pubkey, sig))
+
+	other, err := GetPubkey(GenSeckey())
+	require.Nil(t, err)
+	assert.False(t, Verify([]byte("hello"), other, sig))
+
+	assert.NotNil(t, CheckSeckey(make([]byte, SeckeyLength)))
+	assert.NotNil(t, CheckSeckey(order.Bytes()))
+	assert.NotNil(t, CheckSeckey(seckey[1:]))
+}
+
+func TestProof(t *testing.T) {
+	seckey := GenSeckey()
+	pubkey, err := GetPubkey(seckey)
+	require.Nil(t, err)
+	proof, err := Prove(seckey)
+	require.Nil(t, err)
+	assert.True(t, VerifyProof(pubkey, proof))
+
+	// a signature of the public key is not a proof, the domains differ
+	sig, err := Sign(pubkey, seckey)
+	require.Nil(t, err)
+	assert.False(t, VerifyProof(pubkey, sig))
+	assert.False(t, Verify(pubkey, pubkey, proof))
+}
+
+func TestAggregate(t *testing.T) {
+	msg := []byte("block")
+	pubkeys := make([][]byte, 0)
+	sigs := make([][]byte, 0)
+	for i := 0; i < 4; i++ {
+		seckey := GenSeckey()
+		pubkey, err := GetPubkey(seckey)
+		require.Nil(t, err)
+		sig, err := Sign(msg, seckey)
+		require.Nil(t, err)
+		pubkeys = append(pubkeys, pubkey)
+		sigs = append(sigs, sig)
+	}
+	agg, err := Aggregate(sigs)
+	require.Nil(t, err)
+	assert.Len(t, agg, SignatureLength)
+	assert.True(t, VerifyAggregate(msg, pubkeys, agg))
+	assert.False(t, VerifyAggregate([]byte("other"), pubkeys, agg))
+	assert.False(t, VerifyAggregate(msg, pubkeys[:3], agg))
+	assert.False(t, VerifyAggregate(msg, nil, agg))
+
+	agg, err = Aggregate(sigs[1:])
+	require.Nil(t, err)
+	assert.True(t, VerifyAggregate(msg, pubkeys[1:], agg))
+	assert.False(t, VerifyAggregate(msg, pubkeys, agg))
+
+	_, err = Aggregate(nil)
+	assert.NotNil(t, err)
+	_, err = Aggregate([][]byte{sigs[0][1:]})
+	assert.NotNil(t, err)
+}
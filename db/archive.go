@@ -0,0 +1,298 @@
+package db
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/iost-official/go-iost/common"
+	"github.com/iost-official/go-iost/db/kv"
+	"github.com/iost-official/go-iost/ilog"
+)
+
+// The archive keeps, for every flushed tag, the previous values of the keys changed by the flush.
+// The state of an archived tag is rebuilt by looking up the first change after it for each key,
+// and falling back to the current storage if the key has not been changed since.
+const (
+	archiveCompactInterval = 1000
+)
+
+// keys of the archive, all of them start with SEPARATOR so that they never conflict with table keys
+var (
+	archiveSeqKey        = []byte(string(SEPARATOR) + "archive/seq")      // -> last archived seq
+	archiveStartKey      = []byte(string(SEPARATOR) + "archive/start")    // -> first seq whose state is available
+	archiveTagPrefix     = []byte(string(SEPARATOR) + "archive/tag/")     // + tag -> seq
+	archiveBlockPrefix   = []byte(string(SEPARATOR) + "archive/block/")   // + seq -> tag and keys written by the flush
+	archiveHistoryPrefix = []byte(string(SEPARATOR) + "archive/history/") // + key + SEPARATOR + seq -> value before the flush
+)
+
+// error of archive
+var (
+	ErrReadOnly = errors.New("archived state is read only")
+)
+
+func seqToBytes(seq int64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, uint64(seq))
+	return b
+}
+
+func bytesToSeq(b []byte) int64 {
+	return int64(binary.BigEndian.Uint64(b))
+}
+
+func historyPrefix(key []byte) []byte {
+	p := append([]byte{}, archiveHistoryPrefix...)
+	p = append(p, key...)
+	return append(p, SEPARATOR)
+}
+
+func encodeHistoryValue(has bool, value []byte) []byte {
+	if !has {
+		return []byte{0}
+	}
+	return append([]byte{1}, value...)
+}
+
+func decodeHistoryValue(b []byte) (bool, []byte) {
+	if len(b) == 0 || b[0] == 0 {
+		return false, []byte{}
+	}
+	return true, b[1:]
+}
+
+func encodeArchiveBlock(tag string, keys [][]byte) []byte {
+	se := common.NewSimpleEncoder()
+	se.WriteString(tag)
+	se.WriteBytesSlice(keys)
+	return se.Bytes()
+}
+
+func decodeArchiveBlock(b []byte) (string, [][]byte, error) {
+	sd := common.NewSimpleDecoder(b)
+	tag, err := sd.ParseBytes()
+	if err != nil {
+		return "", nil, err
+	}
+	n, err := sd.ParseInt32()
+	if err != nil {
+		return "", nil, err
+	}
+	keys := make([][]byte, 0, n)
+	for i := int32(0); i < n; i++ {
+		k, err := sd.ParseBytes()
+		if err != nil {
+			return "", nil, err
+		}
+		keys = append(keys, k)
+	}
+	return string(tag), keys, nil
+}
+
+type archive struct {
+	storage *kv.Storage
+	retain  int64
+	seq     int64
+}
+
+func newArchive(storage *kv.Storage, retain int64) (*archive, error) {
+	a := &archive{
+		storage: storage,
+		retain:  retain,
+	}
+	seq, err := storage.Get(archiveSeqKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get archive seq: %v", err)
+	}
+	if len(seq) != 0 {
+		a.seq = bytesToSeq(seq)
+	}
+	return a, nil
+}
+
+// record writes the history of a flush into the current batch of storage.
+// It must be called before the new values are put into the batch.
+func (a *archive) record(tag string, items map[string]*Item) error {
+	seq := a.seq + 1
+	if seq == 1 {
+		if err := a.storage.Put(archiveStartKey, seqToBytes(seq)); err != nil {
+			return err
+		}
+	}
+	keys := make([][]byte, 0, len(items))
+	for k, item := range items {
+		key := []byte(k)
+		has, err := a.storage.Has(key)
+		if err != nil {
+			return err
+		}
+		value, err := a.storage.Get(key)
+		if err != nil {
+			return err
+		}
+		if has == !item.deleted && string(value) == item.value {
+			continue
+		}
+		hk := append(historyPrefix(key), seqToBytes(seq)...)
+		if err := a.storage.Put(hk, encodeHistoryValue(has, value)); err != nil {
+			return err
+		}
+		keys = append(keys, key)
+	}
+	if err := a.storage.Put(append(archiveBlockPrefix, seqToBytes(seq)...), encodeArchiveBlock(tag, keys)); err != nil {
+		return err
+	}
+	if err := a.storage.Put(append(archiveTagPrefix, tag...), seqToBytes(seq)); err != nil {
+		return err
+	}
+	if err := a.storage.Put(archiveSeqKey, seqToBytes(seq)); err != nil {
+		return err
+	}
+	a.seq = seq
+	return nil
+}
+
+// start returns the first seq whose state is available.
+func (a *archive) start() (int64, error) {
+	b, err := a.storage.Get(archiveStartKey)
+	if err != nil {
+		return 0, err
+	}
+	if len(b) == 0 {
+		return 0, nil
+	}
+	return bytesToSeq(b), nil
+}
+
+// lookup returns the seq of the archived tag.
+func (a *archive) lookup(tag string) (int64, bool) {
+	b, err := a.storage.Get(append(archiveTagPrefix, tag...))
+	if err != nil || len(b) == 0 {
+		return 0, false
+	}
+	seq := bytesToSeq(b)
+	start, err := a.start()
+	if err != nil || start == 0 || seq < start {
+		return 0, false
+	}
+	return seq, true
+}
+
+// compact removes the history which is older than the retained seqs.
+func (a *archive) compact() error {
+	if a.retain <= 0 || a.seq%archiveCompactInterval != 0 {
+		return nil
+	}
+	cutoff := a.seq - a.retain + 1
+	start, err := a.start()
+	if err != nil {
+		return err
+	}
+	if cutoff <= start {
+		return nil
+	}
+	if err := a.storage.BeginBatch(); err != nil {
+		return err
+	}
+	iter := a.storage.NewIteratorByPrefix(archiveBlockPrefix)
+	for iter.Next() {
+		seqBytes := iter.Key()[len(archiveBlockPrefix):]
+		seq := bytesToSeq(seqBytes)
+		if seq >= cutoff {
+			break
+		}
+		tag, keys, err := decodeArchiveBlock(iter.Value())
+		if err != nil {
+			iter.Release()
+			return err
+		}
+		for _, k := range keys {
+			a.storage.Delete(append(historyPrefix(k), seqBytes...))
+		}
+		a.storage.Delete(append(archiveTagPrefix, tag...))
+		a.storage.Delete(append(append([]byte{}, archiveBlockPrefix...), seqBytes...))
+	}
+	iter.Release()
+	if err := iter.Error(); err != nil {
+		return err
+	}
+	a.storage.Put(archiveStartKey, seqToBytes(cutoff))
+	if err := a.storage.CommitBatch(); err != nil {
+		return err
+	}
+	ilog.Infof("compacted state archive before seq %v", cutoff)
+	return nil
+}
+
+// view returns a read only storage presenting the state of the archived seq.
+func (a *archive) view(seq int64) *kv.Storage {
+	return &kv.Storage{
+		StorageBackend: &archiveView{
+			StorageBackend: a.storage.StorageBackend,
+			storage:        a.storage,
+			seq:            seq,
+		},
+	}
+}
+
+type archiveView struct {
+	kv.StorageBackend
+	storage *kv.Storage
+	seq     int64
+}
+
+func (v *archiveView) get(key []byte) (bool, []byte, error) {
+	// The first history entry after the archived seq keeps the value at the seq, so seek to it directly.
+	prefix := historyPrefix(key)
+	iter := v.storage.NewIteratorByRange(append(prefix, seqToBytes(v.seq+1)...), nil)
+	defer iter.Release()
+	for iter.Next() {
+		k := iter.Key()
+		if !bytes.HasPrefix(k, prefix) {
+			break
+		}
+		// Skip the history of the longer keys sharing the prefix.
+		if len(k) != len(prefix)+8 {
+			continue
+		}
+		has, value := decodeHistoryValue(iter.Value())
+		return has, append([]byte{}, value...), nil
+	}
+	if err := iter.Error(); err != nil {
+		return false, nil, err
+	}
+	has, err := v.storage.Has(key)
+	if err != nil {
+		return false, nil, err
+	}
+	value, err := v.storage.Get(key)
+	return has, value, err
+}
+
+// Get returns the value of the key at the archived seq
+func (v *archiveView) Get(key []byte) ([]byte, error) {
+	_, value, err := v.get(key)
+	return value, err
+}
+
+// Has returns whether the key exists at the archived seq
+func (v *archiveView) Has(key []byte) (bool, error) {
+	has, _, err := v.get(key)
+	return has, err
+}
+
+// Put is not supported by archived state
+func (v *archiveView) Put(key []byte, value []byte) error {
+	return ErrReadOnly
+}
+
+// Delete is not supported by archived state
+func (v *archiveView) Delete(key []byte) error {
+	return ErrReadOnly
+}
+
+// BeginBatch is not supported by archived state
+func (v *archiveView) BeginBatch() error {
+	return ErrReadOnly
+}
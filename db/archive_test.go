@@ -0,0 +1,88 @@
+package db
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestArchiveCheckout(t *testing.T) {
+	path := "archive_test"
+	mvccdb, err := NewArchiveMVCCDB(path, 0)
+	require.Nil(t, err)
+	defer func() {
+		mvccdb.Close()
+		os.RemoveAll(path)
+	}()
+
+	mvccdb.Put("table01", "key01", "value01")
+	mvccdb.Put("table01", "key02", "value02")
+	mvccdb.Put("table01", "key01/sub", "value01sub")
+	mvccdb.Commit("tag1")
+	require.Nil(t, mvccdb.Flush("tag1"))
+
+	mvccdb.Put("table01", "key01", "value011")
+	mvccdb.Del("table01", "key02")
+	mvccdb.Put("table01", "key03", "value03")
+	mvccdb.Commit("tag2")
+	require.Nil(t, mvccdb.Flush("tag2"))
+
+	mvccdb.Put("table01", "key03", "value033")
+	mvccdb.Del("table01", "key01/sub")
+	mvccdb.Commit("tag3")
+	require.Nil(t, mvccdb.Flush("tag3"))
+
+	expects := map[string]map[string]string{
+		"tag1": {"key01": "value01", "key02": "value02", "key03": "", "key01/sub": "value01sub"},
+		"tag2": {"key01": "value011", "key02": "", "key03": "value03", "key01/sub": "value01sub"},
+		"tag3": {"key01": "value011", "key02": "", "key03": "value033", "key01/sub": ""},
+	}
+	for tag, kvs := range expects {
+		fork := mvccdb.Fork()
+		require.True(t, fork.Checkout(tag), tag)
+		for k, v := range kvs {
+			value, err := fork.Get("table01", k)
+			assert.Nil(t, err)
+			assert.Equal(t, v, value, tag+" "+k)
+			has, err := fork.Has("table01", k)
+			assert.Nil(t, err)
+			assert.Equal(t, v != "", has, tag+" "+k)
+		}
+	}
+
+	fork := mvccdb.Fork()
+	require.True(t, fork.Checkout("tag1"))
+	assert.Equal(t, ErrReadOnly, fork.Flush("tag1"))
+	assert.False(t, fork.Checkout("tag0"))
+
+	value, err := mvccdb.Get("table01", "key03")
+	assert.Nil(t, err)
+	assert.Equal(t, "value033", value)
+}
+
+func TestArchiveCompact(t *testing.T) {
+	path := "archive_compact_test"
+	mvccdb, err := NewArchiveMVCCDB(path, 10)
+	require.Nil(t, err)
+	defer func() {
+		mvccdb.Close()
+		os.RemoveAll(path)
+	}()
+
+	for i := 0; i < archiveCompactInterval; i++ {
+		tag := string(seqToBytes(int64(i)))
+		mvccdb.Put("table01", "key01", tag)
+		mvccdb.Commit(tag)
+		require.Nil(t, mvccdb.Flush(tag))
+	}
+
+	fork := mvccdb.Fork()
+	assert.False(t, fork.Checkout(string(seqToBytes(int64(archiveCompactInterval-11)))))
+	tag := string(seqToBytes(int64(archiveCompactInterval - 10)))
+	require.True(t, fork.Checkout(tag))
+	value, err := fork.Get("table01", "key01")
+	assert.Nil(t, err)
+	assert.Equal(t, tag, value)
+}
@@ -125,6 +125,53 @@ func (d *DB) NewIteratorByPrefix(prefix []byte) interface{} {
 	}
 }
 
+// NewIteratorByRange returns a new iterator of keys in [start, limit)
+func (d *DB) NewIteratorByRange(start []byte, limit []byte) interface{} {
+	iter := d.db.NewIterator(&util.Range{Start: start, Limit: limit}, nil)
+	return &Iter{
+		iter: iter,
+	}
+}
+
+// NewSnapshot returns a read only snapshot of the current state of leveldb
+func (d *DB) NewSnapshot() (interface{}, error) {
+	snap, err := d.db.GetSnapshot()
+	if err != nil {
+		return nil, err
+	}
+	return &Snapshot{
+		snap: snap,
+	}, nil
+}
+
+// Snapshot is the snapshot for leveldb
+type Snapshot struct {
+	snap *leveldb.Snapshot
+}
+
+// Get return the value of the specify key in snapshot
+func (s *Snapshot) Get(key []byte) ([]byte, error) {
+	value, err := s.snap.Get(key, nil)
+	if err == leveldb.ErrNotFound {
+		return []byte{}, nil
+	}
+
+	return value, err
+}
+
+// NewIteratorByRange returns a new iterator of keys in [start, limit) in snapshot
+func (s *Snapshot) NewIteratorByRange(start []byte, limit []byte) interface{} {
+	iter := s.snap.NewIterator(&util.Range{Start: start, Limit: limit}, nil)
+	return &Iter{
+		iter: iter,
+	}
+}
+
+// Release will release the snapshot
+func (s *Snapshot) Release() {
+	s.snap.Release()
+}
+
 // Iter is the iterator for leveldb
 type Iter struct {
 	iter iterator.Iterator
@@ -25,6 +25,8 @@ type StorageBackend interface {
 	Size() (int64, error)
 	Close() error
 	NewIteratorByPrefix(prefix []byte) interface{}
+	NewIteratorByRange(start []byte, limit []byte) interface{}
+	NewSnapshot() (interface{}, error)
 }
 
 // Storage is a kv database
@@ -58,6 +60,45 @@ func (s *Storage) NewIteratorByPrefix(prefix []byte) *Iterator {
 	}
 }
 
+// NewIteratorByRange returns a new iterator of keys in [start, limit)
+func (s *Storage) NewIteratorByRange(start []byte, limit []byte) *Iterator {
+	ib := s.StorageBackend.NewIteratorByRange(start, limit).(IteratorBackend)
+	return &Iterator{
+		IteratorBackend: ib,
+	}
+}
+
+// NewSnapshot returns a read only snapshot of the current state of storage
+func (s *Storage) NewSnapshot() (*Snapshot, error) {
+	sb, err := s.StorageBackend.NewSnapshot()
+	if err != nil {
+		return nil, err
+	}
+	return &Snapshot{
+		SnapshotBackend: sb.(SnapshotBackend),
+	}, nil
+}
+
+// SnapshotBackend is the storage snapshot backend
+type SnapshotBackend interface {
+	Get(key []byte) ([]byte, error)
+	NewIteratorByRange(start []byte, limit []byte) interface{}
+	Release()
+}
+
+// Snapshot is the storage snapshot
+type Snapshot struct {
+	SnapshotBackend
+}
+
+// NewIteratorByRange returns a new iterator of keys in [start, limit)
+func (s *Snapshot) NewIteratorByRange(start []byte, limit []byte) *Iterator {
+	ib := s.SnapshotBackend.NewIteratorByRange(start, limit).(IteratorBackend)
+	return &Iterator{
+		IteratorBackend: ib,
+	}
+}
+
 // IteratorBackend is the storage iterator backend
 type IteratorBackend interface {
 	Next() bool
@@ -178,3 +178,40 @@ func (m *MockMVCCDB) Size() (int64, error) {
 func (mr *MockMVCCDBMockRecorder) Size() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Size", reflect.TypeOf((*MockMVCCDB)(nil).Size))
 }
+
+// Snapshot mocks base method
+func (m *MockMVCCDB) Snapshot() (*db.Snapshot, error) {
+	ret := m.ctrl.Call(m, "Snapshot")
+	ret0, _ := ret[0].(*db.Snapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Snapshot indicates an expected call of Snapshot
+func (mr *MockMVCCDBMockRecorder) Snapshot() *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Snapshot", reflect.TypeOf((*MockMVCCDB)(nil).Snapshot))
+}
+
+// Range mocks base method
+func (m *MockMVCCDB) Range(arg0 func([]byte, []byte) bool) error {
+	ret := m.ctrl.Call(m, "Range", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Range indicates an expected call of Range
+func (mr *MockMVCCDBMockRecorder) Range(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Range", reflect.TypeOf((*MockMVCCDB)(nil).Range), arg0)
+}
+
+// WriteSet mocks base method
+func (m *MockMVCCDB) WriteSet() []*db.Item {
+	ret := m.ctrl.Call(m, "WriteSet")
+	ret0, _ := ret[0].([]*db.Item)
+	return ret0
+}
+
+// WriteSet indicates an expected call of WriteSet
+func (mr *MockMVCCDBMockRecorder) WriteSet() *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteSet", reflect.TypeOf((*MockMVCCDB)(nil).WriteSet))
+}
@@ -2,6 +2,7 @@ package db
 
 import (
 	"fmt"
+	"sort"
 	"sync"
 
 	"github.com/iost-official/go-iost/db/kv"
@@ -32,6 +33,9 @@ type MVCCDB interface {
 	CurrentTag() string
 	Fork() MVCCDB
 	Flush(t string) error
+	WriteSet() []*Item
+	Range(f func(key []byte, value []byte) bool) error
+	Snapshot() (*Snapshot, error)
 	Size() (int64, error)
 	Close() error
 }
@@ -41,12 +45,54 @@ func NewMVCCDB(path string) (MVCCDB, error) {
 	return NewCacheMVCCDB(path, mvcc.MapCache)
 }
 
+// NewArchiveMVCCDB return new mvccdb which retains the history of flushed states.
+// The states of the last retain flushed tags can be checked out, 0 means all of them.
+func NewArchiveMVCCDB(path string, retain int64) (MVCCDB, error) {
+	mvccdb, err := NewCacheMVCCDB(path, mvcc.MapCache)
+	if err != nil {
+		return nil, err
+	}
+	mvccdb.archive, err = newArchive(mvccdb.storage, retain)
+	if err != nil {
+		return nil, err
+	}
+	return mvccdb, nil
+}
+
 // Item is the value of cache
 type Item struct {
 	table   string
 	key     string
 	value   string
 	deleted bool
+	// the committed value before the write, only set in the items returned by WriteSet
+	prevValue string
+	prevExist bool
+}
+
+// Table returns the table of the item.
+func (i *Item) Table() string {
+	return i.table
+}
+
+// Key returns the key of the item.
+func (i *Item) Key() string {
+	return i.key
+}
+
+// Value returns the value of the item, which is empty if deleted.
+func (i *Item) Value() string {
+	return i.value
+}
+
+// Deleted returns whether the item is deleted.
+func (i *Item) Deleted() bool {
+	return i.deleted
+}
+
+// Prev returns the committed value before the write, and whether it exists.
+func (i *Item) Prev() (string, bool) {
+	return i.prevValue, i.prevExist
 }
 
 // Commit is the cache of specify tag
@@ -143,7 +189,11 @@ type CacheMVCCDB struct {
 	stage   mvcc.Cache
 	storage *kv.Storage
 	cm      *CommitManager
+	archive *archive
 	rwmu    sync.RWMutex
+	// writes is the items written since the last commit or checkout.
+	writes map[string]*Item
+	wmu    sync.Mutex
 }
 
 // NewCacheMVCCDB returns new CacheMVCCDB
@@ -160,6 +210,7 @@ func NewCacheMVCCDB(path string, cacheType mvcc.CacheType) (*CacheMVCCDB, error)
 		stage:   stage,
 		storage: storage,
 		cm:      cm,
+		writes:  make(map[string]*Item),
 	}
 
 	tag, err := storage.Get([]byte(string(SEPARATOR) + "tag"))
@@ -222,6 +273,7 @@ func (m *CacheMVCCDB) Put(table string, key string, value string) error {
 		deleted: false,
 	}
 	m.stage.Put(k, v)
+	m.write(k, v)
 	return nil
 }
 
@@ -238,6 +290,122 @@ func (m *CacheMVCCDB) Del(table string, key string) error {
 		deleted: true,
 	}
 	m.stage.Put(k, v)
+	m.write(k, v)
+	return nil
+}
+
+func (m *CacheMVCCDB) write(k []byte, v *Item) {
+	m.wmu.Lock()
+	m.writes[string(k)] = v
+	m.wmu.Unlock()
+}
+
+func (m *CacheMVCCDB) resetWrites() {
+	m.wmu.Lock()
+	m.writes = make(map[string]*Item)
+	m.wmu.Unlock()
+}
+
+// WriteSet returns the items changed since the last commit or checkout, sorted by table and key,
+// along with their committed values before the change.
+// The items written with their committed values are not included, so the write set only depends on
+// the state before and after the writes.
+func (m *CacheMVCCDB) WriteSet() []*Item {
+	m.rwmu.RLock()
+	defer m.rwmu.RUnlock()
+	m.wmu.Lock()
+	defer m.wmu.Unlock()
+
+	changed := make(map[string]*Item, len(m.writes))
+	keys := make([]string, 0, len(m.writes))
+	for k, v := range m.writes {
+		exist, value := m.committed([]byte(k))
+		if exist == !v.deleted && value == v.value {
+			continue
+		}
+		item := *v
+		item.prevValue, item.prevExist = value, exist
+		changed[k] = &item
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	items := make([]*Item, 0, len(keys))
+	for _, k := range keys {
+		items = append(items, changed[k])
+	}
+	return items
+}
+
+// committed returns the value of the key in the head commit.
+func (m *CacheMVCCDB) committed(k []byte) (bool, string) {
+	if v := m.head.Get(k); v != nil {
+		if i, ok := v.(*Item); ok {
+			return !i.deleted, i.value
+		}
+	}
+	exist, err := m.storage.Has(k)
+	if err != nil || !exist {
+		return false, ""
+	}
+	v, err := m.storage.Get(k)
+	if err != nil {
+		return false, ""
+	}
+	return true, string(v)
+}
+
+// Range calls f with the key value pairs of the state at the last commit or checkout in no particular order,
+// until f returns false. The key is in the form of table/key, and the internal keys are skipped.
+// It reads through the whole state, so it should only be used in rare cases.
+func (m *CacheMVCCDB) Range(f func(key []byte, value []byte) bool) error {
+	m.rwmu.RLock()
+	if m.isArchived() {
+		m.rwmu.RUnlock()
+		return fmt.Errorf("can't range the archived state")
+	}
+	// The commits are read before the storage, so that a concurrent flush only adds the same items into the storage.
+	committed := make(map[string]*Item)
+	for _, v := range m.head.All([]byte("")) {
+		item, ok := v.(*Item)
+		if !ok {
+			m.rwmu.RUnlock()
+			return fmt.Errorf("can't assert Item type")
+		}
+		committed[item.table+string(SEPARATOR)+item.key] = item
+	}
+	storage := m.storage
+	m.rwmu.RUnlock()
+
+	snapshot, err := storage.NewSnapshot()
+	if err != nil {
+		return fmt.Errorf("failed to new snapshot: %v", err)
+	}
+	defer snapshot.Release()
+	iter := snapshot.NewIteratorByRange(nil, nil)
+	defer iter.Release()
+	for iter.Next() {
+		key := iter.Key()
+		if len(key) == 0 || key[0] == SEPARATOR {
+			continue
+		}
+		if _, ok := committed[string(key)]; ok {
+			continue
+		}
+		if !f(append([]byte{}, key...), append([]byte{}, iter.Value()...)) {
+			return nil
+		}
+	}
+	if err := iter.Error(); err != nil {
+		return err
+	}
+	for k, item := range committed {
+		if item.deleted {
+			continue
+		}
+		if !f([]byte(k), []byte(item.value)) {
+			return nil
+		}
+	}
 	return nil
 }
 
@@ -291,21 +459,49 @@ func (m *CacheMVCCDB) Checkout(t string) bool {
 
 	head := m.cm.Get(t)
 	if head == nil {
-		return false
+		return m.checkoutArchive(t)
+	}
+	if m.archive != nil {
+		m.storage = m.archive.storage
 	}
 	m.head = head
 	m.stage = m.head.ForkCache()
+	m.resetWrites()
+	return true
+}
+
+func (m *CacheMVCCDB) checkoutArchive(t string) bool {
+	if m.archive == nil {
+		return false
+	}
+	seq, ok := m.archive.lookup(t)
+	if !ok {
+		return false
+	}
+	m.storage = m.archive.view(seq)
+	m.head = NewCommit(mvcc.NewCache(mvcc.MapCache), t)
+	m.stage = m.head.ForkCache()
+	m.resetWrites()
 	return true
 }
 
+func (m *CacheMVCCDB) isArchived() bool {
+	return m.archive != nil && m.storage != m.archive.storage
+}
+
 // Commit will commit the stage and add tag to current state of mvccdb
 func (m *CacheMVCCDB) Commit(t string) {
 	m.rwmu.Lock()
 	defer m.rwmu.Unlock()
 
+	if m.isArchived() {
+		return
+	}
+
 	m.head = NewCommit(m.stage, t)
 	m.stage = m.head.ForkCache()
 	m.cm.Add(m.head)
+	m.resetWrites()
 }
 
 // CurrentTag will return current tag of mvccdb
@@ -327,28 +523,42 @@ func (m *CacheMVCCDB) Fork() MVCCDB {
 		stage:   m.head.ForkCache(),
 		storage: m.storage,
 		cm:      m.cm,
+		archive: m.archive,
+		writes:  make(map[string]*Item),
 	}
 	return mvccdb
 }
 
 // Flush will persist the current state of mvccdb
 func (m *CacheMVCCDB) Flush(t string) error {
+	if m.isArchived() {
+		return ErrReadOnly
+	}
 	commit := m.cm.Get(t)
 	if commit == nil {
 		return fmt.Errorf("not found tag: %v", t)
 	}
+	items := make(map[string]*Item)
+	for _, v := range commit.All([]byte("")) {
+		item, ok := v.(*Item)
+		if !ok {
+			return fmt.Errorf("can't assert Item type")
+		}
+		items[item.table+string(SEPARATOR)+item.key] = item
+	}
 	if err := m.storage.BeginBatch(); err != nil {
 		return err
 	}
+	if m.archive != nil {
+		if err := m.archive.record(t, items); err != nil {
+			return fmt.Errorf("failed to archive: %v", err)
+		}
+	}
 	err := m.storage.Put([]byte(string(SEPARATOR)+"tag"), []byte(t))
 	if err != nil {
 		return err
 	}
-	for _, v := range commit.All([]byte("")) {
-		item, ok := v.(*Item)
-		if !ok {
-			return fmt.Errorf("can't assert Item type")
-		}
+	for _, item := range items {
 		if item.deleted {
 			err := m.storage.Delete([]byte(item.table + string(SEPARATOR) + item.key))
 			if err != nil {
@@ -365,6 +575,11 @@ func (m *CacheMVCCDB) Flush(t string) error {
 		return err
 	}
 	m.cm.FreeBefore(commit)
+	if m.archive != nil {
+		if err := m.archive.compact(); err != nil {
+			return fmt.Errorf("failed to compact archive: %v", err)
+		}
+	}
 	return nil
 }
 
@@ -255,6 +255,33 @@ func (suite *MVCCDBTestSuite) TestFlushAndCheckout() {
 	suite.Equal("", value)
 }
 
+func (suite *MVCCDBTestSuite) TestWriteSet() {
+	err := suite.mvccdb.Put("table01", "key06", "value06")
+	suite.Nil(err)
+	err = suite.mvccdb.Del("table01", "key04")
+	suite.Nil(err)
+	err = suite.mvccdb.Put("table01", "key01", "value01")
+	suite.Nil(err)
+	err = suite.mvccdb.Del("table01", "key07")
+	suite.Nil(err)
+
+	writeSet := suite.mvccdb.WriteSet()
+	suite.Equal(2, len(writeSet))
+	suite.Equal("key04", writeSet[0].Key())
+	suite.True(writeSet[0].Deleted())
+	suite.Equal("key06", writeSet[1].Key())
+	suite.Equal("value06", writeSet[1].Value())
+
+	suite.mvccdb.Commit("tag1")
+	suite.Equal(0, len(suite.mvccdb.WriteSet()))
+
+	err = suite.mvccdb.Put("table01", "key04", "value04")
+	suite.Nil(err)
+	suite.Equal(1, len(suite.mvccdb.WriteSet()))
+	suite.mvccdb.Checkout("tag0")
+	suite.Equal(0, len(suite.mvccdb.WriteSet()))
+}
+
 func (suite *MVCCDBTestSuite) TearDownTest() {
 	err := suite.mvccdb.Close()
 	suite.Nil(err, "Close MVCCDB should not fail")
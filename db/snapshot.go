@@ -0,0 +1,70 @@
+package db
+
+import (
+	"fmt"
+
+	"github.com/iost-official/go-iost/db/kv"
+)
+
+// Snapshot is a read only view of the flushed state of mvccdb.
+// It is consistent even if the mvccdb is flushed after the snapshot is taken.
+type Snapshot struct {
+	snapshot *kv.Snapshot
+	tag      string
+}
+
+// Snapshot returns a read only view of the flushed state of mvccdb, which must be released after use.
+func (m *CacheMVCCDB) Snapshot() (*Snapshot, error) {
+	if m.isArchived() {
+		return nil, fmt.Errorf("can't snapshot the archived state")
+	}
+	snapshot, err := m.storage.NewSnapshot()
+	if err != nil {
+		return nil, fmt.Errorf("failed to new snapshot: %v", err)
+	}
+	tag, err := snapshot.Get([]byte(string(SEPARATOR) + "tag"))
+	if err != nil {
+		snapshot.Release()
+		return nil, fmt.Errorf("failed to get tag from snapshot: %v", err)
+	}
+	return &Snapshot{
+		snapshot: snapshot,
+		tag:      string(tag),
+	}, nil
+}
+
+// Tag returns the tag of the flushed state.
+func (s *Snapshot) Tag() string {
+	return s.tag
+}
+
+// Get returns the value of the key in the table, it is empty if the key does not exist.
+func (s *Snapshot) Get(table string, key string) (string, error) {
+	v, err := s.snapshot.Get([]byte(table + string(SEPARATOR) + key))
+	if err != nil {
+		return "", err
+	}
+	return string(v), nil
+}
+
+// Range calls f with the key value pairs of all tables in order, starting from the key start,
+// until f returns false. The key is in the form of table/key, and the internal keys are skipped.
+func (s *Snapshot) Range(start []byte, f func(key []byte, value []byte) bool) error {
+	iter := s.snapshot.NewIteratorByRange(start, nil)
+	defer iter.Release()
+	for iter.Next() {
+		key := iter.Key()
+		if len(key) == 0 || key[0] == SEPARATOR {
+			continue
+		}
+		if !f(append([]byte{}, key...), append([]byte{}, iter.Value()...)) {
+			break
+		}
+	}
+	return iter.Error()
+}
+
+// Release will release the snapshot.
+func (s *Snapshot) Release() {
+	s.snapshot.Release()
+}
@@ -0,0 +1,145 @@
+package db
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/iost-official/go-iost/common"
+	"golang.org/x/crypto/sha3"
+)
+
+// StateHashTable is the table keeping the state hash, which is not covered by the state hash itself.
+const StateHashTable = "statehash"
+
+// StateHashKey is the key of the state hash in StateHashTable.
+const StateHashKey = "lthash"
+
+// StateHashDeltaKey is the key in StateHashTable of the changes of the state hash since the blocks start to track them,
+// before the state root fork.
+const StateHashDeltaKey = "delta"
+
+// StateHashBaseKey is the key in StateHashTable of the state hash before the blocks start to track the changes,
+// which is computed off the block path.
+const StateHashBaseKey = "base"
+
+const stateHashLanes = 1024
+
+// StateHash is a homomorphic multiset hash (LtHash) of all the key value pairs of the state.
+// The hash of every pair is expanded into 1024 16-bit lanes, and the state hash is their lane-wise sum,
+// so a pair is added or removed with one hash regardless of the size of the state, and the order of
+// the changes does not matter. Two states have the same hash only if they have the same pairs.
+type StateHash struct {
+	lanes [stateHashLanes]uint16
+}
+
+// NewStateHash returns the state hash of the empty state.
+func NewStateHash() *StateHash {
+	return &StateHash{}
+}
+
+func pairLanes(key []byte, value []byte) *[stateHashLanes]uint16 {
+	se := common.NewSimpleEncoder()
+	se.WriteBytes(key)
+	se.WriteBytes(value)
+	b := make([]byte, stateHashLanes*2)
+	sha3.ShakeSum256(b, se.Bytes())
+	var lanes [stateHashLanes]uint16
+	for i := range lanes {
+		lanes[i] = binary.LittleEndian.Uint16(b[i*2:])
+	}
+	return &lanes
+}
+
+func isStateHashKey(key []byte) bool {
+	prefix := StateHashTable + string(SEPARATOR)
+	return len(key) >= len(prefix) && string(key[:len(prefix)]) == prefix
+}
+
+// Add adds the pair into the state hash, the key is in the form of table/key.
+// The pairs of StateHashTable are ignored.
+func (h *StateHash) Add(key []byte, value []byte) {
+	if isStateHashKey(key) {
+		return
+	}
+	lanes := pairLanes(key, value)
+	for i := range h.lanes {
+		h.lanes[i] += lanes[i]
+	}
+}
+
+// Remove removes the pair from the state hash, the key is in the form of table/key.
+// The pairs of StateHashTable are ignored.
+func (h *StateHash) Remove(key []byte, value []byte) {
+	if isStateHashKey(key) {
+		return
+	}
+	lanes := pairLanes(key, value)
+	for i := range h.lanes {
+		h.lanes[i] -= lanes[i]
+	}
+}
+
+// Update applies the write set to the state hash.
+func (h *StateHash) Update(writeSet []*Item) {
+	for _, item := range writeSet {
+		key := []byte(item.table + string(SEPARATOR) + item.key)
+		if item.prevExist {
+			h.Remove(key, []byte(item.prevValue))
+		}
+		if !item.deleted {
+			h.Add(key, []byte(item.value))
+		}
+	}
+}
+
+// Merge adds the changes kept by the other state hash, which started from the empty state.
+func (h *StateHash) Merge(o *StateHash) {
+	for i := range h.lanes {
+		h.lanes[i] += o.lanes[i]
+	}
+}
+
+// Unmerge removes the changes kept by the other state hash, which started from the empty state.
+func (h *StateHash) Unmerge(o *StateHash) {
+	for i := range h.lanes {
+		h.lanes[i] -= o.lanes[i]
+	}
+}
+
+// Root returns the hash of the lanes, which is committed by the block head.
+func (h *StateHash) Root() []byte {
+	return common.Sha3(h.Encode())
+}
+
+// Encode returns the lanes in little endian.
+func (h *StateHash) Encode() []byte {
+	b := make([]byte, stateHashLanes*2)
+	for i, lane := range h.lanes {
+		binary.LittleEndian.PutUint16(b[i*2:], lane)
+	}
+	return b
+}
+
+// Decode reads the lanes encoded by Encode.
+func (h *StateHash) Decode(b []byte) error {
+	if len(b) != stateHashLanes*2 {
+		return fmt.Errorf("invalid state hash length %v", len(b))
+	}
+	for i := range h.lanes {
+		h.lanes[i] = binary.LittleEndian.Uint16(b[i*2:])
+	}
+	return nil
+}
+
+// ComputeStateHash reads through the state at the last commit or checkout of the db, and returns its state hash.
+func ComputeStateHash(mvccdb MVCCDB) (*StateHash, error) {
+	h := NewStateHash()
+	err := mvccdb.Range(func(key []byte, value []byte) bool {
+		h.Add(key, value)
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	return h, nil
+}
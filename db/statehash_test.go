@@ -0,0 +1,106 @@
+package db
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStateHash(t *testing.T) {
+	h1, h2 := NewStateHash(), NewStateHash()
+	h1.Add([]byte("state/a"), []byte("1"))
+	h1.Add([]byte("state/b"), []byte("2"))
+	h2.Add([]byte("state/b"), []byte("2"))
+	h2.Add([]byte("state/a"), []byte("1"))
+	assert.Equal(t, h1.Root(), h2.Root())
+
+	h2.Add([]byte("state/c"), []byte("3"))
+	assert.NotEqual(t, h1.Root(), h2.Root())
+	h2.Remove([]byte("state/c"), []byte("3"))
+	assert.Equal(t, h1.Root(), h2.Root())
+
+	h2.Add([]byte(StateHashTable+"/"+StateHashKey), []byte("ignored"))
+	assert.Equal(t, h1.Root(), h2.Root())
+
+	var h3 StateHash
+	require.Nil(t, h3.Decode(h1.Encode()))
+	assert.Equal(t, h1.Root(), h3.Root())
+	assert.NotNil(t, h3.Decode([]byte("short")))
+}
+
+func TestStateHashUpdate(t *testing.T) {
+	path := "StateHashDB"
+	defer os.RemoveAll(path)
+	mvccdb, err := NewMVCCDB(path)
+	require.Nil(t, err)
+	defer mvccdb.Close()
+
+	mvccdb.Put("state", "a", "1")
+	mvccdb.Put("state", "b", "2")
+	mvccdb.Put("state", "c", "3")
+	mvccdb.Commit("tag1")
+	require.Nil(t, mvccdb.Flush("tag1"))
+	mvccdb.Put("state", "d", "4")
+	mvccdb.Del("state", "c")
+	mvccdb.Commit("tag2")
+
+	h, err := ComputeStateHash(mvccdb)
+	require.Nil(t, err)
+	expected := NewStateHash()
+	expected.Add([]byte("state/a"), []byte("1"))
+	expected.Add([]byte("state/b"), []byte("2"))
+	expected.Add([]byte("state/d"), []byte("4"))
+	assert.Equal(t, expected.Root(), h.Root())
+
+	mvccdb.Put("state", "a", "5")
+	mvccdb.Del("state", "b")
+	mvccdb.Del("state", "x")
+	mvccdb.Put("state", "e", "6")
+	h.Update(mvccdb.WriteSet())
+	mvccdb.Commit("tag3")
+	updated, err := ComputeStateHash(mvccdb)
+	require.Nil(t, err)
+	assert.Equal(t, updated.Root(), h.Root())
+}
+
+func TestStateHashMerge(t *testing.T) {
+	h, delta := NewStateHash(), NewStateHash()
+	h.Add([]byte("state/a"), []byte("1"))
+	delta.Add([]byte("state/b"), []byte("2"))
+	delta.Remove([]byte("state/a"), []byte("1"))
+	h.Merge(delta)
+	expected := NewStateHash()
+	expected.Add([]byte("state/b"), []byte("2"))
+	assert.Equal(t, expected.Root(), h.Root())
+	h.Unmerge(delta)
+	expected = NewStateHash()
+	expected.Add([]byte("state/a"), []byte("1"))
+	assert.Equal(t, expected.Root(), h.Root())
+}
+
+func TestSnapshotGet(t *testing.T) {
+	path := "SnapshotGetDB"
+	defer os.RemoveAll(path)
+	mvccdb, err := NewMVCCDB(path)
+	require.Nil(t, err)
+	defer mvccdb.Close()
+
+	mvccdb.Put("state", "a", "1")
+	mvccdb.Commit("tag1")
+	require.Nil(t, mvccdb.Flush("tag1"))
+	snapshot, err := mvccdb.Snapshot()
+	require.Nil(t, err)
+	defer snapshot.Release()
+	mvccdb.Put("state", "a", "2")
+	mvccdb.Commit("tag2")
+	require.Nil(t, mvccdb.Flush("tag2"))
+
+	v, err := snapshot.Get("state", "a")
+	require.Nil(t, err)
+	assert.Equal(t, "1", v)
+	v, err = snapshot.Get("state", "b")
+	require.Nil(t, err)
+	assert.Equal(t, "", v)
+}
@@ -0,0 +1,78 @@
+package iserver
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/iost-official/go-iost/account"
+	"github.com/iost-official/go-iost/common"
+	"github.com/iost-official/go-iost/consensus/genesis"
+	"github.com/iost-official/go-iost/crypto"
+	"github.com/iost-official/go-iost/ilog"
+)
+
+// The accounts of the dev chain, all of them have the key generated at start.
+const (
+	devAdmin      = "admin"
+	devProducer   = "producer000"
+	devFoundation = "foundation"
+)
+
+// SetDevMode turns the config into the one of a single node dev chain, which runs on an ephemeral db
+// with a new key for the admin, the only producer and the foundation. The blocks are produced on demand,
+// or every blockInterval without any tx if it is not 0.
+func SetDevMode(conf *common.Config, blockInterval time.Duration) error {
+	dir, err := ioutil.TempDir("", "iserver-dev")
+	if err != nil {
+		return fmt.Errorf("fail to create dev db dir: %v", err)
+	}
+	kp, err := account.NewKeyPair(nil, crypto.Ed25519)
+	if err != nil {
+		return err
+	}
+	conf.ACC = &common.ACCConfig{
+		ID:        devProducer,
+		SecKey:    common.Base58Encode(kp.Seckey),
+		Algorithm: crypto.Ed25519.String(),
+	}
+	conf.DB.LdbPath = dir + "/"
+	conf.P2P.SeedNodes = nil
+	conf.P2P.DataPath = filepath.Join(dir, "p2p")
+	conf.Snapshot = &common.SnapshotConfig{}
+	conf.Failover = nil
+	conf.Dev = &common.DevConfig{BlockInterval: int64(blockInterval / time.Millisecond)}
+
+	ilog.Infof("Dev mode, the db is at %v, account %v is prefunded, the secret key is %v",
+		dir, devAdmin, common.Base58Encode(kp.Seckey))
+	return nil
+}
+
+// devGenesisConfig returns the genesis of the dev chain, based on the genesis config with the accounts replaced.
+func devGenesisConfig(conf *common.Config) (*common.GenesisConfig, error) {
+	kp, err := account.NewKeyPair(common.Base58Decode(conf.ACC.SecKey), crypto.NewAlgorithm(conf.ACC.Algorithm))
+	if err != nil {
+		return nil, err
+	}
+	pubkey := kp.ReadablePubkey()
+	gConf := genesis.LoadGenesisConfig(conf.Genesis)
+	admin := gConf.AdminInfo
+	gConf.AdminInfo = &common.Witness{ID: devAdmin, Owner: pubkey, Active: pubkey, Balance: admin.Balance}
+	gConf.FoundationInfo = &common.Witness{ID: devFoundation, Owner: pubkey, Active: pubkey}
+	gConf.WitnessInfo = []*common.Witness{{ID: devProducer, Owner: pubkey, Active: pubkey, SignatureBlock: pubkey}}
+	gConf.WitnessNumber = 0
+	gConf.TokenInfo.FoundationAccount = devFoundation
+	gConf.InitialTimestamp = time.Now().UTC().Format(time.RFC3339)
+	return gConf, nil
+}
+
+// ResetDev removes the db of the dev chain and resets the chain time, it must be called after the iserver is stopped.
+func ResetDev(conf *common.Config) error {
+	if err := os.RemoveAll(conf.DB.LdbPath); err != nil {
+		return fmt.Errorf("fail to remove dev db: %v", err)
+	}
+	common.ResetTime()
+	return nil
+}
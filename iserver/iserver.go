@@ -4,6 +4,11 @@ import (
 	"github.com/iost-official/go-iost/account"
 	"github.com/iost-official/go-iost/common"
 	"github.com/iost-official/go-iost/consensus"
+	"github.com/iost-official/go-iost/consensus/cverifier"
+	"github.com/iost-official/go-iost/consensus/genesis"
+	"github.com/iost-official/go-iost/consensus/producerstats"
+	"github.com/iost-official/go-iost/consensus/snapshot"
+	"github.com/iost-official/go-iost/consensus/synchro"
 	"github.com/iost-official/go-iost/consensus/synchronizer"
 	"github.com/iost-official/go-iost/core/blockcache"
 	"github.com/iost-official/go-iost/core/global"
@@ -13,6 +18,7 @@ import (
 	"github.com/iost-official/go-iost/ilog"
 	"github.com/iost-official/go-iost/p2p"
 	"github.com/iost-official/go-iost/rpc"
+	"github.com/iost-official/go-iost/signer"
 )
 
 // Service defines APIs of resident goroutines.
@@ -25,7 +31,10 @@ type Service interface {
 type IServer struct {
 	bv        global.BaseVariable
 	p2p       *p2p.NetService
-	sync      *synchronizer.SyncImpl
+	p2pActive bool
+	sync      Service
+	snapshot  *snapshot.Server
+	stats     *producerstats.Tracker
 	txp       *txpool.TxPImpl
 	rpcServer *rpc.Server
 	consensus consensus.Consensus
@@ -35,46 +44,94 @@ type IServer struct {
 // New returns a iserver application
 func New(conf *common.Config) *IServer {
 	tx.ChainID = conf.P2P.ChainID
+	// The chain params are verified against the genesis block once the block chain is loaded.
+	gConf, err := genesisConfig(conf)
+	if err != nil {
+		ilog.Fatalf("load genesis config failed, stop the program! err:%v", err)
+	}
+	if err := common.SetChainParams(gConf); err != nil {
+		ilog.Fatalf("set chain params failed, stop the program! err:%v", err)
+	}
+	ilog.Infof("slot length:%vs, blocks per slot:%v", common.SlotLength, common.BlocksPerSlot)
+	if err := common.SetForks(conf.Fork); err != nil {
+		ilog.Fatalf("set forks failed, stop the program! err:%v", err)
+	}
+	ilog.Infof("state root fork height:%v", common.StateRootNumber)
 
 	bv, err := global.New(conf)
 	if err != nil {
 		ilog.Fatalf("create global failed. err=%v", err)
 	}
+
+	p2pService, err := p2p.NewNetService(conf.P2P)
+	if err != nil {
+		ilog.Fatalf("network initialization failed, stop the program! err:%v", err)
+	}
+
+	// Fast sync needs the network before the blockcache is created from the synced block.
+	p2pActive := false
+	if needFastSync(bv) {
+		if err := p2pService.Start(); err != nil {
+			ilog.Fatalf("network start failed, stop the program! err:%v", err)
+		}
+		p2pActive = true
+		if err := snapshot.FastSync(bv.StateDB(), bv.BlockChain(), p2pService,
+			conf.Snapshot.FastSyncPeers, common.Base58Decode(conf.Snapshot.FastSyncCheckpoint)); err != nil {
+			ilog.Fatalf("Fast sync failed: %v", err)
+		}
+	}
 	if err := checkGenesis(bv); err != nil {
 		ilog.Fatalf("Check genesis failed: %v", err)
 	}
+	if err := checkChainParams(bv); err != nil {
+		ilog.Fatalf("Check chain params failed: %v", err)
+	}
+	if err := checkForks(bv); err != nil {
+		ilog.Fatalf("Check forks failed: %v", err)
+	}
 	if err := recoverDB(bv); err != nil {
 		ilog.Fatalf("Recover DB failed: %v", err)
 	}
 
-	p2pService, err := p2p.NewNetService(conf.P2P)
+	sig, err := newSigner(conf.ACC)
 	if err != nil {
-		ilog.Fatalf("network initialization failed, stop the program! err:%v", err)
+		ilog.Fatalf("signer initialization failed, stop the program! err:%v", err)
 	}
 
-	accSecKey := conf.ACC.SecKey
-	acc, err := account.NewKeyPair(common.Base58Decode(accSecKey), crypto.NewAlgorithm(conf.ACC.Algorithm))
-	if err != nil {
-		ilog.Fatalf("NewKeyPair failed, stop the program! err:%v", err)
-	}
+	snapshotServer := snapshot.NewServer(bv.StateDB(), p2pService, conf.Snapshot.ServeInterval)
 
 	blkCache, err := blockcache.NewBlockCache(bv)
 	if err != nil {
 		ilog.Fatalf("blockcache initialization failed, stop the program! err:%v", err)
 	}
+	blkCache.AddFlushHook(snapshotServer.OnFlush)
+	blkCache.AddFlushHook(cverifier.NewStateHashPrecomputer(bv.StateDB()).OnFlush)
+
+	stats, err := producerstats.New(conf.DB.LdbPath+"ProducerStatsDB", blkCache)
+	if err != nil {
+		ilog.Fatalf("producer stats initialization failed, stop the program! err:%v", err)
+	}
+	blkCache.AddFlushHook(stats.OnFlush)
 
 	txp, err := txpool.NewTxPoolImpl(bv, blkCache, p2pService)
 	if err != nil {
 		ilog.Fatalf("txpool initialization failed, stop the program! err:%v", err)
 	}
 
-	consensus := consensus.New(consensus.Pob, acc, bv, blkCache, txp, p2pService)
+	consensus := consensus.New(consensus.Pob, sig, bv, blkCache, txp, p2pService, stats)
 
-	rpcServer := rpc.New(txp, blkCache, bv, p2pService)
+	rpcServer := rpc.New(txp, blkCache, bv, p2pService, stats)
 
-	sync, err := synchronizer.NewSynchronizer(bv, blkCache, p2pService)
-	if err != nil {
-		ilog.Fatalf("synchronizer initialization failed, stop the program! err:%v", err)
+	var sync Service
+	if conf.Consensus != nil && conf.Consensus.Synchronizer == "synchro" {
+		synchroSync := synchro.New(p2pService, blkCache, bv)
+		consensus.AddVerifyHook(synchroSync.OnVerify)
+		sync = synchroSync
+	} else {
+		sync, err = synchronizer.NewSynchronizer(bv, blkCache, p2pService)
+		if err != nil {
+			ilog.Fatalf("synchronizer initialization failed, stop the program! err:%v", err)
+		}
 	}
 
 	debug := NewDebugServer(conf.Debug, p2pService, blkCache, bv.BlockChain())
@@ -82,7 +139,10 @@ func New(conf *common.Config) *IServer {
 	return &IServer{
 		bv:        bv,
 		p2p:       p2pService,
+		p2pActive: p2pActive,
 		sync:      sync,
+		snapshot:  snapshotServer,
+		stats:     stats,
 		txp:       txp,
 		rpcServer: rpcServer,
 		consensus: consensus,
@@ -93,12 +153,15 @@ func New(conf *common.Config) *IServer {
 // Start starts iserver application.
 func (s *IServer) Start() error {
 	Services := []Service{
-		s.p2p,
 		s.sync,
+		s.snapshot,
 		s.txp,
 		s.consensus,
 		s.rpcServer,
 	}
+	if !s.p2pActive {
+		Services = append([]Service{s.p2p}, Services...)
+	}
 	for _, s := range Services {
 		if err := s.Start(); err != nil {
 			return err
@@ -123,12 +186,35 @@ func (s *IServer) Stop() {
 		s.rpcServer,
 		s.consensus,
 		s.txp,
+		s.snapshot,
 		s.sync,
 		s.p2p,
 	}
 	for _, s := range Services {
 		s.Stop()
 	}
+	s.stats.Close()
 	s.bv.BlockChain().Close()
 	s.bv.StateDB().Close()
 }
+
+// ResetRequested returns the channel notified when the chain of the dev mode is requested to reset by rpc.
+func (s *IServer) ResetRequested() <-chan struct{} {
+	return s.rpcServer.ResetRequested()
+}
+
+// newSigner returns the remote signer if configured, otherwise the local signer with the seckey.
+func newSigner(conf *common.ACCConfig) (signer.Signer, error) {
+	if conf.Signer != "" {
+		tlsConfig, err := signer.LoadTLSConfig(conf.SignerCert, conf.SignerKey, conf.SignerCA, false)
+		if err != nil {
+			return nil, err
+		}
+		return signer.NewRemote(conf.Signer, tlsConfig)
+	}
+	acc, err := account.NewKeyPair(common.Base58Decode(conf.SecKey), crypto.NewAlgorithm(conf.Algorithm))
+	if err != nil {
+		return nil, err
+	}
+	return signer.NewLocal(acc), nil
+}
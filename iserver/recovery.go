@@ -5,6 +5,7 @@ import (
 
 	"github.com/iost-official/go-iost/common"
 	"github.com/iost-official/go-iost/consensus/genesis"
+	"github.com/iost-official/go-iost/core/block"
 	"github.com/iost-official/go-iost/core/global"
 	"github.com/iost-official/go-iost/ilog"
 )
@@ -22,7 +23,11 @@ func checkGenesis(bv global.BaseVariable) error {
 			return fmt.Errorf("blockchaindb is empty, but statedb is not")
 		}
 
-		blk, err := genesis.GenGenesisByFile(stateDB, conf.Genesis)
+		gConf, err := genesisConfig(conf)
+		if err != nil {
+			return fmt.Errorf("load genesis config failed, stop the program. err: %v", err)
+		}
+		blk, err := genesis.GenGenesis(stateDB, gConf)
 		if err != nil {
 			return fmt.Errorf("new GenGenesis failed, stop the program. err: %v", err)
 		}
@@ -43,6 +48,53 @@ func checkGenesis(bv global.BaseVariable) error {
 	return nil
 }
 
+// checkChainParams checks that the chain params of the genesis config are the ones committed by the genesis block.
+// A fast synced node has no genesis block, its chain params are only checked by the blocks it syncs.
+func checkChainParams(bv global.BaseVariable) error {
+	blk, err := bv.BlockChain().GetBlockByNumber(0)
+	if err != nil {
+		ilog.Warnf("Genesis block not found, the chain params are not checked.")
+		return nil
+	}
+	return common.CheckChainParams(blk.Head.Info)
+}
+
+// checkForks checks that the saved blocks follow the fork heights, a fork must not be set below the blocks
+// produced without it, nor unset once the chain has passed it.
+func checkForks(bv global.BaseVariable) error {
+	blockChain := bv.BlockChain()
+	top, err := blockChain.GetBlockByNumber(blockChain.Length() - 1)
+	if err != nil {
+		return nil
+	}
+	if top.Head.Version >= block.V1 && (common.StateRootNumber == 0 || common.StateRootNumber > top.Head.Number) {
+		return fmt.Errorf("block %v commits to the state root, but the state root fork height is %v", top.Head.Number, common.StateRootNumber)
+	}
+	if common.StateRootNumber > 0 && common.StateRootNumber <= top.Head.Number {
+		blk, err := blockChain.GetBlockByNumber(common.StateRootNumber)
+		if err == nil && blk.Head.Version < block.V1 {
+			return fmt.Errorf("block %v does not commit to the state root, the state root fork height %v is below the chain", blk.Head.Number, common.StateRootNumber)
+		}
+	}
+	return nil
+}
+
+// genesisConfig returns the genesis config of the dev chain in the dev mode, otherwise the one in the genesis path.
+func genesisConfig(conf *common.Config) (*common.GenesisConfig, error) {
+	if conf.Dev != nil {
+		return devGenesisConfig(conf)
+	}
+	return genesis.LoadGenesisConfig(conf.Genesis), nil
+}
+
+// needFastSync returns whether the state should be fast synced from the neighbor nodes, which is only
+// possible for a new node.
+func needFastSync(bv global.BaseVariable) bool {
+	conf := bv.Config()
+	return conf.Snapshot.FastSync && !conf.Snapshot.Enable &&
+		bv.BlockChain().Length() == 0 && bv.StateDB().CurrentTag() == ""
+}
+
 func recoverDB(bv global.BaseVariable) error {
 	blockChain := bv.BlockChain()
 	stateDB := bv.StateDB()
@@ -142,7 +142,7 @@ func loopGetRAMInfo(it *itest.ITest, interval float64) {
 			ilog.Errorf("cannot get grpc client %v", err)
 			continue
 		}
-		_, err = c.GetRAMInfo(context.Background(), &rpcpb.EmptyRequest{})
+		_, err = c.GetRAMInfo(context.Background(), &rpcpb.GetRAMInfoRequest{})
 		if err != nil {
 			ilog.Errorf("cannot get ram info %v", err)
 		}
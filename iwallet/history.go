@@ -0,0 +1,56 @@
+// Copyright © 2018 NAME HERE <EMAIL ADDRESS>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package iwallet
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/iost-official/go-iost/sdk"
+)
+
+var historyLimit int32
+var historyCursor string
+
+// historyCmd represents the history command.
+var historyCmd = &cobra.Command{
+	Use:   "history accountName",
+	Short: "List transactions of an account",
+	Long: `List transactions involving an account in irreversible blocks, from the newest to the oldest
+The node must be started with the account index enabled (db.accountindex in iserver.yml)`,
+	Example: `  iwallet history test0
+  iwallet history test0 --limit 10 --cursor 3Fa8hZnWq8rW7sYFg`,
+	Args: func(cmd *cobra.Command, args []string) error {
+		if err := checkArgsNumber(cmd, args, "accountName"); err != nil {
+			return err
+		}
+		return nil
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ret, err := iwalletSDK.GetAccountTransactions(args[0], historyLimit, historyCursor)
+		if err != nil {
+			return err
+		}
+		fmt.Println(sdk.MarshalTextString(ret))
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(historyCmd)
+	historyCmd.Flags().Int32VarP(&historyLimit, "limit", "", 50, "max number of transactions to list")
+	historyCmd.Flags().StringVarP(&historyCursor, "cursor", "", "", "cursor of the page returned by the previous call")
+}
@@ -21,6 +21,7 @@ import (
 
 	"github.com/iost-official/go-iost/account"
 	"github.com/iost-official/go-iost/common"
+	"github.com/iost-official/go-iost/crypto/bls"
 	"github.com/spf13/cobra"
 )
 
@@ -30,22 +31,35 @@ type key struct {
 	Seckey    string
 }
 
+var blsKey bool
+
 // keyCmd represents the keyPair command
 var keyCmd = &cobra.Command{
-	Use:     "key",
-	Short:   "Create a key pair",
-	Long:    `Create a key pair`,
-	Example: `  iwallet key`,
+	Use:   "key",
+	Short: "Create a key pair",
+	Long:  `Create a key pair`,
+	Example: `  iwallet key
+  iwallet key --bls`,
 	RunE: func(cmd *cobra.Command, args []string) error {
-		n, err := account.NewKeyPair(nil, sdk.GetSignAlgoByName(signAlgo))
-		if err != nil {
-			return fmt.Errorf("failed to new key pair: %v", err)
-		}
-
 		var k key
-		k.Algorithm = n.Algorithm.String()
-		k.Pubkey = common.Base58Encode(n.Pubkey)
-		k.Seckey = common.Base58Encode(n.Seckey)
+		if blsKey {
+			seckey := bls.GenSeckey()
+			pubkey, err := bls.GetPubkey(seckey)
+			if err != nil {
+				return fmt.Errorf("failed to get bls pubkey: %v", err)
+			}
+			k.Algorithm = "bls12381"
+			k.Pubkey = common.Base58Encode(pubkey)
+			k.Seckey = common.Base58Encode(seckey)
+		} else {
+			n, err := account.NewKeyPair(nil, sdk.GetSignAlgoByName(signAlgo))
+			if err != nil {
+				return fmt.Errorf("failed to new key pair: %v", err)
+			}
+			k.Algorithm = n.Algorithm.String()
+			k.Pubkey = common.Base58Encode(n.Pubkey)
+			k.Seckey = common.Base58Encode(n.Seckey)
+		}
 
 		ret, err := json.MarshalIndent(k, "", "    ")
 		if err != nil {
@@ -58,4 +72,5 @@ var keyCmd = &cobra.Command{
 
 func init() {
 	rootCmd.AddCommand(keyCmd)
+	keyCmd.Flags().BoolVarP(&blsKey, "bls", "", false, "create a BLS key of a witness for the aggregate attestations, see blsseckey in iserver.yml")
 }
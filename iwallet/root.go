@@ -71,7 +71,7 @@ func init() {
 	rootCmd.PersistentFlags().BoolVarP(&checkResult, "check_result", "", true, "check publish/call status after sending to chain")
 	rootCmd.PersistentFlags().Float32VarP(&checkResultDelay, "check_result_delay", "", 3, "rpc checking will occur at [checkResultDelay] seconds after sending to chain.")
 	rootCmd.PersistentFlags().Int32VarP(&checkResultMaxRetry, "check_result_max_retry", "", 30, "max times to call grpc to check tx status")
-	rootCmd.PersistentFlags().StringVarP(&signAlgo, "sign_algo", "", "ed25519", "sign algorithm")
+	rootCmd.PersistentFlags().StringVarP(&signAlgo, "sign_algo", "", "ed25519", "sign algorithm (ed25519, secp256k1 or secp256r1)")
 	rootCmd.PersistentFlags().StringSliceVarP(&signers, "signers", "", []string{}, "additional signers")
 	rootCmd.PersistentFlags().Float64VarP(&gasLimit, "gas_limit", "l", 1000000, "gas limit for a transaction")
 	rootCmd.PersistentFlags().Float64VarP(&gasRatio, "gas_ratio", "p", 1.0, "gas ratio for a transaction")
@@ -81,6 +81,10 @@ func init() {
 	rootCmd.PersistentFlags().StringVarP(&txTime, "tx_time", "", "", fmt.Sprintf("use the special tx time instead of now, format: %v", time.Now().Format(time.RFC3339)))
 	rootCmd.PersistentFlags().Uint32VarP(&txTimeDelay, "tx_time_delay", "", 0, "delay the tx time from now")
 	rootCmd.PersistentFlags().StringVarP(&signPerm, "sign_permission", "", "active", "permission used to sign transactions")
+	rootCmd.PersistentFlags().StringVarP(&signerAddr, "signer", "", "", "address of the remote signer daemon used to sign transactions instead of the local key")
+	rootCmd.PersistentFlags().StringVarP(&signerCert, "signer_cert", "", "", "client certificate file to connect the remote signer daemon")
+	rootCmd.PersistentFlags().StringVarP(&signerKey, "signer_key", "", "", "key file of the client certificate to connect the remote signer daemon")
+	rootCmd.PersistentFlags().StringVarP(&signerCA, "signer_ca", "", "", "ca file of the remote signer daemon")
 	rootCmd.PersistentFlags().StringSliceVarP(&signKeyFiles, "sign_key_files", "", []string{}, "optional private key files used for signing, split by comma")
 	rootCmd.PersistentFlags().StringSliceVarP(&signatureFiles, "signature_files", "", []string{}, "optional signature files, split by comma")
 	rootCmd.PersistentFlags().StringVarP(&outputTxFile, "output", "o", "", "output json file to save transaction request")
@@ -125,6 +129,10 @@ var (
 	signAlgo    string
 	signers     []string
 	signPerm    string
+	signerAddr  string
+	signerCert  string
+	signerKey   string
+	signerCA    string
 
 	gasLimit     float64
 	gasRatio     float64
@@ -0,0 +1,80 @@
+// Copyright © 2018 NAME HERE <EMAIL ADDRESS>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package iwallet
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/iost-official/go-iost/sdk"
+)
+
+var tokenListLimit int32
+var tokenListCursor string
+
+// tokenCmd represents the token command.
+var tokenCmd = &cobra.Command{
+	Use:   "token",
+	Short: "Query tokens created by token.iost",
+	Long:  `Query tokens created by token.iost`,
+	Example: `  iwallet token info iost
+  iwallet token list`,
+}
+
+var tokenInfoCmd = &cobra.Command{
+	Use:     "info symbol",
+	Short:   "Show the metadata of a token",
+	Long:    `Show the issuer, supply, total supply, decimal, full name and transfer settings of a token`,
+	Example: `  iwallet token info iost`,
+	Args: func(cmd *cobra.Command, args []string) error {
+		if err := checkArgsNumber(cmd, args, "symbol"); err != nil {
+			return err
+		}
+		return nil
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		info, err := iwalletSDK.GetTokenInfo(args[0])
+		if err != nil {
+			return err
+		}
+		fmt.Println(sdk.MarshalTextString(info))
+		return nil
+	},
+}
+
+var tokenListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List tokens",
+	Long:  `List the metadata of the tokens created in irreversible blocks in the order of symbol`,
+	Example: `  iwallet token list
+  iwallet token list --limit 10 --cursor iost`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ret, err := iwalletSDK.ListTokens(tokenListLimit, tokenListCursor)
+		if err != nil {
+			return err
+		}
+		fmt.Println(sdk.MarshalTextString(ret))
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(tokenCmd)
+	tokenCmd.AddCommand(tokenInfoCmd)
+	tokenCmd.AddCommand(tokenListCmd)
+	tokenListCmd.Flags().Int32VarP(&tokenListLimit, "limit", "", 50, "max number of tokens to list")
+	tokenListCmd.Flags().StringVarP(&tokenListCursor, "cursor", "", "", "cursor of the page returned by the previous call")
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/iost-official/go-iost/crypto"
 	"github.com/iost-official/go-iost/rpc/pb"
 	"github.com/iost-official/go-iost/sdk"
+	"github.com/iost-official/go-iost/signer"
 )
 
 func errorWithHelp(cmd *cobra.Command, format string, a ...interface{}) error {
@@ -189,6 +190,8 @@ func GetSignAlgoByName(name string) crypto.Algorithm {
 		return crypto.Secp256k1
 	case "ed25519":
 		return crypto.Ed25519
+	case "secp256r1":
+		return crypto.Secp256r1
 	default:
 		return crypto.Ed25519
 	}
@@ -232,6 +235,21 @@ func InitAccount() error {
 
 // LoadAndSetAccountForSDK ...
 func LoadAndSetAccountForSDK(s *sdk.IOSTDevSDK) error {
+	if signerAddr != "" {
+		if accountName == "" {
+			return fmt.Errorf("you must provide account name")
+		}
+		tlsConfig, err := signer.LoadTLSConfig(signerCert, signerKey, signerCA, false)
+		if err != nil {
+			return err
+		}
+		r, err := signer.NewRemote(signerAddr, tlsConfig)
+		if err != nil {
+			return err
+		}
+		s.SetSigner(accountName, r)
+		return nil
+	}
 	a, err := loadAccountByName(accountName, true)
 	if err != nil {
 		return err
@@ -266,6 +284,9 @@ func SaveAccount(name string, kp *account.KeyPair) error {
 	if kp.Algorithm == crypto.Secp256k1 {
 		fileName += "_secp256k1"
 	}
+	if kp.Algorithm == crypto.Secp256r1 {
+		fileName += "_secp256r1"
+	}
 
 	pubfile, err := os.Create(fileName + ".pub")
 	if err != nil {
@@ -373,7 +394,7 @@ func ParseAmountLimit(limitStr string) ([]*rpcpb.AmountLimit, error) {
 }
 
 // ValidSignAlgos ...
-var ValidSignAlgos = []string{"ed25519", "secp256k1"}
+var ValidSignAlgos = []string{"ed25519", "secp256k1", "secp256r1"}
 
 func getAccountNameFromKeyPath(file string, suf string) (string, error) {
 	f := file
@@ -0,0 +1,262 @@
+// Package light implements a light client, which syncs only the heads of irreversible blocks
+// and checks the merkle proofs of txs and receipts against them offline.
+package light
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/iost-official/go-iost/common"
+	"github.com/iost-official/go-iost/consensus/cverifier"
+	"github.com/iost-official/go-iost/core/block"
+	"github.com/iost-official/go-iost/core/merkletree"
+	"github.com/iost-official/go-iost/core/tx"
+	rpcpb "github.com/iost-official/go-iost/rpc/pb"
+)
+
+var (
+	errNumber      = errors.New("wrong number")
+	errParentHash  = errors.New("wrong parent hash")
+	errOldBlk      = errors.New("block time older than parent block")
+	errFutureBlk   = errors.New("block from future")
+	errSlotBlocks  = errors.New("too many blocks in the slot")
+	errUnknownBlk  = errors.New("block not synced")
+	errBlockHash   = errors.New("block hash mismatch")
+	errMerkleProof = errors.New("wrong merkle proof")
+)
+
+// ErrUnknownWitness is returned when a block is produced by a witness out of the trusted list,
+// the witness list should be updated by an attestation then.
+var ErrUnknownWitness = errors.New("unknown witness")
+
+// ErrWrongWitness is returned when a block is produced by a trusted witness out of its slot,
+// either the witness list is outdated and should be updated by an attestation, or the block is forged.
+var ErrWrongWitness = errors.New("wrong witness of the slot")
+
+// Chain is the chain of block heads trusted by the light client.
+type Chain struct {
+	mu            sync.RWMutex
+	witnesses     []string
+	keys          map[string][]byte
+	headers       map[int64]*Header
+	top           *Header
+	topSlotBlocks int
+
+	slotLength    int64
+	blocksPerSlot int
+}
+
+// NewChain returns a chain which starts from the trusted checkpoint and witness list.
+// The witness of each slot is scheduled by the chain parameters set in common, see SetChainParams
+// for the chains with the parameters committed by their genesis blocks.
+func NewChain(checkpoint *Header, witnesses []string) *Chain {
+	return &Chain{
+		witnesses: witnesses,
+		keys:      make(map[string][]byte),
+		headers:   map[int64]*Header{checkpoint.Head.Number: checkpoint},
+		top:       checkpoint,
+		// the blocks of the slot before the checkpoint are unknown
+		topSlotBlocks: 1,
+		slotLength:    common.SlotLength,
+		blocksPerSlot: common.BlocksPerSlot,
+	}
+}
+
+// SetChainParams sets the slot length and the blocks per slot committed by the trusted genesis header.
+func (c *Chain) SetChainParams(genesis *Header) error {
+	slotLength, blocksPerSlot, err := common.DecodeChainParams(genesis.Head.Info)
+	if err != nil {
+		return err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.slotLength = slotLength
+	c.blocksPerSlot = blocksPerSlot
+	return nil
+}
+
+// Top returns the last synced header.
+func (c *Chain) Top() *Header {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.top
+}
+
+// Header returns the synced header by number.
+func (c *Chain) Header(number int64) (*Header, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	h, ok := c.headers[number]
+	return h, ok
+}
+
+// Witnesses returns the trusted witness list.
+func (c *Chain) Witnesses() []string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.witnesses
+}
+
+func isWitness(w string, witnessList []string) bool {
+	for _, v := range witnessList {
+		if v == w {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *Chain) slotOf(h *Header) int64 {
+	return h.Head.Time / 1e9 / c.slotLength
+}
+
+// AddHeader verifies the header as the child of the top, and appends it.
+// The header must be produced by the witness scheduled for its slot, as pob does, and a slot holds no more
+// than the blocks per slot, so that a single trusted witness can not forge a chain of headers.
+func (c *Chain) AddHeader(h *Header) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if h.Head.Number != c.top.Head.Number+1 {
+		return errNumber
+	}
+	if !bytes.Equal(h.Head.ParentHash, c.top.Hash()) {
+		return errParentHash
+	}
+	if h.Head.Time <= c.top.Head.Time {
+		return errOldBlk
+	}
+	if h.Head.Time > common.NowNano()+cverifier.MaxBlockTimeGap {
+		return errFutureBlk
+	}
+	if !isWitness(h.Head.Witness, c.witnesses) {
+		return ErrUnknownWitness
+	}
+	slot := c.slotOf(h)
+	if c.witnesses[slot%int64(len(c.witnesses))] != h.Head.Witness {
+		return ErrWrongWitness
+	}
+	slotBlocks := 1
+	if slot == c.slotOf(c.top) {
+		slotBlocks = c.topSlotBlocks + 1
+	}
+	if slotBlocks > c.blocksPerSlot {
+		return errSlotBlocks
+	}
+	if err := h.VerifySign(); err != nil {
+		return fmt.Errorf("block %v: %v", h.Head.Number, err)
+	}
+	c.headers[h.Head.Number] = h
+	c.top = h
+	c.topSlotBlocks = slotBlocks
+	return nil
+}
+
+// Sync fetches and appends the headers until the number.
+func (c *Chain) Sync(f Fetcher, to int64) error {
+	for number := c.Top().Head.Number + 1; number <= to; number++ {
+		h, err := f.FetchHeader(number)
+		if err != nil {
+			return fmt.Errorf("fail to fetch block %v: %v", number, err)
+		}
+		if err := c.AddHeader(h); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AddWitnessKey verifies the BLS key of the witness and keeps it to verify the aggregate attestations.
+func (c *Chain) AddWitnessKey(k *block.WitnessKey) error {
+	if err := k.Verify(); err != nil {
+		return err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.keys[k.Witness()] = k.Pubkey
+	return nil
+}
+
+// UpdateWitnesses replaces the trusted witness list by the one of the attestation,
+// which must be of a synced block and signed by more than 2/3 of the distinct trusted witnesses.
+// The keys of the voted witnesses must be added by AddWitnessKey before for an aggregate attestation.
+func (c *Chain) UpdateWitnesses(a *block.Attestation) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if a.IsAggregate() {
+		if err := a.VerifyAggregate(c.keys); err != nil {
+			return err
+		}
+	} else if err := a.Verify(); err != nil {
+		return err
+	}
+
+	h, ok := c.headers[a.Number]
+	if !ok {
+		return errUnknownBlk
+	}
+	if !bytes.Equal(h.Hash(), a.BlockHash) {
+		return errBlockHash
+	}
+	voted := make(map[string]bool)
+	for i, w := range a.Witnesses {
+		if a.Bitmap[i/8]&(1<<uint(i%8)) != 0 && isWitness(w, c.witnesses) {
+			voted[w] = true
+		}
+	}
+	count := len(voted)
+	if count < block.AttestationThreshold(len(c.witnesses)) {
+		return fmt.Errorf("votes of trusted witnesses not enough, got %v, need %v", count, block.AttestationThreshold(len(c.witnesses)))
+	}
+	c.witnesses = a.Witnesses
+	return nil
+}
+
+func (c *Chain) verifyHeader(bh *rpcpb.BlockHeader) (*Header, error) {
+	h, ok := c.Header(bh.GetNumber())
+	if !ok {
+		return nil, errUnknownBlk
+	}
+	if common.Base58Encode(h.Hash()) != bh.GetHash() {
+		return nil, errBlockHash
+	}
+	return h, nil
+}
+
+// VerifyTxProof checks that the tx is in a synced block by the response of GetTxProof.
+func (c *Chain) VerifyTxProof(p *rpcpb.TxProofResponse) error {
+	if p.GetHeader() == nil || p.GetProof() == nil {
+		return errMerkleProof
+	}
+	h, err := c.verifyHeader(p.Header)
+	if err != nil {
+		return err
+	}
+	if !merkletree.VerifyMerklePath(common.Base58Decode(p.TxHash), p.Proof.Index, h.Head.TxMerkleHash, p.Proof.Path) {
+		return errMerkleProof
+	}
+	return nil
+}
+
+// VerifyReceiptProof checks that the receipt is in a synced block by the response of GetReceiptProof,
+// and returns the verified receipt.
+func (c *Chain) VerifyReceiptProof(p *rpcpb.ReceiptProofResponse) (*tx.TxReceipt, error) {
+	if p.GetHeader() == nil || p.GetProof() == nil {
+		return nil, errMerkleProof
+	}
+	h, err := c.verifyHeader(p.Header)
+	if err != nil {
+		return nil, err
+	}
+	var r tx.TxReceipt
+	if err := r.Decode(p.Receipt); err != nil {
+		return nil, fmt.Errorf("fail to decode receipt: %v", err)
+	}
+	if !merkletree.VerifyMerklePath(r.Hash(), p.Proof.Index, h.Head.TxReceiptMerkleHash, p.Proof.Path) {
+		return nil, errMerkleProof
+	}
+	return &r, nil
+}
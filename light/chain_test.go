@@ -0,0 +1,245 @@
+package light
+
+import (
+	"testing"
+
+	"github.com/iost-official/go-iost/account"
+	"github.com/iost-official/go-iost/common"
+	"github.com/iost-official/go-iost/core/block"
+	"github.com/iost-official/go-iost/core/merkletree"
+	"github.com/iost-official/go-iost/core/tx"
+	"github.com/iost-official/go-iost/crypto"
+	"github.com/iost-official/go-iost/crypto/bls"
+	rpcpb "github.com/iost-official/go-iost/rpc/pb"
+	"github.com/iost-official/go-iost/signer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mapFetcher map[int64]*Header
+
+func (f mapFetcher) FetchHeader(number int64) (*Header, error) {
+	h, ok := f[number]
+	if !ok {
+		return nil, errUnknownBlk
+	}
+	return h, nil
+}
+
+var slotNano = common.SlotLength * 1e9
+
+func newHeader(kp *account.KeyPair, parent *Header, slot int64, txMerkleHash []byte, txReceiptMerkleHash []byte) *Header {
+	time := slot * slotNano
+	if time <= parent.Head.Time {
+		time = parent.Head.Time + 1
+	}
+	h := &Header{
+		Head: &block.BlockHead{
+			ParentHash:          parent.Hash(),
+			TxMerkleHash:        txMerkleHash,
+			TxReceiptMerkleHash: txReceiptMerkleHash,
+			Number:              parent.Head.Number + 1,
+			Witness:             kp.ReadablePubkey(),
+			Time:                time,
+		},
+	}
+	h.Sign = kp.Sign(h.Hash())
+	return h
+}
+
+func TestChain(t *testing.T) {
+	kps := make([]*account.KeyPair, 0)
+	witnesses := make([]string, 0)
+	for i := 0; i < 3; i++ {
+		kp, err := account.NewKeyPair(nil, crypto.Ed25519)
+		require.Nil(t, err)
+		kps = append(kps, kp)
+		witnesses = append(witnesses, kp.ReadablePubkey())
+	}
+
+	txHashes := [][]byte{[]byte("tx1"), []byte("tx2"), []byte("tx3")}
+	txTree := merkletree.MerkleTree{}
+	txTree.Build(txHashes)
+	receipts := []*tx.TxReceipt{tx.NewTxReceipt([]byte("tx1")), tx.NewTxReceipt([]byte("tx2")), tx.NewTxReceipt([]byte("tx3"))}
+	receiptTree := merkletree.TXRMerkleTree{}
+	receiptTree.Build(receipts)
+
+	// the witness of the slot is witnesses[slot%3]
+	slot := common.NowNano()/slotNano - 120
+	checkpoint := &Header{Head: &block.BlockHead{Number: 10, Time: slot * slotNano}}
+	f := mapFetcher{}
+	parent := checkpoint
+	for i := int64(11); i <= 13; i++ {
+		slot++
+		f[i] = newHeader(kps[slot%3], parent, slot, txTree.RootHash(), receiptTree.RootHash())
+		parent = f[i]
+	}
+	c := NewChain(checkpoint, witnesses)
+	require.Nil(t, c.Sync(f, 13))
+	assert.Equal(t, int64(13), c.Top().Head.Number)
+
+	kp, err := account.NewKeyPair(nil, crypto.Ed25519)
+	require.Nil(t, err)
+	assert.Equal(t, ErrUnknownWitness, c.AddHeader(newHeader(kp, c.Top(), slot+1, nil, nil)))
+	forged := newHeader(kps[(slot+1)%3], c.Top(), slot+1, nil, nil)
+	forged.Sign = kp.Sign(forged.Hash())
+	assert.NotNil(t, c.AddHeader(forged))
+	fork := newHeader(kps[(slot+1)%3], c.Top(), slot+1, nil, nil)
+	fork.Head.ParentHash = f[12].Hash()
+	fork.Sign = kps[(slot+1)%3].Sign(fork.Hash())
+	assert.Equal(t, errParentHash, c.AddHeader(fork))
+
+	header := &rpcpb.BlockHeader{Hash: common.Base58Encode(f[12].Hash()), Number: 12}
+	path, err := txTree.MerklePath(txHashes[1])
+	require.Nil(t, err)
+	txProof := &rpcpb.TxProofResponse{
+		Header: header,
+		TxHash: common.Base58Encode(txHashes[1]),
+		Proof:  &rpcpb.MerkleProof{Index: 1, Path: path},
+	}
+	assert.Nil(t, c.VerifyTxProof(txProof))
+	txProof.Proof.Index = 2
+	assert.Equal(t, errMerkleProof, c.VerifyTxProof(txProof))
+
+	path, err = receiptTree.MerklePath(receipts[2].Hash())
+	require.Nil(t, err)
+	receiptProof := &rpcpb.ReceiptProofResponse{
+		Header:  header,
+		Receipt: receipts[2].Encode(),
+		Proof:   &rpcpb.MerkleProof{Index: 2, Path: path},
+	}
+	r, err := c.VerifyReceiptProof(receiptProof)
+	require.Nil(t, err)
+	assert.Equal(t, receipts[2].TxHash, r.TxHash)
+	receiptProof.Header = &rpcpb.BlockHeader{Hash: common.Base58Encode(f[11].Hash()), Number: 12}
+	_, err = c.VerifyReceiptProof(receiptProof)
+	assert.Equal(t, errBlockHash, err)
+
+	newWitnesses := append([]string{kp.ReadablePubkey()}, witnesses...)
+	votes := make(map[string]*block.AttestationVote)
+	for _, k := range append(kps, kp) {
+		votes[k.ReadablePubkey()] = block.NewAttestationVote(13, f[13].Hash(), k)
+	}
+	a := block.NewAttestation(13, f[13].Hash(), newWitnesses, votes)
+	require.NotNil(t, a)
+
+	repeated := &block.Attestation{
+		Number:    13,
+		BlockHash: f[13].Hash(),
+		Witnesses: []string{kp.ReadablePubkey(), witnesses[0], witnesses[0], witnesses[0]},
+		Bitmap:    []byte{0x0e},
+	}
+	for i := 0; i < 3; i++ {
+		repeated.Signs = append(repeated.Signs, votes[witnesses[0]].Sign)
+	}
+	assert.NotNil(t, c.UpdateWitnesses(repeated))
+	assert.Equal(t, witnesses, c.Witnesses())
+
+	assert.Nil(t, c.UpdateWitnesses(a))
+	assert.Equal(t, newWitnesses, c.Witnesses())
+	// the witness of the slot is newWitnesses[slot%4] now
+	slot += 4 - slot%4
+	assert.Nil(t, c.AddHeader(newHeader(kp, c.Top(), slot, nil, nil)))
+}
+
+func TestChainSchedule(t *testing.T) {
+	kps := make([]*account.KeyPair, 0)
+	witnesses := make([]string, 0)
+	for i := 0; i < 3; i++ {
+		kp, err := account.NewKeyPair(nil, crypto.Ed25519)
+		require.Nil(t, err)
+		kps = append(kps, kp)
+		witnesses = append(witnesses, kp.ReadablePubkey())
+	}
+	slot := common.NowNano()/slotNano - 120
+	slot -= slot % 3
+	checkpoint := &Header{Head: &block.BlockHead{Number: 10, Time: slot * slotNano}}
+	c := NewChain(checkpoint, witnesses)
+
+	// a trusted witness signs the headers of the slots of the others
+	assert.Equal(t, ErrWrongWitness, c.AddHeader(newHeader(kps[0], c.Top(), slot+1, nil, nil)))
+	assert.Equal(t, ErrWrongWitness, c.AddHeader(newHeader(kps[0], c.Top(), slot+2, nil, nil)))
+
+	// no more than the blocks per slot in its own slot
+	for i := 0; i < common.BlocksPerSlot; i++ {
+		assert.Nil(t, c.AddHeader(newHeader(kps[1], c.Top(), slot+1, nil, nil)))
+	}
+	assert.Equal(t, errSlotBlocks, c.AddHeader(newHeader(kps[1], c.Top(), slot+1, nil, nil)))
+	assert.Nil(t, c.AddHeader(newHeader(kps[2], c.Top(), slot+2, nil, nil)))
+
+	// nor in a slot of the future
+	future := common.NowNano()/slotNano + 3
+	future += (3 - future%3) % 3
+	assert.Equal(t, errFutureBlk, c.AddHeader(newHeader(kps[0], c.Top(), future, nil, nil)))
+
+	// the slot length committed by the genesis block
+	require.Nil(t, c.SetChainParams(&Header{Head: &block.BlockHead{Info: []byte(`{"slot_length":6}`)}}))
+	long := (slot+2)/2 + 1
+	for long%3 != 1 {
+		long++
+	}
+	assert.Equal(t, ErrWrongWitness, c.AddHeader(newHeader(kps[2], c.Top(), long*2, nil, nil)))
+	assert.Nil(t, c.AddHeader(newHeader(kps[1], c.Top(), long*2, nil, nil)))
+}
+
+func TestUpdateWitnessesAggregate(t *testing.T) {
+	kps := make([]*account.KeyPair, 0)
+	witnesses := make([]string, 0)
+	for i := 0; i < 4; i++ {
+		kp, err := account.NewKeyPair(nil, crypto.Ed25519)
+		require.Nil(t, err)
+		kps = append(kps, kp)
+		witnesses = append(witnesses, kp.ReadablePubkey())
+	}
+	checkpoint := &Header{Head: &block.BlockHead{Number: 10, Time: common.NowNano()}}
+	c := NewChain(checkpoint, witnesses[:3])
+
+	keys := make([]*block.WitnessKey, 0)
+	votes := make(map[string]*block.AttestationVote)
+	for _, kp := range kps[:3] {
+		seckey := bls.GenSeckey()
+		key, err := block.NewWitnessKey(seckey, signer.NewLocal(kp).SignWitnessKey)
+		require.Nil(t, err)
+		keys = append(keys, key)
+		v := block.NewAttestationVote(10, checkpoint.Hash(), kp)
+		require.Nil(t, v.SignBLS(seckey, key))
+		votes[kp.ReadablePubkey()] = v
+	}
+	a := block.NewAggregateAttestation(10, checkpoint.Hash(), witnesses, votes)
+	require.NotNil(t, a)
+
+	// the keys come with the attestation from rpc
+	la := &rpcpb.LIBAttestation{
+		Number:    a.Number,
+		BlockHash: common.Base58Encode(a.BlockHash),
+		Witnesses: a.Witnesses,
+		Bitmap:    a.Bitmap,
+		Aggregate: a.Aggregate,
+	}
+	for _, k := range keys {
+		la.Keys = append(la.Keys, &rpcpb.WitnessKey{
+			Pubkey: k.Pubkey,
+			Proof:  k.Proof,
+			Signature: &rpcpb.Signature{
+				Algorithm: rpcpb.Signature_Algorithm(k.Sign.Algorithm),
+				Signature: k.Sign.Sig,
+				PublicKey: k.Sign.Pubkey,
+			},
+		})
+	}
+	a, keys = AttestationFromPb(la)
+
+	assert.NotNil(t, c.UpdateWitnesses(a))
+	forged := *keys[1]
+	forged.Sign = keys[0].Sign
+	assert.NotNil(t, c.AddWitnessKey(&forged))
+	for _, k := range keys[:2] {
+		require.Nil(t, c.AddWitnessKey(k))
+	}
+	assert.NotNil(t, c.UpdateWitnesses(a))
+	assert.Equal(t, witnesses[:3], c.Witnesses())
+
+	require.Nil(t, c.AddWitnessKey(keys[2]))
+	assert.Nil(t, c.UpdateWitnesses(a))
+	assert.Equal(t, witnesses, c.Witnesses())
+}
@@ -0,0 +1,118 @@
+package light
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/iost-official/go-iost/account"
+	"github.com/iost-official/go-iost/common"
+	"github.com/iost-official/go-iost/core/block"
+	"github.com/iost-official/go-iost/crypto"
+	rpcpb "github.com/iost-official/go-iost/rpc/pb"
+	"google.golang.org/grpc"
+)
+
+// Header is the block head with the signature of its producer.
+type Header struct {
+	Head *block.BlockHead
+	Sign *crypto.Signature
+}
+
+// Hash returns the block hash.
+func (h *Header) Hash() []byte {
+	return common.Sha3(h.Head.ToBytes())
+}
+
+// VerifySign verifies that the block is signed by its witness.
+func (h *Header) VerifySign() error {
+	if h.Sign == nil {
+		return errors.New("no signature")
+	}
+	if !h.Sign.Algorithm.Verify(h.Hash(), account.DecodePubkey(h.Head.Witness), h.Sign.Sig) {
+		return errors.New("wrong signature")
+	}
+	return nil
+}
+
+// HeaderFromPb converts the block header returned by rpc, and checks its hash.
+func HeaderFromPb(bh *rpcpb.BlockHeader) (*Header, error) {
+	h := &Header{
+		Head: &block.BlockHead{
+			Version:             bh.Version,
+			ParentHash:          common.Base58Decode(bh.ParentHash),
+			TxMerkleHash:        common.Base58Decode(bh.TxMerkleHash),
+			TxReceiptMerkleHash: common.Base58Decode(bh.TxReceiptMerkleHash),
+			Info:                bh.Info,
+			Number:              bh.Number,
+			Witness:             bh.Witness,
+			Time:                bh.Time,
+			StateRoot:           common.Base58Decode(bh.StateRoot),
+		},
+	}
+	if bh.Signature != nil {
+		h.Sign = &crypto.Signature{
+			Algorithm: crypto.Algorithm(bh.Signature.Algorithm),
+			Sig:       bh.Signature.Signature,
+			Pubkey:    bh.Signature.PublicKey,
+		}
+	}
+	if !bytes.Equal(h.Hash(), common.Base58Decode(bh.Hash)) {
+		return nil, fmt.Errorf("wrong hash of block %v", bh.Number)
+	}
+	return h, nil
+}
+
+// AttestationFromPb converts the attestation returned by rpc, with the BLS keys of the voted witnesses
+// for an aggregate attestation, which should be added to the chain by AddWitnessKey before it is used.
+func AttestationFromPb(la *rpcpb.LIBAttestation) (*block.Attestation, []*block.WitnessKey) {
+	a := &block.Attestation{
+		Number:    la.Number,
+		BlockHash: common.Base58Decode(la.BlockHash),
+		Witnesses: la.Witnesses,
+		Bitmap:    la.Bitmap,
+		Aggregate: la.Aggregate,
+	}
+	for _, s := range la.Signatures {
+		a.Signs = append(a.Signs, &crypto.Signature{
+			Algorithm: crypto.Algorithm(s.Algorithm),
+			Sig:       s.Signature,
+		})
+	}
+	keys := make([]*block.WitnessKey, 0, len(la.Keys))
+	for _, k := range la.Keys {
+		key := &block.WitnessKey{Pubkey: k.Pubkey, Proof: k.Proof}
+		if k.Signature != nil {
+			key.Sign = &crypto.Signature{
+				Algorithm: crypto.Algorithm(k.Signature.Algorithm),
+				Sig:       k.Signature.Signature,
+				Pubkey:    k.Signature.PublicKey,
+			}
+		}
+		keys = append(keys, key)
+	}
+	return a, keys
+}
+
+// Fetcher fetches the heads of irreversible blocks.
+type Fetcher interface {
+	FetchHeader(number int64) (*Header, error)
+}
+
+type rpcFetcher struct {
+	client rpcpb.ApiServiceClient
+}
+
+// NewRPCFetcher returns a fetcher which gets the block heads from the grpc server.
+func NewRPCFetcher(conn *grpc.ClientConn) Fetcher {
+	return &rpcFetcher{client: rpcpb.NewApiServiceClient(conn)}
+}
+
+func (f *rpcFetcher) FetchHeader(number int64) (*Header, error) {
+	bh, err := f.client.GetBlockHeader(context.Background(), &rpcpb.GetBlockHeaderRequest{Number: number})
+	if err != nil {
+		return nil, err
+	}
+	return HeaderFromPb(bh)
+}
@@ -52,6 +52,13 @@ const (
 	SyncBlockResponse
 	SyncHeight
 	PublishTx
+	LIBVote
+	CancelTx
+	SnapshotManifestRequest
+	SnapshotManifestResponse
+	SnapshotChunkRequest
+	SnapshotChunkResponse
+	DoubleSignEvidence
 
 	UrgentMessage = 1
 	NormalMessage = 2
@@ -81,6 +88,20 @@ func (m MessageType) String() string {
 		return "PublishTx"
 	case NewBlockHash:
 		return "NewBlockHash"
+	case LIBVote:
+		return "LIBVote"
+	case CancelTx:
+		return "CancelTx"
+	case SnapshotManifestRequest:
+		return "SnapshotManifestRequest"
+	case SnapshotManifestResponse:
+		return "SnapshotManifestResponse"
+	case SnapshotChunkRequest:
+		return "SnapshotChunkRequest"
+	case SnapshotChunkResponse:
+		return "SnapshotChunkResponse"
+	case DoubleSignEvidence:
+		return "DoubleSignEvidence"
 	default:
 		return "unknown_type:" + strconv.Itoa(int(m))
 	}
@@ -153,7 +174,8 @@ func (m *p2pMessage) data() ([]byte, error) {
 }
 
 func (m *p2pMessage) needDedup() bool {
-	return m.messageType() == PublishTx || m.messageType() == NewBlockHash
+	return m.messageType() == PublishTx || m.messageType() == NewBlockHash || m.messageType() == LIBVote || m.messageType() == CancelTx ||
+		m.messageType() == DoubleSignEvidence
 }
 
 func newP2PMessage(chainID uint32, messageType MessageType, version uint16, reserved uint32, data []byte) *p2pMessage {
@@ -1,24 +1,30 @@
 package rpc
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"math"
 	"reflect"
 	"sort"
+	"strings"
 	"time"
 
 	"github.com/iost-official/go-iost/vm"
 
 	"github.com/iost-official/go-iost/common"
 	"github.com/iost-official/go-iost/consensus/cverifier"
+	"github.com/iost-official/go-iost/consensus/producerstats"
 	"github.com/iost-official/go-iost/core/block"
 	"github.com/iost-official/go-iost/core/blockcache"
 	"github.com/iost-official/go-iost/core/event"
 	"github.com/iost-official/go-iost/core/global"
+	"github.com/iost-official/go-iost/core/merkletree"
 	"github.com/iost-official/go-iost/core/tx"
 	"github.com/iost-official/go-iost/core/txpool"
+	"github.com/iost-official/go-iost/crypto"
 	"github.com/iost-official/go-iost/ilog"
 	"github.com/iost-official/go-iost/p2p"
 	rpcpb "github.com/iost-official/go-iost/rpc/pb"
@@ -36,19 +42,23 @@ type APIService struct {
 	txpool     txpool.TxPool
 	blockchain block.Chain
 	bv         global.BaseVariable
+	stats      *producerstats.Tracker
 
-	quitCh chan struct{}
+	quitCh  chan struct{}
+	resetCh chan struct{}
 }
 
 // NewAPIService returns a new APIService instance.
-func NewAPIService(tp txpool.TxPool, bcache blockcache.BlockCache, bv global.BaseVariable, p2pService p2p.Service, quitCh chan struct{}) *APIService {
+func NewAPIService(tp txpool.TxPool, bcache blockcache.BlockCache, bv global.BaseVariable, p2pService p2p.Service, stats *producerstats.Tracker, quitCh chan struct{}, resetCh chan struct{}) *APIService {
 	return &APIService{
 		p2pService: p2pService,
 		txpool:     tp,
 		blockchain: bv.BlockChain(),
 		bc:         bcache,
 		bv:         bv,
+		stats:      stats,
 		quitCh:     quitCh,
+		resetCh:    resetCh,
 	}
 }
 
@@ -70,8 +80,8 @@ func (as *APIService) GetNodeInfo(context.Context, *rpcpb.EmptyRequest) (*rpcpb.
 }
 
 // GetRAMInfo returns the chain info.
-func (as *APIService) GetRAMInfo(context.Context, *rpcpb.EmptyRequest) (*rpcpb.RAMInfoResponse, error) {
-	dbVisitor, _, err := as.getStateDBVisitor(true)
+func (as *APIService) GetRAMInfo(ctx context.Context, req *rpcpb.GetRAMInfoRequest) (*rpcpb.RAMInfoResponse, error) {
+	dbVisitor, _, err := as.getStateDBVisitorAt(true, req)
 	if err != nil {
 		return nil, err
 	}
@@ -197,7 +207,7 @@ func (as *APIService) GetBlockByNumber(ctx context.Context, req *rpcpb.GetBlockB
 
 // GetAccount returns account information corresponding to the given account name.
 func (as *APIService) GetAccount(ctx context.Context, req *rpcpb.GetAccountRequest) (*rpcpb.Account, error) {
-	dbVisitor, _, err := as.getStateDBVisitor(req.ByLongestChain)
+	dbVisitor, blk, err := as.getStateDBVisitorAt(req.ByLongestChain, req)
 	if err != nil {
 		return nil, err
 	}
@@ -219,13 +229,7 @@ func (as *APIService) GetAccount(ctx context.Context, req *rpcpb.GetAccountReque
 	}
 
 	// pack gas information
-	var blkTime int64
-	if req.GetByLongestChain() {
-		blkTime = as.bc.Head().Head.Time
-	} else {
-		blkTime = as.bc.LinkedRoot().Head.Time
-	}
-	pGas := dbVisitor.PGasAtTime(req.GetName(), blkTime)
+	pGas := dbVisitor.PGasAtTime(req.GetName(), blk.Head.Time)
 	tGas := dbVisitor.TGas(req.GetName())
 	totalGas := pGas.Add(tGas)
 	gasLimit := dbVisitor.GasLimit(req.GetName())
@@ -268,7 +272,7 @@ func (as *APIService) GetAccount(ctx context.Context, req *rpcpb.GetAccountReque
 
 // GetTokenBalance returns contract information corresponding to the given contract ID.
 func (as *APIService) GetTokenBalance(ctx context.Context, req *rpcpb.GetTokenBalanceRequest) (*rpcpb.GetTokenBalanceResponse, error) {
-	dbVisitor, _, err := as.getStateDBVisitor(req.ByLongestChain)
+	dbVisitor, _, err := as.getStateDBVisitorAt(req.ByLongestChain, req)
 	if err != nil {
 		return nil, err
 	}
@@ -292,9 +296,52 @@ func (as *APIService) GetTokenBalance(ctx context.Context, req *rpcpb.GetTokenBa
 	}, nil
 }
 
+// GetTokenInfo returns the metadata of the token.
+func (as *APIService) GetTokenInfo(ctx context.Context, req *rpcpb.GetTokenInfoRequest) (*rpcpb.TokenInfo, error) {
+	dbVisitor, _, err := as.getStateDBVisitorAt(req.ByLongestChain, req)
+	if err != nil {
+		return nil, err
+	}
+	info := dbVisitor.TokenInfo(req.GetSymbol())
+	if info == nil {
+		return nil, errors.New("token not found")
+	}
+	return toPbTokenInfo(info), nil
+}
+
+// ListTokens returns the metadata of the tokens created in irreversible blocks in the order of symbol.
+func (as *APIService) ListTokens(ctx context.Context, req *rpcpb.ListTokensRequest) (*rpcpb.ListTokensResponse, error) {
+	limit := int(req.GetLimit())
+	if limit <= 0 {
+		limit = defaultTokenLimit
+	}
+	if limit > maxTokenLimit {
+		limit = maxTokenLimit
+	}
+	dbVisitor, _, err := as.getStateDBVisitor(req.ByLongestChain)
+	if err != nil {
+		return nil, err
+	}
+	ret := &rpcpb.ListTokensResponse{}
+	err = as.blockchain.IterateTokens(req.GetCursor(), func(symbol string) bool {
+		if len(ret.Tokens) == limit {
+			ret.NextCursor = symbol
+			return false
+		}
+		if info := dbVisitor.TokenInfo(symbol); info != nil {
+			ret.Tokens = append(ret.Tokens, toPbTokenInfo(info))
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
 // GetToken721Balance returns balance of account of an specific token721 token.
 func (as *APIService) GetToken721Balance(ctx context.Context, req *rpcpb.GetTokenBalanceRequest) (*rpcpb.GetToken721BalanceResponse, error) {
-	dbVisitor, _, err := as.getStateDBVisitor(req.ByLongestChain)
+	dbVisitor, _, err := as.getStateDBVisitorAt(req.ByLongestChain, req)
 	if err != nil {
 		return nil, err
 	}
@@ -398,7 +445,7 @@ func (as *APIService) GetProducerVoteInfo(ctx context.Context, req *rpcpb.GetPro
 
 // GetContractStorage returns contract storage corresponding to the given key and field.
 func (as *APIService) GetContractStorage(ctx context.Context, req *rpcpb.GetContractStorageRequest) (*rpcpb.GetContractStorageResponse, error) {
-	dbVisitor, bcn, err := as.getStateDBVisitor(req.ByLongestChain)
+	dbVisitor, blk, err := as.getStateDBVisitorAt(req.ByLongestChain, req)
 	if err != nil {
 		return nil, err
 	}
@@ -422,8 +469,8 @@ func (as *APIService) GetContractStorage(ctx context.Context, req *rpcpb.GetCont
 	}
 	return &rpcpb.GetContractStorageResponse{
 		Data:        data,
-		BlockHash:   common.Base58Encode(bcn.HeadHash()),
-		BlockNumber: bcn.Head.Number,
+		BlockHash:   common.Base58Encode(blk.HeadHash()),
+		BlockNumber: blk.Head.Number,
 	}, nil
 }
 
@@ -505,6 +552,397 @@ func (as *APIService) ExecTransaction(ctx context.Context, req *rpcpb.Transactio
 	return toPbTxReceipt(receipt), nil
 }
 
+const (
+	// maxEventBlockRange is the max block range of GetEvents without contract ids.
+	maxEventBlockRange = 1000
+	// maxEventLimit is the max number of events returned by GetEvents.
+	maxEventLimit = 1000
+	// defaultAccountTxLimit is the default number of txs returned by GetAccountTransactions.
+	defaultAccountTxLimit = 50
+	// maxAccountTxLimit is the max number of txs returned by GetAccountTransactions.
+	maxAccountTxLimit = 1000
+	// defaultPendingTxLimit is the default number of txs returned by GetPendingTxs.
+	defaultPendingTxLimit = 100
+	// maxPendingTxLimit is the max number of txs returned by GetPendingTxs.
+	maxPendingTxLimit = 1000
+	// defaultTokenLimit is the default number of tokens returned by ListTokens.
+	defaultTokenLimit = 50
+	// maxTokenLimit is the max number of tokens returned by ListTokens.
+	maxTokenLimit = 1000
+)
+
+// GetEvents returns the contract receipts in irreversible blocks matching the request.
+func (as *APIService) GetEvents(ctx context.Context, req *rpcpb.GetEventsRequest) (*rpcpb.GetEventsResponse, error) {
+	from, to := req.GetFromBlock(), req.GetToBlock()
+	if from < 0 || from > to {
+		return nil, fmt.Errorf("invalid block range [%d, %d]", from, to)
+	}
+	if lib := as.blockchain.Length() - 1; to > lib {
+		return nil, fmt.Errorf("block %d is not irreversible, last irreversible block is %d", to, lib)
+	}
+	limit := int(req.GetLimit())
+	if limit <= 0 || limit > maxEventLimit {
+		limit = maxEventLimit
+	}
+	match := func(r *tx.Receipt) bool {
+		return strings.HasPrefix(r.Content, req.GetContentPrefix())
+	}
+	events := make([]*rpcpb.ReceiptEvent, 0)
+
+	if len(req.GetContractIds()) == 0 {
+		if to-from >= maxEventBlockRange {
+			return nil, fmt.Errorf("block range should be less than %d without contract ids", maxEventBlockRange)
+		}
+		for n := from; n <= to && len(events) < limit; n++ {
+			blk, err := as.blockchain.GetBlockByNumber(n)
+			if err != nil {
+				return nil, err
+			}
+			for i, r := range blk.Receipts {
+				for _, rr := range r.Receipts {
+					if match(rr) && len(events) < limit {
+						events = append(events, toPbReceiptEvent(n, blk.Txs[i].Hash(), rr))
+					}
+				}
+			}
+		}
+		return &rpcpb.GetEventsResponse{Events: events}, nil
+	}
+
+	receipts := make(map[string]*tx.TxReceipt)
+	for _, id := range req.GetContractIds() {
+		count := 0
+		var iterErr error
+		err := as.blockchain.IterateContractReceipts(id, from, to, func(number int64, txHash []byte, index int32) bool {
+			r, ok := receipts[string(txHash)]
+			if !ok {
+				r, iterErr = as.blockchain.GetReceiptByTxHash(txHash)
+				if iterErr != nil {
+					return false
+				}
+				receipts[string(txHash)] = r
+			}
+			if int(index) >= len(r.Receipts) {
+				iterErr = fmt.Errorf("receipt %d of tx %s not found", index, common.Base58Encode(txHash))
+				return false
+			}
+			if match(r.Receipts[index]) {
+				events = append(events, toPbReceiptEvent(number, txHash, r.Receipts[index]))
+				count++
+			}
+			return count < limit
+		})
+		if err != nil {
+			return nil, err
+		}
+		if iterErr != nil {
+			return nil, iterErr
+		}
+	}
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].BlockNumber < events[j].BlockNumber
+	})
+	if len(events) > limit {
+		events = events[:limit]
+	}
+	return &rpcpb.GetEventsResponse{Events: events}, nil
+}
+
+// GetAccountTransactions returns the txs involving the account in irreversible blocks from the newest to the oldest.
+func (as *APIService) GetAccountTransactions(ctx context.Context, req *rpcpb.GetAccountTransactionsRequest) (*rpcpb.GetAccountTransactionsResponse, error) {
+	if req.GetName() == "" {
+		return nil, errors.New("account name is required")
+	}
+	limit := int(req.GetLimit())
+	if limit <= 0 {
+		limit = defaultAccountTxLimit
+	}
+	if limit > maxAccountTxLimit {
+		limit = maxAccountTxLimit
+	}
+	number, txIndex := int64(math.MaxInt64), int32(math.MaxInt32)
+	if req.GetCursor() != "" {
+		cursor := common.Base58Decode(req.GetCursor())
+		if len(cursor) != 12 {
+			return nil, fmt.Errorf("invalid cursor %v", req.GetCursor())
+		}
+		number, txIndex = common.BytesToInt64(cursor[:8]), common.BytesToInt32(cursor[8:])
+	}
+
+	ret := &rpcpb.GetAccountTransactionsResponse{}
+	var iterErr error
+	err := as.blockchain.IterateAccountTxs(req.GetName(), number, txIndex, func(number int64, txIndex int32, txHash []byte) bool {
+		if len(ret.Transactions) == limit {
+			ret.NextCursor = common.Base58Encode(append(common.Int64ToBytes(number), common.Int32ToBytes(txIndex)...))
+			return false
+		}
+		var t *tx.Tx
+		t, iterErr = as.blockchain.GetTx(txHash)
+		if iterErr != nil {
+			return false
+		}
+		var receipt *tx.TxReceipt
+		receipt, iterErr = as.blockchain.GetReceiptByTxHash(txHash)
+		if iterErr != nil {
+			return false
+		}
+		ret.Transactions = append(ret.Transactions, &rpcpb.AccountTransaction{
+			BlockNumber: number,
+			Transaction: toPbTx(t, receipt),
+		})
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	if iterErr != nil {
+		return nil, iterErr
+	}
+	return ret, nil
+}
+
+// GetBlockHeader returns the head of the irreversible block.
+func (as *APIService) GetBlockHeader(ctx context.Context, req *rpcpb.GetBlockHeaderRequest) (*rpcpb.BlockHeader, error) {
+	blk, err := as.blockchain.GetBlockByNumber(req.GetNumber())
+	if err != nil {
+		return nil, err
+	}
+	return toPbBlockHeader(blk), nil
+}
+
+// GetTxProof returns the merkle proof of the irreversible tx.
+func (as *APIService) GetTxProof(ctx context.Context, req *rpcpb.TxHashRequest) (*rpcpb.TxProofResponse, error) {
+	txHashBytes := common.Base58Decode(req.GetHash())
+	blk, err := as.blockchain.GetBlockByTxHash(txHashBytes)
+	if err != nil {
+		return nil, err
+	}
+	hashes := make([][]byte, 0, len(blk.Txs))
+	for _, t := range blk.Txs {
+		hashes = append(hashes, t.Hash())
+	}
+	m := merkletree.MerkleTree{}
+	m.Build(hashes)
+	proof, err := merkleProof(&m, txHashBytes)
+	if err != nil {
+		return nil, err
+	}
+	return &rpcpb.TxProofResponse{
+		Header: toPbBlockHeader(blk),
+		TxHash: req.GetHash(),
+		Proof:  proof,
+	}, nil
+}
+
+// GetReceiptProof returns the merkle proof of the receipt of the irreversible tx.
+func (as *APIService) GetReceiptProof(ctx context.Context, req *rpcpb.TxHashRequest) (*rpcpb.ReceiptProofResponse, error) {
+	txHashBytes := common.Base58Decode(req.GetHash())
+	blk, err := as.blockchain.GetBlockByTxHash(txHashBytes)
+	if err != nil {
+		return nil, err
+	}
+	var receipt *tx.TxReceipt
+	for _, r := range blk.Receipts {
+		if bytes.Equal(r.TxHash, txHashBytes) {
+			receipt = r
+			break
+		}
+	}
+	if receipt == nil {
+		return nil, errors.New("txreceipt not found")
+	}
+	m := merkletree.TXRMerkleTree{}
+	m.Build(blk.Receipts)
+	proof, err := merkleProof(m.Mt, receipt.Hash())
+	if err != nil {
+		return nil, err
+	}
+	return &rpcpb.ReceiptProofResponse{
+		Header:    toPbBlockHeader(blk),
+		Receipt:   receipt.Encode(),
+		TxReceipt: toPbTxReceipt(receipt),
+		Proof:     proof,
+	}, nil
+}
+
+func merkleProof(m *merkletree.MerkleTree, hash []byte) (*rpcpb.MerkleProof, error) {
+	index, err := m.MerkleIndex(hash)
+	if err != nil {
+		return nil, err
+	}
+	path, err := m.MerklePath(hash)
+	if err != nil {
+		return nil, err
+	}
+	return &rpcpb.MerkleProof{Index: index, Path: path}, nil
+}
+
+// CancelTransaction removes the pending tx from the txpool of the node and broadcasts the cancellation.
+func (as *APIService) CancelTransaction(ctx context.Context, req *rpcpb.CancelTransactionRequest) (*rpcpb.SendTransactionResponse, error) {
+	if req.GetSignature() == nil {
+		return nil, errors.New("signature is required")
+	}
+	sign := &crypto.Signature{
+		Algorithm: crypto.Algorithm(req.Signature.Algorithm),
+		Sig:       req.Signature.Signature,
+		Pubkey:    req.Signature.PublicKey,
+	}
+	err := as.txpool.CancelTx(common.Base58Decode(req.GetHash()), sign)
+	if err != nil {
+		return nil, err
+	}
+	return &rpcpb.SendTransactionResponse{Hash: req.GetHash()}, nil
+}
+
+func pendingTxFilter(req *rpcpb.GetPendingTxsRequest) func(t *tx.Tx) bool {
+	publisher, contractID := req.GetPublisher(), req.GetContractId()
+	return func(t *tx.Tx) bool {
+		if publisher != "" && t.Publisher != publisher {
+			return false
+		}
+		if contractID == "" {
+			return true
+		}
+		for _, a := range t.Actions {
+			if a.Contract == contractID {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// GetPendingTxs returns the pending txs matching the request in packing order.
+func (as *APIService) GetPendingTxs(ctx context.Context, req *rpcpb.GetPendingTxsRequest) (*rpcpb.GetPendingTxsResponse, error) {
+	limit := int(req.GetLimit())
+	if limit <= 0 {
+		limit = defaultPendingTxLimit
+	}
+	if limit > maxPendingTxLimit {
+		limit = maxPendingTxLimit
+	}
+	now := time.Now().UnixNano()
+	ret := &rpcpb.GetPendingTxsResponse{
+		Total: int64(as.txpool.PendingTxCount()),
+	}
+	for _, p := range as.txpool.PendingTxs(pendingTxFilter(req), limit) {
+		ret.Transactions = append(ret.Transactions, toPbPendingTx(p.Tx, int32(p.Rank), now))
+	}
+	return ret, nil
+}
+
+// GetPendingTxCount returns the number of pending txs.
+func (as *APIService) GetPendingTxCount(ctx context.Context, req *rpcpb.EmptyRequest) (*rpcpb.GetPendingTxCountResponse, error) {
+	return &rpcpb.GetPendingTxCountResponse{
+		Count: int64(as.txpool.PendingTxCount()),
+	}, nil
+}
+
+// SubscribePendingTxs streams the txs matching the request when they are accepted into the pending list.
+func (as *APIService) SubscribePendingTxs(req *rpcpb.GetPendingTxsRequest, res rpcpb.ApiService_SubscribePendingTxsServer) error {
+	filter := pendingTxFilter(req)
+	topics := []event.Topic{event.TxAccepted}
+	ec := event.GetCollector()
+	id := time.Now().UnixNano()
+	ch := ec.Subscribe(id, topics, nil)
+	defer ec.Unsubscribe(id, topics)
+
+	timeup := time.NewTimer(time.Hour)
+	for {
+		select {
+		case <-timeup.C:
+			return nil
+		case <-as.quitCh:
+			return nil
+		case <-res.Context().Done():
+			return res.Context().Err()
+		case ev := <-ch:
+			var data event.TxEventData
+			if err := json.Unmarshal([]byte(ev.Data), &data); err != nil {
+				continue
+			}
+			t, err := as.txpool.GetFromPending(common.Base58Decode(data.Hash))
+			if err != nil || !filter(t) {
+				continue
+			}
+			err = res.Send(toPbPendingTx(t, 0, time.Now().UnixNano()))
+			if err != nil {
+				ilog.Errorf("stream send failed. err=%v", err)
+				return err
+			}
+		}
+	}
+}
+
+// GetLIBAttestation returns the attestation of the irreversible block.
+func (as *APIService) GetLIBAttestation(ctx context.Context, req *rpcpb.GetLIBAttestationRequest) (*rpcpb.LIBAttestation, error) {
+	a, err := as.blockchain.GetAttestation(req.GetNumber())
+	if err != nil {
+		return nil, err
+	}
+	var keys []*block.WitnessKey
+	if a.IsAggregate() {
+		voted, err := a.Voted()
+		if err != nil {
+			return nil, err
+		}
+		for _, w := range voted {
+			k, err := as.blockchain.GetWitnessKey(w)
+			if err != nil {
+				return nil, fmt.Errorf("fail to get key of witness %v: %v", w, err)
+			}
+			keys = append(keys, k)
+		}
+	}
+	return toPbLIBAttestation(a, keys), nil
+}
+
+// GetProducerStats returns the reliability statistics of the producers.
+func (as *APIService) GetProducerStats(ctx context.Context, req *rpcpb.GetProducerStatsRequest) (*rpcpb.GetProducerStatsResponse, error) {
+	if as.stats == nil {
+		return nil, errors.New("producer stats is not enabled")
+	}
+	var all []*producerstats.Stats
+	if req.GetPubkey() != "" {
+		s := as.stats.Get(req.GetPubkey())
+		if s == nil {
+			return nil, fmt.Errorf("no stats of producer %v", req.GetPubkey())
+		}
+		all = []*producerstats.Stats{s}
+	} else {
+		all = as.stats.All()
+	}
+	res := &rpcpb.GetProducerStatsResponse{}
+	for _, s := range all {
+		res.Stats = append(res.Stats, toPbProducerStats(s))
+	}
+	return res, nil
+}
+
+// DevAdvanceTime advances the chain time of the dev mode.
+func (as *APIService) DevAdvanceTime(ctx context.Context, req *rpcpb.DevAdvanceTimeRequest) (*rpcpb.DevAdvanceTimeResponse, error) {
+	if as.bv.Config().Dev == nil {
+		return nil, errors.New("only available in the dev mode")
+	}
+	if req.GetSeconds() <= 0 {
+		return nil, fmt.Errorf("invalid seconds %v", req.GetSeconds())
+	}
+	now := common.AdvanceTime(time.Duration(req.GetSeconds()) * time.Second)
+	return &rpcpb.DevAdvanceTimeResponse{Time: now}, nil
+}
+
+// DevReset resets the chain of the dev mode, the node restarts from a new genesis block after the response.
+func (as *APIService) DevReset(ctx context.Context, req *rpcpb.EmptyRequest) (*rpcpb.DevResetResponse, error) {
+	if as.bv.Config().Dev == nil {
+		return nil, errors.New("only available in the dev mode")
+	}
+	select {
+	case as.resetCh <- struct{}{}:
+	default:
+	}
+	return &rpcpb.DevResetResponse{}, nil
+}
+
 // Subscribe used for event.
 func (as *APIService) Subscribe(req *rpcpb.SubscribeRequest, res rpcpb.ApiService_SubscribeServer) error {
 
@@ -516,6 +954,7 @@ func (as *APIService) Subscribe(req *rpcpb.SubscribeRequest, res rpcpb.ApiServic
 	if req.GetFilter() != nil {
 		filter = &event.Meta{
 			ContractID: req.GetFilter().GetContractId(),
+			TxHash:     req.GetFilter().GetTxHash(),
 		}
 	}
 
@@ -583,3 +1022,47 @@ func (as *APIService) getStateDBVisitor(longestChain bool) (*database.Visitor, *
 	}
 	return nil, nil, err
 }
+
+// blockSpecifier is implemented by the requests which can get data at a specified block.
+type blockSpecifier interface {
+	GetBlockNumber() int64
+	GetBlockHash() string
+	GetUseBlockNumber() bool
+}
+
+// getStateDBVisitorAt returns the state visitor of the block specified by hash or number.
+// It works as getStateDBVisitor if neither of them is specified.
+func (as *APIService) getStateDBVisitorAt(longestChain bool, req blockSpecifier) (*database.Visitor, *block.Block, error) {
+	number, hash := req.GetBlockNumber(), req.GetBlockHash()
+	if hash == "" && number <= 0 && !req.GetUseBlockNumber() {
+		db, bcn, err := as.getStateDBVisitor(longestChain)
+		if err != nil {
+			return nil, nil, err
+		}
+		return db, bcn.Block, nil
+	}
+	var (
+		blk *block.Block
+		err error
+	)
+	if hash != "" {
+		hashBytes := common.Base58Decode(hash)
+		blk, err = as.bc.GetBlockByHash(hashBytes)
+		if err != nil {
+			blk, err = as.blockchain.GetBlockByHash(hashBytes)
+		}
+	} else {
+		blk, err = as.bc.GetBlockByNumber(number)
+		if err != nil {
+			blk, err = as.blockchain.GetBlockByNumber(number)
+		}
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("block not found: %v", err)
+	}
+	db, err := as.getStateDBVisitorByHash(blk.HeadHash())
+	if err != nil {
+		return nil, nil, fmt.Errorf("state of block %d is not available: %v", blk.Head.Number, err)
+	}
+	return db, blk, nil
+}
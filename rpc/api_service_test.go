@@ -0,0 +1,153 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/iost-official/go-iost/common"
+	"github.com/iost-official/go-iost/core/block"
+	"github.com/iost-official/go-iost/core/blockcache"
+	core_mock "github.com/iost-official/go-iost/core/mocks"
+	"github.com/iost-official/go-iost/crypto"
+	db_mock "github.com/iost-official/go-iost/db/mocks"
+	rpcpb "github.com/iost-official/go-iost/rpc/pb"
+	"github.com/iost-official/go-iost/vm/database"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// fakeBlockCache serves the head, the linked root and the blocks by number, other methods are not used.
+type fakeBlockCache struct {
+	blockcache.BlockCache
+	head   *blockcache.BlockCacheNode
+	root   *blockcache.BlockCacheNode
+	blocks map[int64]*block.Block
+}
+
+func (bc *fakeBlockCache) Head() *blockcache.BlockCacheNode {
+	return bc.head
+}
+
+func (bc *fakeBlockCache) LinkedRoot() *blockcache.BlockCacheNode {
+	return bc.root
+}
+
+func (bc *fakeBlockCache) GetBlockByNumber(number int64) (*block.Block, error) {
+	if blk, ok := bc.blocks[number]; ok {
+		return blk, nil
+	}
+	return nil, errors.New("block not found")
+}
+
+func (bc *fakeBlockCache) GetBlockByHash(hash []byte) (*block.Block, error) {
+	for _, blk := range bc.blocks {
+		if string(blk.HeadHash()) == string(hash) {
+			return blk, nil
+		}
+	}
+	return nil, errors.New("block not found")
+}
+
+func newTestBlock(number int64) *block.Block {
+	blk := &block.Block{
+		Head: &block.BlockHead{
+			Version:    block.V0,
+			ParentHash: []byte("parent hash"),
+			Number:     number,
+			Witness:    "witness",
+		},
+		Sign: &crypto.Signature{},
+	}
+	blk.CalculateHeadHash()
+	return blk
+}
+
+// newTestAPIService returns an APIService whose state db reports the token issuer as
+// "issuer" + the number of the block checked out.
+func newTestAPIService(ctrl *gomock.Controller) *APIService {
+	blocks := make(map[int64]*block.Block)
+	issuers := make(map[string]string)
+	for i := int64(0); i < 3; i++ {
+		blocks[i] = newTestBlock(i)
+		issuers[string(blocks[i].HeadHash())] = "issuer" + string('0'+rune(i))
+	}
+	bc := &fakeBlockCache{
+		head:   &blockcache.BlockCacheNode{Block: blocks[2]},
+		root:   &blockcache.BlockCacheNode{Block: blocks[1]},
+		blocks: blocks,
+	}
+
+	var current string
+	stateDB := db_mock.NewMockMVCCDB(ctrl)
+	stateDB.EXPECT().Fork().Return(stateDB).AnyTimes()
+	stateDB.EXPECT().Checkout(gomock.Any()).DoAndReturn(func(hash string) bool {
+		current = hash
+		_, ok := issuers[hash]
+		return ok
+	}).AnyTimes()
+	stateDB.EXPECT().Get(database.StateTable, gomock.Any()).DoAndReturn(func(table string, key string) (string, error) {
+		switch {
+		case strings.HasSuffix(key, "-issuer"):
+			return database.MustMarshal(issuers[current]), nil
+		case strings.HasSuffix(key, "-decimal"):
+			return database.MustMarshal(int64(8)), nil
+		}
+		return "", nil
+	}).AnyTimes()
+
+	chain := core_mock.NewMockChain(ctrl)
+	chain.EXPECT().GetBlockByNumber(gomock.Any()).Return(nil, errors.New("block not found")).AnyTimes()
+	chain.EXPECT().GetBlockByHash(gomock.Any()).Return(nil, errors.New("block not found")).AnyTimes()
+	bv := core_mock.NewMockBaseVariable(ctrl)
+	bv.EXPECT().StateDB().Return(stateDB).AnyTimes()
+
+	return &APIService{
+		bc:         bc,
+		blockchain: chain,
+		bv:         bv,
+	}
+}
+
+func TestGetStateAtBlock(t *testing.T) {
+	Convey("test getting state at a specified block", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		as := newTestAPIService(ctrl)
+
+		Convey("latest block", func() {
+			info, err := as.GetTokenInfo(context.Background(), &rpcpb.GetTokenInfoRequest{Symbol: "iost", ByLongestChain: true})
+			So(err, ShouldBeNil)
+			So(info.Issuer, ShouldEqual, "issuer2")
+
+			info, err = as.GetTokenInfo(context.Background(), &rpcpb.GetTokenInfoRequest{Symbol: "iost"})
+			So(err, ShouldBeNil)
+			So(info.Issuer, ShouldEqual, "issuer1")
+		})
+
+		Convey("specific height", func() {
+			info, err := as.GetTokenInfo(context.Background(), &rpcpb.GetTokenInfoRequest{Symbol: "iost", ByLongestChain: true, BlockNumber: 1})
+			So(err, ShouldBeNil)
+			So(info.Issuer, ShouldEqual, "issuer1")
+
+			info, err = as.GetTokenInfo(context.Background(), &rpcpb.GetTokenInfoRequest{Symbol: "iost", ByLongestChain: true, UseBlockNumber: true})
+			So(err, ShouldBeNil)
+			So(info.Issuer, ShouldEqual, "issuer0")
+
+			_, err = as.GetTokenInfo(context.Background(), &rpcpb.GetTokenInfoRequest{Symbol: "iost", BlockNumber: 5})
+			So(err, ShouldNotBeNil)
+		})
+
+		Convey("specific hash", func() {
+			blk, _ := as.bc.GetBlockByNumber(0)
+			info, err := as.GetTokenInfo(context.Background(), &rpcpb.GetTokenInfoRequest{
+				Symbol:      "iost",
+				BlockNumber: 2,
+				BlockHash:   common.Base58Encode(blk.HeadHash()),
+			})
+			So(err, ShouldBeNil)
+			So(info.Issuer, ShouldEqual, "issuer0")
+		})
+	})
+}
@@ -5,12 +5,14 @@ import (
 
 	"github.com/iost-official/go-iost/account"
 	"github.com/iost-official/go-iost/common"
+	"github.com/iost-official/go-iost/consensus/producerstats"
 	"github.com/iost-official/go-iost/core/block"
 	"github.com/iost-official/go-iost/core/contract"
 	"github.com/iost-official/go-iost/core/tx"
 	"github.com/iost-official/go-iost/crypto"
 	"github.com/iost-official/go-iost/rpc/pb"
 	"github.com/iost-official/go-iost/verifier"
+	"github.com/iost-official/go-iost/vm/database"
 )
 
 func toPbAction(a *tx.Action) *rpcpb.Action {
@@ -72,6 +74,23 @@ func toPbTx(t *tx.Tx, tr *tx.TxReceipt) *rpcpb.Transaction {
 	return ret
 }
 
+func toPbPendingTx(t *tx.Tx, rank int32, now int64) *rpcpb.PendingTx {
+	status := "queued"
+	switch {
+	case !t.IsCreatedBefore(now):
+		status = "not_arrived"
+	case t.IsExpired(now) && !t.IsDefer():
+		status = "expired"
+	case t.GasLimit > common.MaxBlockGasLimit:
+		status = "exceed_block_gas_limit"
+	}
+	return &rpcpb.PendingTx{
+		Transaction: toPbTx(t, nil),
+		Rank:        rank,
+		Status:      status,
+	}
+}
+
 func toPbBlock(blk *block.Block, complete bool) *rpcpb.Block {
 	ret := &rpcpb.Block{
 		Hash:                common.Base58Encode(blk.HeadHash()),
@@ -84,6 +103,7 @@ func toPbBlock(blk *block.Block, complete bool) *rpcpb.Block {
 		Time:                blk.Head.Time,
 		GasUsage:            float64(blk.CalculateGasUsage()) / 100,
 		TxCount:             int64(len(blk.Txs)),
+		StateRoot:           common.Base58Encode(blk.Head.StateRoot),
 	}
 	var info verifier.Info
 	json.Unmarshal(blk.Head.Info, &info)
@@ -102,6 +122,29 @@ func toPbBlock(blk *block.Block, complete bool) *rpcpb.Block {
 	return ret
 }
 
+func toPbBlockHeader(blk *block.Block) *rpcpb.BlockHeader {
+	ret := &rpcpb.BlockHeader{
+		Hash:                common.Base58Encode(blk.HeadHash()),
+		Version:             blk.Head.Version,
+		ParentHash:          common.Base58Encode(blk.Head.ParentHash),
+		TxMerkleHash:        common.Base58Encode(blk.Head.TxMerkleHash),
+		TxReceiptMerkleHash: common.Base58Encode(blk.Head.TxReceiptMerkleHash),
+		Info:                blk.Head.Info,
+		Number:              blk.Head.Number,
+		Witness:             blk.Head.Witness,
+		Time:                blk.Head.Time,
+		StateRoot:           common.Base58Encode(blk.Head.StateRoot),
+	}
+	if blk.Sign != nil {
+		ret.Signature = &rpcpb.Signature{
+			Algorithm: rpcpb.Signature_Algorithm(blk.Sign.Algorithm),
+			Signature: blk.Sign.Sig,
+			PublicKey: blk.Sign.Pubkey,
+		}
+	}
+	return ret
+}
+
 func toPbItem(item *account.Item) *rpcpb.Account_Item {
 	return &rpcpb.Account_Item{
 		Id:         item.ID,
@@ -206,3 +249,66 @@ func toCoreTx(t *rpcpb.TransactionRequest) *tx.Tx {
 	}
 	return ret
 }
+
+func toPbReceiptEvent(number int64, txHash []byte, r *tx.Receipt) *rpcpb.ReceiptEvent {
+	return &rpcpb.ReceiptEvent{
+		BlockNumber: number,
+		TxHash:      common.Base58Encode(txHash),
+		ContractId:  r.ContractID(),
+		FuncName:    r.FuncName,
+		Content:     r.Content,
+	}
+}
+
+func toPbProducerStats(s *producerstats.Stats) *rpcpb.ProducerStats {
+	return &rpcpb.ProducerStats{
+		Pubkey:         s.Witness,
+		Produced:       s.Produced,
+		Missed:         s.Missed,
+		LastProduced:   s.LastProduced,
+		LastMissedSlot: s.LastMissedSlot,
+		AvgLatency:     s.AvgLatency(),
+	}
+}
+
+func toPbLIBAttestation(a *block.Attestation, keys []*block.WitnessKey) *rpcpb.LIBAttestation {
+	ret := &rpcpb.LIBAttestation{
+		Number:    a.Number,
+		BlockHash: common.Base58Encode(a.BlockHash),
+		Witnesses: a.Witnesses,
+		Bitmap:    a.Bitmap,
+		Aggregate: a.Aggregate,
+	}
+	for _, s := range a.Signs {
+		ret.Signatures = append(ret.Signatures, &rpcpb.Signature{
+			Algorithm: rpcpb.Signature_Algorithm(s.Algorithm),
+			Signature: s.Sig,
+		})
+	}
+	for _, k := range keys {
+		ret.Keys = append(ret.Keys, &rpcpb.WitnessKey{
+			Pubkey: k.Pubkey,
+			Proof:  k.Proof,
+			Signature: &rpcpb.Signature{
+				Algorithm: rpcpb.Signature_Algorithm(k.Sign.Algorithm),
+				Signature: k.Sign.Sig,
+				PublicKey: k.Sign.Pubkey,
+			},
+		})
+	}
+	return ret
+}
+
+func toPbTokenInfo(info *database.TokenInfo) *rpcpb.TokenInfo {
+	return &rpcpb.TokenInfo{
+		Symbol:                info.Symbol,
+		FullName:              info.FullName,
+		Issuer:                info.Issuer,
+		Supply:                (&common.Fixed{Value: info.Supply, Decimal: info.Decimal}).ToFloat(),
+		TotalSupply:           (&common.Fixed{Value: info.TotalSupply, Decimal: info.Decimal}).ToFloat(),
+		Decimal:               int32(info.Decimal),
+		CanTransfer:           info.CanTransfer,
+		OnlyIssuerCanTransfer: info.OnlyIssuerCanTransfer,
+		Paused:                info.Paused,
+	}
+}
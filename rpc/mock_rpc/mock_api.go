@@ -34,6 +34,45 @@ func (m *MockApiServiceServer) EXPECT() *MockApiServiceServerMockRecorder {
 	return m.recorder
 }
 
+// CancelTransaction mocks base method
+func (m *MockApiServiceServer) CancelTransaction(arg0 context.Context, arg1 *pb.CancelTransactionRequest) (*pb.SendTransactionResponse, error) {
+	ret := m.ctrl.Call(m, "CancelTransaction", arg0, arg1)
+	ret0, _ := ret[0].(*pb.SendTransactionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelTransaction indicates an expected call of CancelTransaction
+func (mr *MockApiServiceServerMockRecorder) CancelTransaction(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelTransaction", reflect.TypeOf((*MockApiServiceServer)(nil).CancelTransaction), arg0, arg1)
+}
+
+// DevAdvanceTime mocks base method
+func (m *MockApiServiceServer) DevAdvanceTime(arg0 context.Context, arg1 *pb.DevAdvanceTimeRequest) (*pb.DevAdvanceTimeResponse, error) {
+	ret := m.ctrl.Call(m, "DevAdvanceTime", arg0, arg1)
+	ret0, _ := ret[0].(*pb.DevAdvanceTimeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DevAdvanceTime indicates an expected call of DevAdvanceTime
+func (mr *MockApiServiceServerMockRecorder) DevAdvanceTime(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DevAdvanceTime", reflect.TypeOf((*MockApiServiceServer)(nil).DevAdvanceTime), arg0, arg1)
+}
+
+// DevReset mocks base method
+func (m *MockApiServiceServer) DevReset(arg0 context.Context, arg1 *pb.EmptyRequest) (*pb.DevResetResponse, error) {
+	ret := m.ctrl.Call(m, "DevReset", arg0, arg1)
+	ret0, _ := ret[0].(*pb.DevResetResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DevReset indicates an expected call of DevReset
+func (mr *MockApiServiceServerMockRecorder) DevReset(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DevReset", reflect.TypeOf((*MockApiServiceServer)(nil).DevReset), arg0, arg1)
+}
+
 // ExecTransaction mocks base method
 func (m *MockApiServiceServer) ExecTransaction(arg0 context.Context, arg1 *pb.TransactionRequest) (*pb.TxReceipt, error) {
 	ret := m.ctrl.Call(m, "ExecTransaction", arg0, arg1)
@@ -60,6 +99,19 @@ func (mr *MockApiServiceServerMockRecorder) GetAccount(arg0, arg1 interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccount", reflect.TypeOf((*MockApiServiceServer)(nil).GetAccount), arg0, arg1)
 }
 
+// GetAccountTransactions mocks base method
+func (m *MockApiServiceServer) GetAccountTransactions(arg0 context.Context, arg1 *pb.GetAccountTransactionsRequest) (*pb.GetAccountTransactionsResponse, error) {
+	ret := m.ctrl.Call(m, "GetAccountTransactions", arg0, arg1)
+	ret0, _ := ret[0].(*pb.GetAccountTransactionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountTransactions indicates an expected call of GetAccountTransactions
+func (mr *MockApiServiceServerMockRecorder) GetAccountTransactions(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountTransactions", reflect.TypeOf((*MockApiServiceServer)(nil).GetAccountTransactions), arg0, arg1)
+}
+
 // GetBlockByHash mocks base method
 func (m *MockApiServiceServer) GetBlockByHash(arg0 context.Context, arg1 *pb.GetBlockByHashRequest) (*pb.BlockResponse, error) {
 	ret := m.ctrl.Call(m, "GetBlockByHash", arg0, arg1)
@@ -86,6 +138,19 @@ func (mr *MockApiServiceServerMockRecorder) GetBlockByNumber(arg0, arg1 interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockByNumber", reflect.TypeOf((*MockApiServiceServer)(nil).GetBlockByNumber), arg0, arg1)
 }
 
+// GetBlockHeader mocks base method
+func (m *MockApiServiceServer) GetBlockHeader(arg0 context.Context, arg1 *pb.GetBlockHeaderRequest) (*pb.BlockHeader, error) {
+	ret := m.ctrl.Call(m, "GetBlockHeader", arg0, arg1)
+	ret0, _ := ret[0].(*pb.BlockHeader)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBlockHeader indicates an expected call of GetBlockHeader
+func (mr *MockApiServiceServerMockRecorder) GetBlockHeader(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockHeader", reflect.TypeOf((*MockApiServiceServer)(nil).GetBlockHeader), arg0, arg1)
+}
+
 // GetChainInfo mocks base method
 func (m *MockApiServiceServer) GetChainInfo(arg0 context.Context, arg1 *pb.EmptyRequest) (*pb.ChainInfoResponse, error) {
 	ret := m.ctrl.Call(m, "GetChainInfo", arg0, arg1)
@@ -138,6 +203,19 @@ func (mr *MockApiServiceServerMockRecorder) GetContractStorageFields(arg0, arg1
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetContractStorageFields", reflect.TypeOf((*MockApiServiceServer)(nil).GetContractStorageFields), arg0, arg1)
 }
 
+// GetEvents mocks base method
+func (m *MockApiServiceServer) GetEvents(arg0 context.Context, arg1 *pb.GetEventsRequest) (*pb.GetEventsResponse, error) {
+	ret := m.ctrl.Call(m, "GetEvents", arg0, arg1)
+	ret0, _ := ret[0].(*pb.GetEventsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEvents indicates an expected call of GetEvents
+func (mr *MockApiServiceServerMockRecorder) GetEvents(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEvents", reflect.TypeOf((*MockApiServiceServer)(nil).GetEvents), arg0, arg1)
+}
+
 // GetGasRatio mocks base method
 func (m *MockApiServiceServer) GetGasRatio(arg0 context.Context, arg1 *pb.EmptyRequest) (*pb.GasRatioResponse, error) {
 	ret := m.ctrl.Call(m, "GetGasRatio", arg0, arg1)
@@ -151,6 +229,19 @@ func (mr *MockApiServiceServerMockRecorder) GetGasRatio(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGasRatio", reflect.TypeOf((*MockApiServiceServer)(nil).GetGasRatio), arg0, arg1)
 }
 
+// GetLIBAttestation mocks base method
+func (m *MockApiServiceServer) GetLIBAttestation(arg0 context.Context, arg1 *pb.GetLIBAttestationRequest) (*pb.LIBAttestation, error) {
+	ret := m.ctrl.Call(m, "GetLIBAttestation", arg0, arg1)
+	ret0, _ := ret[0].(*pb.LIBAttestation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLIBAttestation indicates an expected call of GetLIBAttestation
+func (mr *MockApiServiceServerMockRecorder) GetLIBAttestation(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLIBAttestation", reflect.TypeOf((*MockApiServiceServer)(nil).GetLIBAttestation), arg0, arg1)
+}
+
 // GetNodeInfo mocks base method
 func (m *MockApiServiceServer) GetNodeInfo(arg0 context.Context, arg1 *pb.EmptyRequest) (*pb.NodeInfoResponse, error) {
 	ret := m.ctrl.Call(m, "GetNodeInfo", arg0, arg1)
@@ -164,6 +255,45 @@ func (mr *MockApiServiceServerMockRecorder) GetNodeInfo(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNodeInfo", reflect.TypeOf((*MockApiServiceServer)(nil).GetNodeInfo), arg0, arg1)
 }
 
+// GetPendingTxCount mocks base method
+func (m *MockApiServiceServer) GetPendingTxCount(arg0 context.Context, arg1 *pb.EmptyRequest) (*pb.GetPendingTxCountResponse, error) {
+	ret := m.ctrl.Call(m, "GetPendingTxCount", arg0, arg1)
+	ret0, _ := ret[0].(*pb.GetPendingTxCountResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPendingTxCount indicates an expected call of GetPendingTxCount
+func (mr *MockApiServiceServerMockRecorder) GetPendingTxCount(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPendingTxCount", reflect.TypeOf((*MockApiServiceServer)(nil).GetPendingTxCount), arg0, arg1)
+}
+
+// GetPendingTxs mocks base method
+func (m *MockApiServiceServer) GetPendingTxs(arg0 context.Context, arg1 *pb.GetPendingTxsRequest) (*pb.GetPendingTxsResponse, error) {
+	ret := m.ctrl.Call(m, "GetPendingTxs", arg0, arg1)
+	ret0, _ := ret[0].(*pb.GetPendingTxsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPendingTxs indicates an expected call of GetPendingTxs
+func (mr *MockApiServiceServerMockRecorder) GetPendingTxs(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPendingTxs", reflect.TypeOf((*MockApiServiceServer)(nil).GetPendingTxs), arg0, arg1)
+}
+
+// GetProducerStats mocks base method
+func (m *MockApiServiceServer) GetProducerStats(arg0 context.Context, arg1 *pb.GetProducerStatsRequest) (*pb.GetProducerStatsResponse, error) {
+	ret := m.ctrl.Call(m, "GetProducerStats", arg0, arg1)
+	ret0, _ := ret[0].(*pb.GetProducerStatsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProducerStats indicates an expected call of GetProducerStats
+func (mr *MockApiServiceServerMockRecorder) GetProducerStats(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProducerStats", reflect.TypeOf((*MockApiServiceServer)(nil).GetProducerStats), arg0, arg1)
+}
+
 // GetProducerVoteInfo mocks base method
 func (m *MockApiServiceServer) GetProducerVoteInfo(arg0 context.Context, arg1 *pb.GetProducerVoteInfoRequest) (*pb.GetProducerVoteInfoResponse, error) {
 	ret := m.ctrl.Call(m, "GetProducerVoteInfo", arg0, arg1)
@@ -178,7 +308,7 @@ func (mr *MockApiServiceServerMockRecorder) GetProducerVoteInfo(arg0, arg1 inter
 }
 
 // GetRAMInfo mocks base method
-func (m *MockApiServiceServer) GetRAMInfo(arg0 context.Context, arg1 *pb.EmptyRequest) (*pb.RAMInfoResponse, error) {
+func (m *MockApiServiceServer) GetRAMInfo(arg0 context.Context, arg1 *pb.GetRAMInfoRequest) (*pb.RAMInfoResponse, error) {
 	ret := m.ctrl.Call(m, "GetRAMInfo", arg0, arg1)
 	ret0, _ := ret[0].(*pb.RAMInfoResponse)
 	ret1, _ := ret[1].(error)
@@ -190,6 +320,19 @@ func (mr *MockApiServiceServerMockRecorder) GetRAMInfo(arg0, arg1 interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRAMInfo", reflect.TypeOf((*MockApiServiceServer)(nil).GetRAMInfo), arg0, arg1)
 }
 
+// GetReceiptProof mocks base method
+func (m *MockApiServiceServer) GetReceiptProof(arg0 context.Context, arg1 *pb.TxHashRequest) (*pb.ReceiptProofResponse, error) {
+	ret := m.ctrl.Call(m, "GetReceiptProof", arg0, arg1)
+	ret0, _ := ret[0].(*pb.ReceiptProofResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReceiptProof indicates an expected call of GetReceiptProof
+func (mr *MockApiServiceServerMockRecorder) GetReceiptProof(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReceiptProof", reflect.TypeOf((*MockApiServiceServer)(nil).GetReceiptProof), arg0, arg1)
+}
+
 // GetToken721Balance mocks base method
 func (m *MockApiServiceServer) GetToken721Balance(arg0 context.Context, arg1 *pb.GetTokenBalanceRequest) (*pb.GetToken721BalanceResponse, error) {
 	ret := m.ctrl.Call(m, "GetToken721Balance", arg0, arg1)
@@ -242,6 +385,19 @@ func (mr *MockApiServiceServerMockRecorder) GetTokenBalance(arg0, arg1 interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTokenBalance", reflect.TypeOf((*MockApiServiceServer)(nil).GetTokenBalance), arg0, arg1)
 }
 
+// GetTokenInfo mocks base method
+func (m *MockApiServiceServer) GetTokenInfo(arg0 context.Context, arg1 *pb.GetTokenInfoRequest) (*pb.TokenInfo, error) {
+	ret := m.ctrl.Call(m, "GetTokenInfo", arg0, arg1)
+	ret0, _ := ret[0].(*pb.TokenInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTokenInfo indicates an expected call of GetTokenInfo
+func (mr *MockApiServiceServerMockRecorder) GetTokenInfo(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTokenInfo", reflect.TypeOf((*MockApiServiceServer)(nil).GetTokenInfo), arg0, arg1)
+}
+
 // GetTxByHash mocks base method
 func (m *MockApiServiceServer) GetTxByHash(arg0 context.Context, arg1 *pb.TxHashRequest) (*pb.TransactionResponse, error) {
 	ret := m.ctrl.Call(m, "GetTxByHash", arg0, arg1)
@@ -255,6 +411,19 @@ func (mr *MockApiServiceServerMockRecorder) GetTxByHash(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTxByHash", reflect.TypeOf((*MockApiServiceServer)(nil).GetTxByHash), arg0, arg1)
 }
 
+// GetTxProof mocks base method
+func (m *MockApiServiceServer) GetTxProof(arg0 context.Context, arg1 *pb.TxHashRequest) (*pb.TxProofResponse, error) {
+	ret := m.ctrl.Call(m, "GetTxProof", arg0, arg1)
+	ret0, _ := ret[0].(*pb.TxProofResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTxProof indicates an expected call of GetTxProof
+func (mr *MockApiServiceServerMockRecorder) GetTxProof(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTxProof", reflect.TypeOf((*MockApiServiceServer)(nil).GetTxProof), arg0, arg1)
+}
+
 // GetTxReceiptByTxHash mocks base method
 func (m *MockApiServiceServer) GetTxReceiptByTxHash(arg0 context.Context, arg1 *pb.TxHashRequest) (*pb.TxReceipt, error) {
 	ret := m.ctrl.Call(m, "GetTxReceiptByTxHash", arg0, arg1)
@@ -268,6 +437,19 @@ func (mr *MockApiServiceServerMockRecorder) GetTxReceiptByTxHash(arg0, arg1 inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTxReceiptByTxHash", reflect.TypeOf((*MockApiServiceServer)(nil).GetTxReceiptByTxHash), arg0, arg1)
 }
 
+// ListTokens mocks base method
+func (m *MockApiServiceServer) ListTokens(arg0 context.Context, arg1 *pb.ListTokensRequest) (*pb.ListTokensResponse, error) {
+	ret := m.ctrl.Call(m, "ListTokens", arg0, arg1)
+	ret0, _ := ret[0].(*pb.ListTokensResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTokens indicates an expected call of ListTokens
+func (mr *MockApiServiceServerMockRecorder) ListTokens(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTokens", reflect.TypeOf((*MockApiServiceServer)(nil).ListTokens), arg0, arg1)
+}
+
 // SendTransaction mocks base method
 func (m *MockApiServiceServer) SendTransaction(arg0 context.Context, arg1 *pb.TransactionRequest) (*pb.SendTransactionResponse, error) {
 	ret := m.ctrl.Call(m, "SendTransaction", arg0, arg1)
@@ -292,3 +474,15 @@ func (m *MockApiServiceServer) Subscribe(arg0 *pb.SubscribeRequest, arg1 pb.ApiS
 func (mr *MockApiServiceServerMockRecorder) Subscribe(arg0, arg1 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockApiServiceServer)(nil).Subscribe), arg0, arg1)
 }
+
+// SubscribePendingTxs mocks base method
+func (m *MockApiServiceServer) SubscribePendingTxs(arg0 *pb.GetPendingTxsRequest, arg1 pb.ApiService_SubscribePendingTxsServer) error {
+	ret := m.ctrl.Call(m, "SubscribePendingTxs", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SubscribePendingTxs indicates an expected call of SubscribePendingTxs
+func (mr *MockApiServiceServerMockRecorder) SubscribePendingTxs(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribePendingTxs", reflect.TypeOf((*MockApiServiceServer)(nil).SubscribePendingTxs), arg0, arg1)
+}
@@ -76,7 +76,7 @@ func (x TxReceipt_StatusCode) String() string {
 }
 
 func (TxReceipt_StatusCode) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{6, 0}
+	return fileDescriptor_1b773bf3e696f610, []int{7, 0}
 }
 
 // The enumeration defines transaction status.
@@ -108,7 +108,7 @@ func (x TransactionResponse_Status) String() string {
 }
 
 func (TransactionResponse_Status) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{8, 0}
+	return fileDescriptor_1b773bf3e696f610, []int{9, 0}
 }
 
 // The enumeration defines the signature algorithm.
@@ -121,18 +121,22 @@ const (
 	Signature_SECP256K1 Signature_Algorithm = 1
 	// ed25519
 	Signature_ED25519 Signature_Algorithm = 2
+	// secp256r1 (NIST P-256)
+	Signature_SECP256R1 Signature_Algorithm = 3
 )
 
 var Signature_Algorithm_name = map[int32]string{
 	0: "UNKNOWN",
 	1: "SECP256K1",
 	2: "ED25519",
+	3: "SECP256R1",
 }
 
 var Signature_Algorithm_value = map[string]int32{
 	"UNKNOWN":   0,
 	"SECP256K1": 1,
 	"ED25519":   2,
+	"SECP256R1": 3,
 }
 
 func (x Signature_Algorithm) String() string {
@@ -140,7 +144,7 @@ func (x Signature_Algorithm) String() string {
 }
 
 func (Signature_Algorithm) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{9, 0}
+	return fileDescriptor_1b773bf3e696f610, []int{10, 0}
 }
 
 // The enumeration defines block status.
@@ -168,7 +172,7 @@ func (x BlockResponse_Status) String() string {
 }
 
 func (BlockResponse_Status) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{12, 0}
+	return fileDescriptor_1b773bf3e696f610, []int{13, 0}
 }
 
 type Event_Topic int32
@@ -178,16 +182,44 @@ const (
 	Event_CONTRACT_RECEIPT Event_Topic = 0
 	// contract event
 	Event_CONTRACT_EVENT Event_Topic = 1
+	// transaction accepted into the transaction pool
+	Event_TX_ACCEPTED Event_Topic = 2
+	// transaction packed in a block that has not been confirmed
+	Event_TX_PACKED Event_Topic = 3
+	// transaction packed in an irreversible block
+	Event_TX_IRREVERSIBLE Event_Topic = 4
+	// transaction dropped from the transaction pool, e.g. expired
+	Event_TX_DROPPED Event_Topic = 5
+	// new head of the longest chain
+	Event_NEW_HEAD Event_Topic = 6
+	// new last irreversible block
+	Event_NEW_LIB Event_Topic = 7
+	// head switched to another fork, with the reverted block hashes
+	Event_CHAIN_REORG Event_Topic = 8
 )
 
 var Event_Topic_name = map[int32]string{
 	0: "CONTRACT_RECEIPT",
 	1: "CONTRACT_EVENT",
+	2: "TX_ACCEPTED",
+	3: "TX_PACKED",
+	4: "TX_IRREVERSIBLE",
+	5: "TX_DROPPED",
+	6: "NEW_HEAD",
+	7: "NEW_LIB",
+	8: "CHAIN_REORG",
 }
 
 var Event_Topic_value = map[string]int32{
 	"CONTRACT_RECEIPT": 0,
 	"CONTRACT_EVENT":   1,
+	"TX_ACCEPTED":      2,
+	"TX_PACKED":        3,
+	"TX_IRREVERSIBLE":  4,
+	"TX_DROPPED":       5,
+	"NEW_HEAD":         6,
+	"NEW_LIB":          7,
+	"CHAIN_REORG":      8,
 }
 
 func (x Event_Topic) String() string {
@@ -195,7 +227,7 @@ func (x Event_Topic) String() string {
 }
 
 func (Event_Topic) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{37, 0}
+	return fileDescriptor_1b773bf3e696f610, []int{38, 0}
 }
 
 // The message defines an empty request.
@@ -280,6 +312,65 @@ func (m *NetworkInfo) GetPeerCount() int32 {
 	return 0
 }
 
+// The message defines get ram information request.
+type GetRAMInfoRequest struct {
+	// get data at the block with the given number, 0 means not specified unless use_block_number is set
+	BlockNumber int64 `protobuf:"varint,1,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	// get data at the block with the given hash, which takes precedence over block_number
+	BlockHash string `protobuf:"bytes,2,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	// use block_number even if it is 0, which is needed to get data at the genesis block
+	UseBlockNumber       bool     `protobuf:"varint,3,opt,name=use_block_number,json=useBlockNumber,proto3" json:"use_block_number,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetRAMInfoRequest) Reset()         { *m = GetRAMInfoRequest{} }
+func (m *GetRAMInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*GetRAMInfoRequest) ProtoMessage()    {}
+func (*GetRAMInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{2}
+}
+
+func (m *GetRAMInfoRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetRAMInfoRequest.Unmarshal(m, b)
+}
+func (m *GetRAMInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetRAMInfoRequest.Marshal(b, m, deterministic)
+}
+func (m *GetRAMInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetRAMInfoRequest.Merge(m, src)
+}
+func (m *GetRAMInfoRequest) XXX_Size() int {
+	return xxx_messageInfo_GetRAMInfoRequest.Size(m)
+}
+func (m *GetRAMInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetRAMInfoRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetRAMInfoRequest proto.InternalMessageInfo
+
+func (m *GetRAMInfoRequest) GetBlockNumber() int64 {
+	if m != nil {
+		return m.BlockNumber
+	}
+	return 0
+}
+
+func (m *GetRAMInfoRequest) GetBlockHash() string {
+	if m != nil {
+		return m.BlockHash
+	}
+	return ""
+}
+
+func (m *GetRAMInfoRequest) GetUseBlockNumber() bool {
+	if m != nil {
+		return m.UseBlockNumber
+	}
+	return false
+}
+
 // The message containing blockchain's ram information.
 type RAMInfoResponse struct {
 	// how many bytes have been used
@@ -301,7 +392,7 @@ func (m *RAMInfoResponse) Reset()         { *m = RAMInfoResponse{} }
 func (m *RAMInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*RAMInfoResponse) ProtoMessage()    {}
 func (*RAMInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{2}
+	return fileDescriptor_1b773bf3e696f610, []int{3}
 }
 
 func (m *RAMInfoResponse) XXX_Unmarshal(b []byte) error {
@@ -376,7 +467,7 @@ func (m *NodeInfoResponse) Reset()         { *m = NodeInfoResponse{} }
 func (m *NodeInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*NodeInfoResponse) ProtoMessage()    {}
 func (*NodeInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{3}
+	return fileDescriptor_1b773bf3e696f610, []int{4}
 }
 
 func (m *NodeInfoResponse) XXX_Unmarshal(b []byte) error {
@@ -440,7 +531,7 @@ func (m *AmountLimit) Reset()         { *m = AmountLimit{} }
 func (m *AmountLimit) String() string { return proto.CompactTextString(m) }
 func (*AmountLimit) ProtoMessage()    {}
 func (*AmountLimit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{4}
+	return fileDescriptor_1b773bf3e696f610, []int{5}
 }
 
 func (m *AmountLimit) XXX_Unmarshal(b []byte) error {
@@ -492,7 +583,7 @@ func (m *Action) Reset()         { *m = Action{} }
 func (m *Action) String() string { return proto.CompactTextString(m) }
 func (*Action) ProtoMessage()    {}
 func (*Action) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{5}
+	return fileDescriptor_1b773bf3e696f610, []int{6}
 }
 
 func (m *Action) XXX_Unmarshal(b []byte) error {
@@ -559,7 +650,7 @@ func (m *TxReceipt) Reset()         { *m = TxReceipt{} }
 func (m *TxReceipt) String() string { return proto.CompactTextString(m) }
 func (*TxReceipt) ProtoMessage()    {}
 func (*TxReceipt) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{6}
+	return fileDescriptor_1b773bf3e696f610, []int{7}
 }
 
 func (m *TxReceipt) XXX_Unmarshal(b []byte) error {
@@ -644,7 +735,7 @@ func (m *TxReceipt_Receipt) Reset()         { *m = TxReceipt_Receipt{} }
 func (m *TxReceipt_Receipt) String() string { return proto.CompactTextString(m) }
 func (*TxReceipt_Receipt) ProtoMessage()    {}
 func (*TxReceipt_Receipt) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{6, 1}
+	return fileDescriptor_1b773bf3e696f610, []int{7, 1}
 }
 
 func (m *TxReceipt_Receipt) XXX_Unmarshal(b []byte) error {
@@ -716,7 +807,7 @@ func (m *Transaction) Reset()         { *m = Transaction{} }
 func (m *Transaction) String() string { return proto.CompactTextString(m) }
 func (*Transaction) ProtoMessage()    {}
 func (*Transaction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{7}
+	return fileDescriptor_1b773bf3e696f610, []int{8}
 }
 
 func (m *Transaction) XXX_Unmarshal(b []byte) error {
@@ -843,7 +934,7 @@ func (m *TransactionResponse) Reset()         { *m = TransactionResponse{} }
 func (m *TransactionResponse) String() string { return proto.CompactTextString(m) }
 func (*TransactionResponse) ProtoMessage()    {}
 func (*TransactionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{8}
+	return fileDescriptor_1b773bf3e696f610, []int{9}
 }
 
 func (m *TransactionResponse) XXX_Unmarshal(b []byte) error {
@@ -895,7 +986,7 @@ func (m *Signature) Reset()         { *m = Signature{} }
 func (m *Signature) String() string { return proto.CompactTextString(m) }
 func (*Signature) ProtoMessage()    {}
 func (*Signature) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{9}
+	return fileDescriptor_1b773bf3e696f610, []int{10}
 }
 
 func (m *Signature) XXX_Unmarshal(b []byte) error {
@@ -972,7 +1063,7 @@ func (m *TransactionRequest) Reset()         { *m = TransactionRequest{} }
 func (m *TransactionRequest) String() string { return proto.CompactTextString(m) }
 func (*TransactionRequest) ProtoMessage()    {}
 func (*TransactionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{10}
+	return fileDescriptor_1b773bf3e696f610, []int{11}
 }
 
 func (m *TransactionRequest) XXX_Unmarshal(b []byte) error {
@@ -1102,17 +1193,19 @@ type Block struct {
 	// extra information
 	Info *Block_Info `protobuf:"bytes,11,opt,name=info,proto3" json:"info,omitempty"`
 	// block transactions
-	Transactions         []*Transaction `protobuf:"bytes,12,rep,name=transactions,proto3" json:"transactions,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+	Transactions []*Transaction `protobuf:"bytes,12,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	// state root committed since block version 1
+	StateRoot            string   `protobuf:"bytes,13,opt,name=state_root,json=stateRoot,proto3" json:"state_root,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Block) Reset()         { *m = Block{} }
 func (m *Block) String() string { return proto.CompactTextString(m) }
 func (*Block) ProtoMessage()    {}
 func (*Block) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{11}
+	return fileDescriptor_1b773bf3e696f610, []int{12}
 }
 
 func (m *Block) XXX_Unmarshal(b []byte) error {
@@ -1217,6 +1310,13 @@ func (m *Block) GetTransactions() []*Transaction {
 	return nil
 }
 
+func (m *Block) GetStateRoot() string {
+	if m != nil {
+		return m.StateRoot
+	}
+	return ""
+}
+
 // The message defines block extra information
 type Block_Info struct {
 	// pack mode
@@ -1234,7 +1334,7 @@ func (m *Block_Info) Reset()         { *m = Block_Info{} }
 func (m *Block_Info) String() string { return proto.CompactTextString(m) }
 func (*Block_Info) ProtoMessage()    {}
 func (*Block_Info) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{11, 0}
+	return fileDescriptor_1b773bf3e696f610, []int{12, 0}
 }
 
 func (m *Block_Info) XXX_Unmarshal(b []byte) error {
@@ -1290,7 +1390,7 @@ func (m *BlockResponse) Reset()         { *m = BlockResponse{} }
 func (m *BlockResponse) String() string { return proto.CompactTextString(m) }
 func (*BlockResponse) ProtoMessage()    {}
 func (*BlockResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{12}
+	return fileDescriptor_1b773bf3e696f610, []int{13}
 }
 
 func (m *BlockResponse) XXX_Unmarshal(b []byte) error {
@@ -1353,7 +1453,7 @@ func (m *ChainInfoResponse) Reset()         { *m = ChainInfoResponse{} }
 func (m *ChainInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*ChainInfoResponse) ProtoMessage()    {}
 func (*ChainInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{13}
+	return fileDescriptor_1b773bf3e696f610, []int{14}
 }
 
 func (m *ChainInfoResponse) XXX_Unmarshal(b []byte) error {
@@ -1450,7 +1550,7 @@ func (m *TxHashRequest) Reset()         { *m = TxHashRequest{} }
 func (m *TxHashRequest) String() string { return proto.CompactTextString(m) }
 func (*TxHashRequest) ProtoMessage()    {}
 func (*TxHashRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{14}
+	return fileDescriptor_1b773bf3e696f610, []int{15}
 }
 
 func (m *TxHashRequest) XXX_Unmarshal(b []byte) error {
@@ -1493,7 +1593,7 @@ func (m *GetBlockByHashRequest) Reset()         { *m = GetBlockByHashRequest{} }
 func (m *GetBlockByHashRequest) String() string { return proto.CompactTextString(m) }
 func (*GetBlockByHashRequest) ProtoMessage()    {}
 func (*GetBlockByHashRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{15}
+	return fileDescriptor_1b773bf3e696f610, []int{16}
 }
 
 func (m *GetBlockByHashRequest) XXX_Unmarshal(b []byte) error {
@@ -1543,7 +1643,7 @@ func (m *GetBlockByNumberRequest) Reset()         { *m = GetBlockByNumberRequest
 func (m *GetBlockByNumberRequest) String() string { return proto.CompactTextString(m) }
 func (*GetBlockByNumberRequest) ProtoMessage()    {}
 func (*GetBlockByNumberRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{16}
+	return fileDescriptor_1b773bf3e696f610, []int{17}
 }
 
 func (m *GetBlockByNumberRequest) XXX_Unmarshal(b []byte) error {
@@ -1593,7 +1693,7 @@ func (m *FrozenBalance) Reset()         { *m = FrozenBalance{} }
 func (m *FrozenBalance) String() string { return proto.CompactTextString(m) }
 func (*FrozenBalance) ProtoMessage()    {}
 func (*FrozenBalance) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{17}
+	return fileDescriptor_1b773bf3e696f610, []int{18}
 }
 
 func (m *FrozenBalance) XXX_Unmarshal(b []byte) error {
@@ -1645,7 +1745,7 @@ func (m *VoteInfo) Reset()         { *m = VoteInfo{} }
 func (m *VoteInfo) String() string { return proto.CompactTextString(m) }
 func (*VoteInfo) ProtoMessage()    {}
 func (*VoteInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{18}
+	return fileDescriptor_1b773bf3e696f610, []int{19}
 }
 
 func (m *VoteInfo) XXX_Unmarshal(b []byte) error {
@@ -1701,7 +1801,7 @@ func (m *GetProducerVoteInfoRequest) Reset()         { *m = GetProducerVoteInfoR
 func (m *GetProducerVoteInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*GetProducerVoteInfoRequest) ProtoMessage()    {}
 func (*GetProducerVoteInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{19}
+	return fileDescriptor_1b773bf3e696f610, []int{20}
 }
 
 func (m *GetProducerVoteInfoRequest) XXX_Unmarshal(b []byte) error {
@@ -1762,7 +1862,7 @@ func (m *GetProducerVoteInfoResponse) Reset()         { *m = GetProducerVoteInfo
 func (m *GetProducerVoteInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*GetProducerVoteInfoResponse) ProtoMessage()    {}
 func (*GetProducerVoteInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{20}
+	return fileDescriptor_1b773bf3e696f610, []int{21}
 }
 
 func (m *GetProducerVoteInfoResponse) XXX_Unmarshal(b []byte) error {
@@ -1853,7 +1953,7 @@ func (m *GasRatioResponse) Reset()         { *m = GasRatioResponse{} }
 func (m *GasRatioResponse) String() string { return proto.CompactTextString(m) }
 func (*GasRatioResponse) ProtoMessage()    {}
 func (*GasRatioResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{21}
+	return fileDescriptor_1b773bf3e696f610, []int{22}
 }
 
 func (m *GasRatioResponse) XXX_Unmarshal(b []byte) error {
@@ -1915,7 +2015,7 @@ func (m *Account) Reset()         { *m = Account{} }
 func (m *Account) String() string { return proto.CompactTextString(m) }
 func (*Account) ProtoMessage()    {}
 func (*Account) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{22}
+	return fileDescriptor_1b773bf3e696f610, []int{23}
 }
 
 func (m *Account) XXX_Unmarshal(b []byte) error {
@@ -2007,7 +2107,7 @@ func (m *Account_PledgeInfo) Reset()         { *m = Account_PledgeInfo{} }
 func (m *Account_PledgeInfo) String() string { return proto.CompactTextString(m) }
 func (*Account_PledgeInfo) ProtoMessage()    {}
 func (*Account_PledgeInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{22, 0}
+	return fileDescriptor_1b773bf3e696f610, []int{23, 0}
 }
 
 func (m *Account_PledgeInfo) XXX_Unmarshal(b []byte) error {
@@ -2063,7 +2163,7 @@ func (m *Account_GasInfo) Reset()         { *m = Account_GasInfo{} }
 func (m *Account_GasInfo) String() string { return proto.CompactTextString(m) }
 func (*Account_GasInfo) ProtoMessage()    {}
 func (*Account_GasInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{22, 1}
+	return fileDescriptor_1b773bf3e696f610, []int{23, 1}
 }
 
 func (m *Account_GasInfo) XXX_Unmarshal(b []byte) error {
@@ -2143,7 +2243,7 @@ func (m *Account_RAMInfo) Reset()         { *m = Account_RAMInfo{} }
 func (m *Account_RAMInfo) String() string { return proto.CompactTextString(m) }
 func (*Account_RAMInfo) ProtoMessage()    {}
 func (*Account_RAMInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{22, 2}
+	return fileDescriptor_1b773bf3e696f610, []int{23, 2}
 }
 
 func (m *Account_RAMInfo) XXX_Unmarshal(b []byte) error {
@@ -2204,7 +2304,7 @@ func (m *Account_Item) Reset()         { *m = Account_Item{} }
 func (m *Account_Item) String() string { return proto.CompactTextString(m) }
 func (*Account_Item) ProtoMessage()    {}
 func (*Account_Item) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{22, 3}
+	return fileDescriptor_1b773bf3e696f610, []int{23, 3}
 }
 
 func (m *Account_Item) XXX_Unmarshal(b []byte) error {
@@ -2268,7 +2368,7 @@ func (m *Account_Group) Reset()         { *m = Account_Group{} }
 func (m *Account_Group) String() string { return proto.CompactTextString(m) }
 func (*Account_Group) ProtoMessage()    {}
 func (*Account_Group) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{22, 4}
+	return fileDescriptor_1b773bf3e696f610, []int{23, 4}
 }
 
 func (m *Account_Group) XXX_Unmarshal(b []byte) error {
@@ -2322,7 +2422,7 @@ func (m *Account_Permission) Reset()         { *m = Account_Permission{} }
 func (m *Account_Permission) String() string { return proto.CompactTextString(m) }
 func (*Account_Permission) ProtoMessage()    {}
 func (*Account_Permission) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{22, 5}
+	return fileDescriptor_1b773bf3e696f610, []int{23, 5}
 }
 
 func (m *Account_Permission) XXX_Unmarshal(b []byte) error {
@@ -2376,7 +2476,13 @@ type GetAccountRequest struct {
 	// account name
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	// get account by longest chain's head block or last irreversible block
-	ByLongestChain       bool     `protobuf:"varint,2,opt,name=by_longest_chain,json=byLongestChain,proto3" json:"by_longest_chain,omitempty"`
+	ByLongestChain bool `protobuf:"varint,2,opt,name=by_longest_chain,json=byLongestChain,proto3" json:"by_longest_chain,omitempty"`
+	// get data at the block with the given number, 0 means not specified unless use_block_number is set
+	BlockNumber int64 `protobuf:"varint,3,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	// get data at the block with the given hash, which takes precedence over block_number
+	BlockHash string `protobuf:"bytes,4,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	// use block_number even if it is 0, which is needed to get data at the genesis block
+	UseBlockNumber       bool     `protobuf:"varint,5,opt,name=use_block_number,json=useBlockNumber,proto3" json:"use_block_number,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -2386,7 +2492,7 @@ func (m *GetAccountRequest) Reset()         { *m = GetAccountRequest{} }
 func (m *GetAccountRequest) String() string { return proto.CompactTextString(m) }
 func (*GetAccountRequest) ProtoMessage()    {}
 func (*GetAccountRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{23}
+	return fileDescriptor_1b773bf3e696f610, []int{24}
 }
 
 func (m *GetAccountRequest) XXX_Unmarshal(b []byte) error {
@@ -2421,6 +2527,27 @@ func (m *GetAccountRequest) GetByLongestChain() bool {
 	return false
 }
 
+func (m *GetAccountRequest) GetBlockNumber() int64 {
+	if m != nil {
+		return m.BlockNumber
+	}
+	return 0
+}
+
+func (m *GetAccountRequest) GetBlockHash() string {
+	if m != nil {
+		return m.BlockHash
+	}
+	return ""
+}
+
+func (m *GetAccountRequest) GetUseBlockNumber() bool {
+	if m != nil {
+		return m.UseBlockNumber
+	}
+	return false
+}
+
 // The message defines the contract struct.
 type Contract struct {
 	// contract id
@@ -2442,7 +2569,7 @@ func (m *Contract) Reset()         { *m = Contract{} }
 func (m *Contract) String() string { return proto.CompactTextString(m) }
 func (*Contract) ProtoMessage()    {}
 func (*Contract) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{24}
+	return fileDescriptor_1b773bf3e696f610, []int{25}
 }
 
 func (m *Contract) XXX_Unmarshal(b []byte) error {
@@ -2515,7 +2642,7 @@ func (m *Contract_ABI) Reset()         { *m = Contract_ABI{} }
 func (m *Contract_ABI) String() string { return proto.CompactTextString(m) }
 func (*Contract_ABI) ProtoMessage()    {}
 func (*Contract_ABI) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{24, 0}
+	return fileDescriptor_1b773bf3e696f610, []int{25, 0}
 }
 
 func (m *Contract_ABI) XXX_Unmarshal(b []byte) error {
@@ -2572,7 +2699,7 @@ func (m *GetContractRequest) Reset()         { *m = GetContractRequest{} }
 func (m *GetContractRequest) String() string { return proto.CompactTextString(m) }
 func (*GetContractRequest) ProtoMessage()    {}
 func (*GetContractRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{25}
+	return fileDescriptor_1b773bf3e696f610, []int{26}
 }
 
 func (m *GetContractRequest) XXX_Unmarshal(b []byte) error {
@@ -2616,7 +2743,13 @@ type GetContractStorageRequest struct {
 	// get the value from StateDB, field is needed if StateDB[key] is a map.(we get StateDB[key][field] in this case)
 	Field string `protobuf:"bytes,3,opt,name=field,proto3" json:"field,omitempty"`
 	// get data by longest chain's head block or last irreversible block
-	ByLongestChain       bool     `protobuf:"varint,4,opt,name=by_longest_chain,json=byLongestChain,proto3" json:"by_longest_chain,omitempty"`
+	ByLongestChain bool `protobuf:"varint,4,opt,name=by_longest_chain,json=byLongestChain,proto3" json:"by_longest_chain,omitempty"`
+	// get data at the block with the given number, 0 means not specified unless use_block_number is set
+	BlockNumber int64 `protobuf:"varint,5,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	// get data at the block with the given hash, which takes precedence over block_number
+	BlockHash string `protobuf:"bytes,6,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	// use block_number even if it is 0, which is needed to get data at the genesis block
+	UseBlockNumber       bool     `protobuf:"varint,7,opt,name=use_block_number,json=useBlockNumber,proto3" json:"use_block_number,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -2626,7 +2759,7 @@ func (m *GetContractStorageRequest) Reset()         { *m = GetContractStorageReq
 func (m *GetContractStorageRequest) String() string { return proto.CompactTextString(m) }
 func (*GetContractStorageRequest) ProtoMessage()    {}
 func (*GetContractStorageRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{26}
+	return fileDescriptor_1b773bf3e696f610, []int{27}
 }
 
 func (m *GetContractStorageRequest) XXX_Unmarshal(b []byte) error {
@@ -2675,6 +2808,27 @@ func (m *GetContractStorageRequest) GetByLongestChain() bool {
 	return false
 }
 
+func (m *GetContractStorageRequest) GetBlockNumber() int64 {
+	if m != nil {
+		return m.BlockNumber
+	}
+	return 0
+}
+
+func (m *GetContractStorageRequest) GetBlockHash() string {
+	if m != nil {
+		return m.BlockHash
+	}
+	return ""
+}
+
+func (m *GetContractStorageRequest) GetUseBlockNumber() bool {
+	if m != nil {
+		return m.UseBlockNumber
+	}
+	return false
+}
+
 // The message defines get contract storage response.
 type GetContractStorageResponse struct {
 	// the json string data
@@ -2692,7 +2846,7 @@ func (m *GetContractStorageResponse) Reset()         { *m = GetContractStorageRe
 func (m *GetContractStorageResponse) String() string { return proto.CompactTextString(m) }
 func (*GetContractStorageResponse) ProtoMessage()    {}
 func (*GetContractStorageResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{27}
+	return fileDescriptor_1b773bf3e696f610, []int{28}
 }
 
 func (m *GetContractStorageResponse) XXX_Unmarshal(b []byte) error {
@@ -2751,7 +2905,7 @@ func (m *GetContractStorageFieldsRequest) Reset()         { *m = GetContractStor
 func (m *GetContractStorageFieldsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetContractStorageFieldsRequest) ProtoMessage()    {}
 func (*GetContractStorageFieldsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{28}
+	return fileDescriptor_1b773bf3e696f610, []int{29}
 }
 
 func (m *GetContractStorageFieldsRequest) XXX_Unmarshal(b []byte) error {
@@ -2810,7 +2964,7 @@ func (m *GetContractStorageFieldsResponse) Reset()         { *m = GetContractSto
 func (m *GetContractStorageFieldsResponse) String() string { return proto.CompactTextString(m) }
 func (*GetContractStorageFieldsResponse) ProtoMessage()    {}
 func (*GetContractStorageFieldsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{29}
+	return fileDescriptor_1b773bf3e696f610, []int{30}
 }
 
 func (m *GetContractStorageFieldsResponse) XXX_Unmarshal(b []byte) error {
@@ -2867,7 +3021,7 @@ func (m *SendTransactionResponse) Reset()         { *m = SendTransactionResponse
 func (m *SendTransactionResponse) String() string { return proto.CompactTextString(m) }
 func (*SendTransactionResponse) ProtoMessage()    {}
 func (*SendTransactionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{30}
+	return fileDescriptor_1b773bf3e696f610, []int{31}
 }
 
 func (m *SendTransactionResponse) XXX_Unmarshal(b []byte) error {
@@ -2917,7 +3071,7 @@ func (m *GetTokenBalanceResponse) Reset()         { *m = GetTokenBalanceResponse
 func (m *GetTokenBalanceResponse) String() string { return proto.CompactTextString(m) }
 func (*GetTokenBalanceResponse) ProtoMessage()    {}
 func (*GetTokenBalanceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{31}
+	return fileDescriptor_1b773bf3e696f610, []int{32}
 }
 
 func (m *GetTokenBalanceResponse) XXX_Unmarshal(b []byte) error {
@@ -2959,7 +3113,13 @@ type GetTokenBalanceRequest struct {
 	// the token name
 	Token string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
 	// get data by longest chain's head block or last irreversible block
-	ByLongestChain       bool     `protobuf:"varint,3,opt,name=by_longest_chain,json=byLongestChain,proto3" json:"by_longest_chain,omitempty"`
+	ByLongestChain bool `protobuf:"varint,3,opt,name=by_longest_chain,json=byLongestChain,proto3" json:"by_longest_chain,omitempty"`
+	// get data at the block with the given number, 0 means not specified unless use_block_number is set
+	BlockNumber int64 `protobuf:"varint,4,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	// get data at the block with the given hash, which takes precedence over block_number
+	BlockHash string `protobuf:"bytes,5,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	// use block_number even if it is 0, which is needed to get data at the genesis block
+	UseBlockNumber       bool     `protobuf:"varint,6,opt,name=use_block_number,json=useBlockNumber,proto3" json:"use_block_number,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -2969,7 +3129,7 @@ func (m *GetTokenBalanceRequest) Reset()         { *m = GetTokenBalanceRequest{}
 func (m *GetTokenBalanceRequest) String() string { return proto.CompactTextString(m) }
 func (*GetTokenBalanceRequest) ProtoMessage()    {}
 func (*GetTokenBalanceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{32}
+	return fileDescriptor_1b773bf3e696f610, []int{33}
 }
 
 func (m *GetTokenBalanceRequest) XXX_Unmarshal(b []byte) error {
@@ -3011,6 +3171,27 @@ func (m *GetTokenBalanceRequest) GetByLongestChain() bool {
 	return false
 }
 
+func (m *GetTokenBalanceRequest) GetBlockNumber() int64 {
+	if m != nil {
+		return m.BlockNumber
+	}
+	return 0
+}
+
+func (m *GetTokenBalanceRequest) GetBlockHash() string {
+	if m != nil {
+		return m.BlockHash
+	}
+	return ""
+}
+
+func (m *GetTokenBalanceRequest) GetUseBlockNumber() bool {
+	if m != nil {
+		return m.UseBlockNumber
+	}
+	return false
+}
+
 // The message defines get token721 balance response.
 type GetToken721BalanceResponse struct {
 	// token balance
@@ -3026,7 +3207,7 @@ func (m *GetToken721BalanceResponse) Reset()         { *m = GetToken721BalanceRe
 func (m *GetToken721BalanceResponse) String() string { return proto.CompactTextString(m) }
 func (*GetToken721BalanceResponse) ProtoMessage()    {}
 func (*GetToken721BalanceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{33}
+	return fileDescriptor_1b773bf3e696f610, []int{34}
 }
 
 func (m *GetToken721BalanceResponse) XXX_Unmarshal(b []byte) error {
@@ -3078,7 +3259,7 @@ func (m *GetToken721InfoRequest) Reset()         { *m = GetToken721InfoRequest{}
 func (m *GetToken721InfoRequest) String() string { return proto.CompactTextString(m) }
 func (*GetToken721InfoRequest) ProtoMessage()    {}
 func (*GetToken721InfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{34}
+	return fileDescriptor_1b773bf3e696f610, []int{35}
 }
 
 func (m *GetToken721InfoRequest) XXX_Unmarshal(b []byte) error {
@@ -3133,7 +3314,7 @@ func (m *GetToken721MetadataResponse) Reset()         { *m = GetToken721Metadata
 func (m *GetToken721MetadataResponse) String() string { return proto.CompactTextString(m) }
 func (*GetToken721MetadataResponse) ProtoMessage()    {}
 func (*GetToken721MetadataResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{35}
+	return fileDescriptor_1b773bf3e696f610, []int{36}
 }
 
 func (m *GetToken721MetadataResponse) XXX_Unmarshal(b []byte) error {
@@ -3174,7 +3355,7 @@ func (m *GetToken721OwnerResponse) Reset()         { *m = GetToken721OwnerRespon
 func (m *GetToken721OwnerResponse) String() string { return proto.CompactTextString(m) }
 func (*GetToken721OwnerResponse) ProtoMessage()    {}
 func (*GetToken721OwnerResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{36}
+	return fileDescriptor_1b773bf3e696f610, []int{37}
 }
 
 func (m *GetToken721OwnerResponse) XXX_Unmarshal(b []byte) error {
@@ -3219,7 +3400,7 @@ func (m *Event) Reset()         { *m = Event{} }
 func (m *Event) String() string { return proto.CompactTextString(m) }
 func (*Event) ProtoMessage()    {}
 func (*Event) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{37}
+	return fileDescriptor_1b773bf3e696f610, []int{38}
 }
 
 func (m *Event) XXX_Unmarshal(b []byte) error {
@@ -3261,130 +3442,1876 @@ func (m *Event) GetTime() int64 {
 	return 0
 }
 
-// The message defines subscribe request.
-type SubscribeRequest struct {
-	Topics               []Event_Topic            `protobuf:"varint,1,rep,packed,name=topics,proto3,enum=rpcpb.Event_Topic" json:"topics,omitempty"`
-	Filter               *SubscribeRequest_Filter `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
-	XXX_unrecognized     []byte                   `json:"-"`
-	XXX_sizecache        int32                    `json:"-"`
+// The message defines get events request.
+type GetEventsRequest struct {
+	// the first block number of the range
+	FromBlock int64 `protobuf:"varint,1,opt,name=from_block,json=fromBlock,proto3" json:"from_block,omitempty"`
+	// the last block number of the range, which should not be greater than the last irreversible block
+	ToBlock int64 `protobuf:"varint,2,opt,name=to_block,json=toBlock,proto3" json:"to_block,omitempty"`
+	// only return the events of these contracts, all contracts if empty
+	ContractIds []string `protobuf:"bytes,3,rep,name=contract_ids,json=contractIds,proto3" json:"contract_ids,omitempty"`
+	// only return the events whose content starts with the prefix
+	ContentPrefix string `protobuf:"bytes,4,opt,name=content_prefix,json=contentPrefix,proto3" json:"content_prefix,omitempty"`
+	// max number of returned events
+	Limit                int32    `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *SubscribeRequest) Reset()         { *m = SubscribeRequest{} }
-func (m *SubscribeRequest) String() string { return proto.CompactTextString(m) }
-func (*SubscribeRequest) ProtoMessage()    {}
-func (*SubscribeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{38}
+func (m *GetEventsRequest) Reset()         { *m = GetEventsRequest{} }
+func (m *GetEventsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetEventsRequest) ProtoMessage()    {}
+func (*GetEventsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{39}
 }
 
-func (m *SubscribeRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SubscribeRequest.Unmarshal(m, b)
+func (m *GetEventsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetEventsRequest.Unmarshal(m, b)
 }
-func (m *SubscribeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SubscribeRequest.Marshal(b, m, deterministic)
+func (m *GetEventsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetEventsRequest.Marshal(b, m, deterministic)
 }
-func (m *SubscribeRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SubscribeRequest.Merge(m, src)
+func (m *GetEventsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetEventsRequest.Merge(m, src)
 }
-func (m *SubscribeRequest) XXX_Size() int {
-	return xxx_messageInfo_SubscribeRequest.Size(m)
+func (m *GetEventsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetEventsRequest.Size(m)
 }
-func (m *SubscribeRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_SubscribeRequest.DiscardUnknown(m)
+func (m *GetEventsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetEventsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SubscribeRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetEventsRequest proto.InternalMessageInfo
 
-func (m *SubscribeRequest) GetTopics() []Event_Topic {
+func (m *GetEventsRequest) GetFromBlock() int64 {
 	if m != nil {
-		return m.Topics
+		return m.FromBlock
 	}
-	return nil
+	return 0
 }
 
-func (m *SubscribeRequest) GetFilter() *SubscribeRequest_Filter {
+func (m *GetEventsRequest) GetToBlock() int64 {
 	if m != nil {
-		return m.Filter
+		return m.ToBlock
+	}
+	return 0
+}
+
+func (m *GetEventsRequest) GetContractIds() []string {
+	if m != nil {
+		return m.ContractIds
 	}
 	return nil
 }
 
-type SubscribeRequest_Filter struct {
+func (m *GetEventsRequest) GetContentPrefix() string {
+	if m != nil {
+		return m.ContentPrefix
+	}
+	return ""
+}
+
+func (m *GetEventsRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+// The message defines a contract receipt in an irreversible block.
+type ReceiptEvent struct {
+	// block number
+	BlockNumber int64 `protobuf:"varint,1,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	// transaction hash
+	TxHash string `protobuf:"bytes,2,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
 	// contract id
-	ContractId           string   `protobuf:"bytes,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	ContractId string `protobuf:"bytes,3,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	// function name
+	FuncName string `protobuf:"bytes,4,opt,name=func_name,json=funcName,proto3" json:"func_name,omitempty"`
+	// content
+	Content              string   `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *SubscribeRequest_Filter) Reset()         { *m = SubscribeRequest_Filter{} }
-func (m *SubscribeRequest_Filter) String() string { return proto.CompactTextString(m) }
-func (*SubscribeRequest_Filter) ProtoMessage()    {}
-func (*SubscribeRequest_Filter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{38, 0}
+func (m *ReceiptEvent) Reset()         { *m = ReceiptEvent{} }
+func (m *ReceiptEvent) String() string { return proto.CompactTextString(m) }
+func (*ReceiptEvent) ProtoMessage()    {}
+func (*ReceiptEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{40}
 }
 
-func (m *SubscribeRequest_Filter) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SubscribeRequest_Filter.Unmarshal(m, b)
+func (m *ReceiptEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReceiptEvent.Unmarshal(m, b)
 }
-func (m *SubscribeRequest_Filter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SubscribeRequest_Filter.Marshal(b, m, deterministic)
+func (m *ReceiptEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReceiptEvent.Marshal(b, m, deterministic)
 }
-func (m *SubscribeRequest_Filter) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SubscribeRequest_Filter.Merge(m, src)
+func (m *ReceiptEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptEvent.Merge(m, src)
 }
-func (m *SubscribeRequest_Filter) XXX_Size() int {
-	return xxx_messageInfo_SubscribeRequest_Filter.Size(m)
+func (m *ReceiptEvent) XXX_Size() int {
+	return xxx_messageInfo_ReceiptEvent.Size(m)
 }
-func (m *SubscribeRequest_Filter) XXX_DiscardUnknown() {
-	xxx_messageInfo_SubscribeRequest_Filter.DiscardUnknown(m)
+func (m *ReceiptEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptEvent.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SubscribeRequest_Filter proto.InternalMessageInfo
+var xxx_messageInfo_ReceiptEvent proto.InternalMessageInfo
 
-func (m *SubscribeRequest_Filter) GetContractId() string {
+func (m *ReceiptEvent) GetBlockNumber() int64 {
 	if m != nil {
-		return m.ContractId
+		return m.BlockNumber
+	}
+	return 0
+}
+
+func (m *ReceiptEvent) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
 	}
 	return ""
 }
 
-// The message defines subscribe response.
-type SubscribeResponse struct {
-	Event                *Event   `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+func (m *ReceiptEvent) GetContractId() string {
+	if m != nil {
+		return m.ContractId
+	}
+	return ""
+}
+
+func (m *ReceiptEvent) GetFuncName() string {
+	if m != nil {
+		return m.FuncName
+	}
+	return ""
+}
+
+func (m *ReceiptEvent) GetContent() string {
+	if m != nil {
+		return m.Content
+	}
+	return ""
+}
+
+// The message defines get events response.
+type GetEventsResponse struct {
+	// matched events in order of block number
+	Events               []*ReceiptEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *GetEventsResponse) Reset()         { *m = GetEventsResponse{} }
+func (m *GetEventsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetEventsResponse) ProtoMessage()    {}
+func (*GetEventsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{41}
+}
+
+func (m *GetEventsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetEventsResponse.Unmarshal(m, b)
+}
+func (m *GetEventsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetEventsResponse.Marshal(b, m, deterministic)
+}
+func (m *GetEventsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetEventsResponse.Merge(m, src)
+}
+func (m *GetEventsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetEventsResponse.Size(m)
+}
+func (m *GetEventsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetEventsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetEventsResponse proto.InternalMessageInfo
+
+func (m *GetEventsResponse) GetEvents() []*ReceiptEvent {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+// The message defines get account transactions request.
+type GetAccountTransactionsRequest struct {
+	// account name
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// max number of returned transactions
+	Limit int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// cursor returned by the previous page, empty for the first page
+	Cursor               string   `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetAccountTransactionsRequest) Reset()         { *m = GetAccountTransactionsRequest{} }
+func (m *GetAccountTransactionsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetAccountTransactionsRequest) ProtoMessage()    {}
+func (*GetAccountTransactionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{42}
+}
+
+func (m *GetAccountTransactionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetAccountTransactionsRequest.Unmarshal(m, b)
+}
+func (m *GetAccountTransactionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetAccountTransactionsRequest.Marshal(b, m, deterministic)
+}
+func (m *GetAccountTransactionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAccountTransactionsRequest.Merge(m, src)
+}
+func (m *GetAccountTransactionsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetAccountTransactionsRequest.Size(m)
+}
+func (m *GetAccountTransactionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAccountTransactionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetAccountTransactionsRequest proto.InternalMessageInfo
+
+func (m *GetAccountTransactionsRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *GetAccountTransactionsRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *GetAccountTransactionsRequest) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
+// The message defines a transaction involving an account.
+type AccountTransaction struct {
+	// block number
+	BlockNumber int64 `protobuf:"varint,1,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	// transaction
+	Transaction          *Transaction `protobuf:"bytes,2,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *AccountTransaction) Reset()         { *m = AccountTransaction{} }
+func (m *AccountTransaction) String() string { return proto.CompactTextString(m) }
+func (*AccountTransaction) ProtoMessage()    {}
+func (*AccountTransaction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{43}
+}
+
+func (m *AccountTransaction) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AccountTransaction.Unmarshal(m, b)
+}
+func (m *AccountTransaction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AccountTransaction.Marshal(b, m, deterministic)
+}
+func (m *AccountTransaction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountTransaction.Merge(m, src)
+}
+func (m *AccountTransaction) XXX_Size() int {
+	return xxx_messageInfo_AccountTransaction.Size(m)
+}
+func (m *AccountTransaction) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountTransaction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountTransaction proto.InternalMessageInfo
+
+func (m *AccountTransaction) GetBlockNumber() int64 {
+	if m != nil {
+		return m.BlockNumber
+	}
+	return 0
+}
+
+func (m *AccountTransaction) GetTransaction() *Transaction {
+	if m != nil {
+		return m.Transaction
+	}
+	return nil
+}
+
+// The message defines get account transactions response.
+type GetAccountTransactionsResponse struct {
+	// transactions from the newest to the oldest
+	Transactions []*AccountTransaction `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	// cursor of the next page, empty if there are no more transactions
+	NextCursor           string   `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetAccountTransactionsResponse) Reset()         { *m = GetAccountTransactionsResponse{} }
+func (m *GetAccountTransactionsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetAccountTransactionsResponse) ProtoMessage()    {}
+func (*GetAccountTransactionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{44}
+}
+
+func (m *GetAccountTransactionsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetAccountTransactionsResponse.Unmarshal(m, b)
+}
+func (m *GetAccountTransactionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetAccountTransactionsResponse.Marshal(b, m, deterministic)
+}
+func (m *GetAccountTransactionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAccountTransactionsResponse.Merge(m, src)
+}
+func (m *GetAccountTransactionsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetAccountTransactionsResponse.Size(m)
+}
+func (m *GetAccountTransactionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAccountTransactionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetAccountTransactionsResponse proto.InternalMessageInfo
+
+func (m *GetAccountTransactionsResponse) GetTransactions() []*AccountTransaction {
+	if m != nil {
+		return m.Transactions
+	}
+	return nil
+}
+
+func (m *GetAccountTransactionsResponse) GetNextCursor() string {
+	if m != nil {
+		return m.NextCursor
+	}
+	return ""
+}
+
+// The message defines get LIB attestation request.
+type GetLIBAttestationRequest struct {
+	// block number
+	Number               int64    `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetLIBAttestationRequest) Reset()         { *m = GetLIBAttestationRequest{} }
+func (m *GetLIBAttestationRequest) String() string { return proto.CompactTextString(m) }
+func (*GetLIBAttestationRequest) ProtoMessage()    {}
+func (*GetLIBAttestationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{45}
+}
+
+func (m *GetLIBAttestationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetLIBAttestationRequest.Unmarshal(m, b)
+}
+func (m *GetLIBAttestationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetLIBAttestationRequest.Marshal(b, m, deterministic)
+}
+func (m *GetLIBAttestationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetLIBAttestationRequest.Merge(m, src)
+}
+func (m *GetLIBAttestationRequest) XXX_Size() int {
+	return xxx_messageInfo_GetLIBAttestationRequest.Size(m)
+}
+func (m *GetLIBAttestationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetLIBAttestationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetLIBAttestationRequest proto.InternalMessageInfo
+
+func (m *GetLIBAttestationRequest) GetNumber() int64 {
+	if m != nil {
+		return m.Number
+	}
+	return 0
+}
+
+// The message defines the attestation of an irreversible block.
+type LIBAttestation struct {
+	// block number
+	Number int64 `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"`
+	// block hash
+	BlockHash string `protobuf:"bytes,2,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	// active witness list of the block
+	Witnesses []string `protobuf:"bytes,3,rep,name=witnesses,proto3" json:"witnesses,omitempty"`
+	// bitmap of the witnesses who signed the block, the lowest bit of the first byte is the first witness
+	Bitmap []byte `protobuf:"bytes,4,opt,name=bitmap,proto3" json:"bitmap,omitempty"`
+	// signatures of the marked witnesses in order of the witness list, without public key
+	Signatures []*Signature `protobuf:"bytes,5,rep,name=signatures,proto3" json:"signatures,omitempty"`
+	// aggregate BLS signature of the marked witnesses, signatures is empty if it is set
+	Aggregate []byte `protobuf:"bytes,6,opt,name=aggregate,proto3" json:"aggregate,omitempty"`
+	// BLS keys of the marked witnesses in order of the witness list, for the aggregate signature
+	Keys                 []*WitnessKey `protobuf:"bytes,7,rep,name=keys,proto3" json:"keys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *LIBAttestation) Reset()         { *m = LIBAttestation{} }
+func (m *LIBAttestation) String() string { return proto.CompactTextString(m) }
+func (*LIBAttestation) ProtoMessage()    {}
+func (*LIBAttestation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{46}
+}
+
+func (m *LIBAttestation) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LIBAttestation.Unmarshal(m, b)
+}
+func (m *LIBAttestation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LIBAttestation.Marshal(b, m, deterministic)
+}
+func (m *LIBAttestation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LIBAttestation.Merge(m, src)
+}
+func (m *LIBAttestation) XXX_Size() int {
+	return xxx_messageInfo_LIBAttestation.Size(m)
+}
+func (m *LIBAttestation) XXX_DiscardUnknown() {
+	xxx_messageInfo_LIBAttestation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LIBAttestation proto.InternalMessageInfo
+
+func (m *LIBAttestation) GetNumber() int64 {
+	if m != nil {
+		return m.Number
+	}
+	return 0
+}
+
+func (m *LIBAttestation) GetBlockHash() string {
+	if m != nil {
+		return m.BlockHash
+	}
+	return ""
+}
+
+func (m *LIBAttestation) GetWitnesses() []string {
+	if m != nil {
+		return m.Witnesses
+	}
+	return nil
+}
+
+func (m *LIBAttestation) GetBitmap() []byte {
+	if m != nil {
+		return m.Bitmap
+	}
+	return nil
+}
+
+func (m *LIBAttestation) GetSignatures() []*Signature {
+	if m != nil {
+		return m.Signatures
+	}
+	return nil
+}
+
+func (m *LIBAttestation) GetAggregate() []byte {
+	if m != nil {
+		return m.Aggregate
+	}
+	return nil
+}
+
+func (m *LIBAttestation) GetKeys() []*WitnessKey {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
+// The message defines get block header request.
+type GetBlockHeaderRequest struct {
+	// block number
+	Number               int64    `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetBlockHeaderRequest) Reset()         { *m = GetBlockHeaderRequest{} }
+func (m *GetBlockHeaderRequest) String() string { return proto.CompactTextString(m) }
+func (*GetBlockHeaderRequest) ProtoMessage()    {}
+func (*GetBlockHeaderRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{47}
+}
+
+func (m *GetBlockHeaderRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetBlockHeaderRequest.Unmarshal(m, b)
+}
+func (m *GetBlockHeaderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetBlockHeaderRequest.Marshal(b, m, deterministic)
+}
+func (m *GetBlockHeaderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBlockHeaderRequest.Merge(m, src)
+}
+func (m *GetBlockHeaderRequest) XXX_Size() int {
+	return xxx_messageInfo_GetBlockHeaderRequest.Size(m)
+}
+func (m *GetBlockHeaderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBlockHeaderRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetBlockHeaderRequest proto.InternalMessageInfo
+
+func (m *GetBlockHeaderRequest) GetNumber() int64 {
+	if m != nil {
+		return m.Number
+	}
+	return 0
+}
+
+// The message defines the block head with all the fields needed to compute the block hash.
+type BlockHeader struct {
+	// block hash
+	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	// block version
+	Version int64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	// parent block hash
+	ParentHash string `protobuf:"bytes,3,opt,name=parent_hash,json=parentHash,proto3" json:"parent_hash,omitempty"`
+	// transaction merkle tree root hash
+	TxMerkleHash string `protobuf:"bytes,4,opt,name=tx_merkle_hash,json=txMerkleHash,proto3" json:"tx_merkle_hash,omitempty"`
+	// transaction receipt merkle tree root hash
+	TxReceiptMerkleHash string `protobuf:"bytes,5,opt,name=tx_receipt_merkle_hash,json=txReceiptMerkleHash,proto3" json:"tx_receipt_merkle_hash,omitempty"`
+	// raw extra information
+	Info []byte `protobuf:"bytes,6,opt,name=info,proto3" json:"info,omitempty"`
+	// block number
+	Number int64 `protobuf:"varint,7,opt,name=number,proto3" json:"number,omitempty"`
+	// block producer witness
+	Witness string `protobuf:"bytes,8,opt,name=witness,proto3" json:"witness,omitempty"`
+	// block timestamp
+	Time int64 `protobuf:"varint,9,opt,name=time,proto3" json:"time,omitempty"`
+	// signature of the block hash by the witness
+	Signature *Signature `protobuf:"bytes,10,opt,name=signature,proto3" json:"signature,omitempty"`
+	// state root committed since block version 1
+	StateRoot            string   `protobuf:"bytes,11,opt,name=state_root,json=stateRoot,proto3" json:"state_root,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BlockHeader) Reset()         { *m = BlockHeader{} }
+func (m *BlockHeader) String() string { return proto.CompactTextString(m) }
+func (*BlockHeader) ProtoMessage()    {}
+func (*BlockHeader) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{48}
+}
+
+func (m *BlockHeader) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlockHeader.Unmarshal(m, b)
+}
+func (m *BlockHeader) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlockHeader.Marshal(b, m, deterministic)
+}
+func (m *BlockHeader) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockHeader.Merge(m, src)
+}
+func (m *BlockHeader) XXX_Size() int {
+	return xxx_messageInfo_BlockHeader.Size(m)
+}
+func (m *BlockHeader) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockHeader.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockHeader proto.InternalMessageInfo
+
+func (m *BlockHeader) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *BlockHeader) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *BlockHeader) GetParentHash() string {
+	if m != nil {
+		return m.ParentHash
+	}
+	return ""
+}
+
+func (m *BlockHeader) GetTxMerkleHash() string {
+	if m != nil {
+		return m.TxMerkleHash
+	}
+	return ""
+}
+
+func (m *BlockHeader) GetTxReceiptMerkleHash() string {
+	if m != nil {
+		return m.TxReceiptMerkleHash
+	}
+	return ""
+}
+
+func (m *BlockHeader) GetInfo() []byte {
+	if m != nil {
+		return m.Info
+	}
+	return nil
+}
+
+func (m *BlockHeader) GetNumber() int64 {
+	if m != nil {
+		return m.Number
+	}
+	return 0
+}
+
+func (m *BlockHeader) GetWitness() string {
+	if m != nil {
+		return m.Witness
+	}
+	return ""
+}
+
+func (m *BlockHeader) GetTime() int64 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
+}
+
+func (m *BlockHeader) GetSignature() *Signature {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+func (m *BlockHeader) GetStateRoot() string {
+	if m != nil {
+		return m.StateRoot
+	}
+	return ""
+}
+
+// The message defines a merkle path from a leaf to the root.
+type MerkleProof struct {
+	// index of the leaf
+	Index int32 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	// sibling hashes from the leaf to the root
+	Path                 [][]byte `protobuf:"bytes,2,rep,name=path,proto3" json:"path,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MerkleProof) Reset()         { *m = MerkleProof{} }
+func (m *MerkleProof) String() string { return proto.CompactTextString(m) }
+func (*MerkleProof) ProtoMessage()    {}
+func (*MerkleProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{49}
+}
+
+func (m *MerkleProof) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MerkleProof.Unmarshal(m, b)
+}
+func (m *MerkleProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MerkleProof.Marshal(b, m, deterministic)
+}
+func (m *MerkleProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MerkleProof.Merge(m, src)
+}
+func (m *MerkleProof) XXX_Size() int {
+	return xxx_messageInfo_MerkleProof.Size(m)
+}
+func (m *MerkleProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_MerkleProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MerkleProof proto.InternalMessageInfo
+
+func (m *MerkleProof) GetIndex() int32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *MerkleProof) GetPath() [][]byte {
+	if m != nil {
+		return m.Path
+	}
+	return nil
+}
+
+// The message defines the merkle proof of a transaction.
+type TxProofResponse struct {
+	// head of the block containing the transaction
+	Header *BlockHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	// transaction hash, which is the leaf of the proof
+	TxHash string `protobuf:"bytes,2,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	// proof against tx_merkle_hash of the header
+	Proof                *MerkleProof `protobuf:"bytes,3,opt,name=proof,proto3" json:"proof,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *TxProofResponse) Reset()         { *m = TxProofResponse{} }
+func (m *TxProofResponse) String() string { return proto.CompactTextString(m) }
+func (*TxProofResponse) ProtoMessage()    {}
+func (*TxProofResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{50}
+}
+
+func (m *TxProofResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TxProofResponse.Unmarshal(m, b)
+}
+func (m *TxProofResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TxProofResponse.Marshal(b, m, deterministic)
+}
+func (m *TxProofResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxProofResponse.Merge(m, src)
+}
+func (m *TxProofResponse) XXX_Size() int {
+	return xxx_messageInfo_TxProofResponse.Size(m)
+}
+func (m *TxProofResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxProofResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxProofResponse proto.InternalMessageInfo
+
+func (m *TxProofResponse) GetHeader() *BlockHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *TxProofResponse) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+func (m *TxProofResponse) GetProof() *MerkleProof {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+// The message defines the merkle proof of a transaction receipt.
+type ReceiptProofResponse struct {
+	// head of the block containing the transaction
+	Header *BlockHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	// protobuf encoded receipt, whose hash is the leaf of the proof
+	Receipt []byte `protobuf:"bytes,2,opt,name=receipt,proto3" json:"receipt,omitempty"`
+	// readable receipt
+	TxReceipt *TxReceipt `protobuf:"bytes,3,opt,name=tx_receipt,json=txReceipt,proto3" json:"tx_receipt,omitempty"`
+	// proof against tx_receipt_merkle_hash of the header
+	Proof                *MerkleProof `protobuf:"bytes,4,opt,name=proof,proto3" json:"proof,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *ReceiptProofResponse) Reset()         { *m = ReceiptProofResponse{} }
+func (m *ReceiptProofResponse) String() string { return proto.CompactTextString(m) }
+func (*ReceiptProofResponse) ProtoMessage()    {}
+func (*ReceiptProofResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{51}
+}
+
+func (m *ReceiptProofResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReceiptProofResponse.Unmarshal(m, b)
+}
+func (m *ReceiptProofResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReceiptProofResponse.Marshal(b, m, deterministic)
+}
+func (m *ReceiptProofResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptProofResponse.Merge(m, src)
+}
+func (m *ReceiptProofResponse) XXX_Size() int {
+	return xxx_messageInfo_ReceiptProofResponse.Size(m)
+}
+func (m *ReceiptProofResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptProofResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiptProofResponse proto.InternalMessageInfo
+
+func (m *ReceiptProofResponse) GetHeader() *BlockHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *ReceiptProofResponse) GetReceipt() []byte {
+	if m != nil {
+		return m.Receipt
+	}
+	return nil
+}
+
+func (m *ReceiptProofResponse) GetTxReceipt() *TxReceipt {
+	if m != nil {
+		return m.TxReceipt
+	}
+	return nil
+}
+
+func (m *ReceiptProofResponse) GetProof() *MerkleProof {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+// The message defines cancel transaction request.
+type CancelTransactionRequest struct {
+	// hash of the pending transaction
+	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	// signature of sha3("CancelTx" + transaction hash bytes) by one of the publisher keys of the transaction
+	Signature            *Signature `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *CancelTransactionRequest) Reset()         { *m = CancelTransactionRequest{} }
+func (m *CancelTransactionRequest) String() string { return proto.CompactTextString(m) }
+func (*CancelTransactionRequest) ProtoMessage()    {}
+func (*CancelTransactionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{52}
+}
+
+func (m *CancelTransactionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CancelTransactionRequest.Unmarshal(m, b)
+}
+func (m *CancelTransactionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CancelTransactionRequest.Marshal(b, m, deterministic)
+}
+func (m *CancelTransactionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelTransactionRequest.Merge(m, src)
+}
+func (m *CancelTransactionRequest) XXX_Size() int {
+	return xxx_messageInfo_CancelTransactionRequest.Size(m)
+}
+func (m *CancelTransactionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelTransactionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelTransactionRequest proto.InternalMessageInfo
+
+func (m *CancelTransactionRequest) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *CancelTransactionRequest) GetSignature() *Signature {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+// The message defines get pending transactions request.
+type GetPendingTxsRequest struct {
+	// only return the transactions of the publisher, all publishers if empty
+	Publisher string `protobuf:"bytes,1,opt,name=publisher,proto3" json:"publisher,omitempty"`
+	// only return the transactions calling the contract, all contracts if empty
+	ContractId string `protobuf:"bytes,2,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	// max number of returned transactions, not used by subscription
+	Limit                int32    `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetPendingTxsRequest) Reset()         { *m = GetPendingTxsRequest{} }
+func (m *GetPendingTxsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetPendingTxsRequest) ProtoMessage()    {}
+func (*GetPendingTxsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{53}
+}
+
+func (m *GetPendingTxsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPendingTxsRequest.Unmarshal(m, b)
+}
+func (m *GetPendingTxsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPendingTxsRequest.Marshal(b, m, deterministic)
+}
+func (m *GetPendingTxsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPendingTxsRequest.Merge(m, src)
+}
+func (m *GetPendingTxsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetPendingTxsRequest.Size(m)
+}
+func (m *GetPendingTxsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPendingTxsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPendingTxsRequest proto.InternalMessageInfo
+
+func (m *GetPendingTxsRequest) GetPublisher() string {
+	if m != nil {
+		return m.Publisher
+	}
+	return ""
+}
+
+func (m *GetPendingTxsRequest) GetContractId() string {
+	if m != nil {
+		return m.ContractId
+	}
+	return ""
+}
+
+func (m *GetPendingTxsRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+// The message defines a pending transaction.
+type PendingTx struct {
+	// transaction
+	Transaction *Transaction `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	// position in packing order of the whole pending list, starting from 0, not set by subscription
+	Rank int32 `protobuf:"varint,2,opt,name=rank,proto3" json:"rank,omitempty"`
+	// why the transaction is not packed yet: queued, not_arrived, expired or exceed_block_gas_limit
+	Status               string   `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PendingTx) Reset()         { *m = PendingTx{} }
+func (m *PendingTx) String() string { return proto.CompactTextString(m) }
+func (*PendingTx) ProtoMessage()    {}
+func (*PendingTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{54}
+}
+
+func (m *PendingTx) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PendingTx.Unmarshal(m, b)
+}
+func (m *PendingTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PendingTx.Marshal(b, m, deterministic)
+}
+func (m *PendingTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingTx.Merge(m, src)
+}
+func (m *PendingTx) XXX_Size() int {
+	return xxx_messageInfo_PendingTx.Size(m)
+}
+func (m *PendingTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingTx proto.InternalMessageInfo
+
+func (m *PendingTx) GetTransaction() *Transaction {
+	if m != nil {
+		return m.Transaction
+	}
+	return nil
+}
+
+func (m *PendingTx) GetRank() int32 {
+	if m != nil {
+		return m.Rank
+	}
+	return 0
+}
+
+func (m *PendingTx) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+// The message defines get pending transactions response.
+type GetPendingTxsResponse struct {
+	// pending transactions in packing order
+	Transactions []*PendingTx `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	// number of all the pending transactions
+	Total                int64    `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetPendingTxsResponse) Reset()         { *m = GetPendingTxsResponse{} }
+func (m *GetPendingTxsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetPendingTxsResponse) ProtoMessage()    {}
+func (*GetPendingTxsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{55}
+}
+
+func (m *GetPendingTxsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPendingTxsResponse.Unmarshal(m, b)
+}
+func (m *GetPendingTxsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPendingTxsResponse.Marshal(b, m, deterministic)
+}
+func (m *GetPendingTxsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPendingTxsResponse.Merge(m, src)
+}
+func (m *GetPendingTxsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetPendingTxsResponse.Size(m)
+}
+func (m *GetPendingTxsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPendingTxsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPendingTxsResponse proto.InternalMessageInfo
+
+func (m *GetPendingTxsResponse) GetTransactions() []*PendingTx {
+	if m != nil {
+		return m.Transactions
+	}
+	return nil
+}
+
+func (m *GetPendingTxsResponse) GetTotal() int64 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+// The message defines get pending transaction count response.
+type GetPendingTxCountResponse struct {
+	// number of all the pending transactions
+	Count                int64    `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetPendingTxCountResponse) Reset()         { *m = GetPendingTxCountResponse{} }
+func (m *GetPendingTxCountResponse) String() string { return proto.CompactTextString(m) }
+func (*GetPendingTxCountResponse) ProtoMessage()    {}
+func (*GetPendingTxCountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{56}
+}
+
+func (m *GetPendingTxCountResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPendingTxCountResponse.Unmarshal(m, b)
+}
+func (m *GetPendingTxCountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPendingTxCountResponse.Marshal(b, m, deterministic)
+}
+func (m *GetPendingTxCountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPendingTxCountResponse.Merge(m, src)
+}
+func (m *GetPendingTxCountResponse) XXX_Size() int {
+	return xxx_messageInfo_GetPendingTxCountResponse.Size(m)
+}
+func (m *GetPendingTxCountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPendingTxCountResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPendingTxCountResponse proto.InternalMessageInfo
+
+func (m *GetPendingTxCountResponse) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+// The message defines subscribe request.
+type SubscribeRequest struct {
+	Topics               []Event_Topic            `protobuf:"varint,1,rep,packed,name=topics,proto3,enum=rpcpb.Event_Topic" json:"topics,omitempty"`
+	Filter               *SubscribeRequest_Filter `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *SubscribeRequest) Reset()         { *m = SubscribeRequest{} }
+func (m *SubscribeRequest) String() string { return proto.CompactTextString(m) }
+func (*SubscribeRequest) ProtoMessage()    {}
+func (*SubscribeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{57}
+}
+
+func (m *SubscribeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubscribeRequest.Unmarshal(m, b)
+}
+func (m *SubscribeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubscribeRequest.Marshal(b, m, deterministic)
+}
+func (m *SubscribeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribeRequest.Merge(m, src)
+}
+func (m *SubscribeRequest) XXX_Size() int {
+	return xxx_messageInfo_SubscribeRequest.Size(m)
+}
+func (m *SubscribeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscribeRequest proto.InternalMessageInfo
+
+func (m *SubscribeRequest) GetTopics() []Event_Topic {
+	if m != nil {
+		return m.Topics
+	}
+	return nil
+}
+
+func (m *SubscribeRequest) GetFilter() *SubscribeRequest_Filter {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
+type SubscribeRequest_Filter struct {
+	// contract id
+	ContractId string `protobuf:"bytes,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	// transaction hash, used by the transaction topics
+	TxHash               string   `protobuf:"bytes,2,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SubscribeRequest_Filter) Reset()         { *m = SubscribeRequest_Filter{} }
+func (m *SubscribeRequest_Filter) String() string { return proto.CompactTextString(m) }
+func (*SubscribeRequest_Filter) ProtoMessage()    {}
+func (*SubscribeRequest_Filter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{57, 0}
+}
+
+func (m *SubscribeRequest_Filter) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubscribeRequest_Filter.Unmarshal(m, b)
+}
+func (m *SubscribeRequest_Filter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubscribeRequest_Filter.Marshal(b, m, deterministic)
+}
+func (m *SubscribeRequest_Filter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribeRequest_Filter.Merge(m, src)
+}
+func (m *SubscribeRequest_Filter) XXX_Size() int {
+	return xxx_messageInfo_SubscribeRequest_Filter.Size(m)
+}
+func (m *SubscribeRequest_Filter) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribeRequest_Filter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscribeRequest_Filter proto.InternalMessageInfo
+
+func (m *SubscribeRequest_Filter) GetContractId() string {
+	if m != nil {
+		return m.ContractId
+	}
+	return ""
+}
+
+func (m *SubscribeRequest_Filter) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+// The message defines subscribe response.
+type SubscribeResponse struct {
+	Event                *Event   `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SubscribeResponse) Reset()         { *m = SubscribeResponse{} }
+func (m *SubscribeResponse) String() string { return proto.CompactTextString(m) }
+func (*SubscribeResponse) ProtoMessage()    {}
+func (*SubscribeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{58}
+}
+
+func (m *SubscribeResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubscribeResponse.Unmarshal(m, b)
+}
+func (m *SubscribeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubscribeResponse.Marshal(b, m, deterministic)
+}
+func (m *SubscribeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribeResponse.Merge(m, src)
+}
+func (m *SubscribeResponse) XXX_Size() int {
+	return xxx_messageInfo_SubscribeResponse.Size(m)
+}
+func (m *SubscribeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscribeResponse proto.InternalMessageInfo
+
+func (m *SubscribeResponse) GetEvent() *Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+// The message defines get producer stats request.
+type GetProducerStatsRequest struct {
+	// public key of the producer, all producers if empty
+	Pubkey               string   `protobuf:"bytes,1,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetProducerStatsRequest) Reset()         { *m = GetProducerStatsRequest{} }
+func (m *GetProducerStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetProducerStatsRequest) ProtoMessage()    {}
+func (*GetProducerStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{59}
+}
+
+func (m *GetProducerStatsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetProducerStatsRequest.Unmarshal(m, b)
+}
+func (m *GetProducerStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetProducerStatsRequest.Marshal(b, m, deterministic)
+}
+func (m *GetProducerStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetProducerStatsRequest.Merge(m, src)
+}
+func (m *GetProducerStatsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetProducerStatsRequest.Size(m)
+}
+func (m *GetProducerStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetProducerStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetProducerStatsRequest proto.InternalMessageInfo
+
+func (m *GetProducerStatsRequest) GetPubkey() string {
+	if m != nil {
+		return m.Pubkey
+	}
+	return ""
+}
+
+// The message defines the reliability statistics of a producer.
+type ProducerStats struct {
+	// public key of the producer
+	Pubkey string `protobuf:"bytes,1,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	// number of the irreversible blocks produced
+	Produced int64 `protobuf:"varint,2,opt,name=produced,proto3" json:"produced,omitempty"`
+	// number of the slots without any irreversible block of the producer
+	Missed int64 `protobuf:"varint,3,opt,name=missed,proto3" json:"missed,omitempty"`
+	// number of the last irreversible block produced
+	LastProduced int64 `protobuf:"varint,4,opt,name=last_produced,json=lastProduced,proto3" json:"last_produced,omitempty"`
+	// the last slot missed
+	LastMissedSlot int64 `protobuf:"varint,5,opt,name=last_missed_slot,json=lastMissedSlot,proto3" json:"last_missed_slot,omitempty"`
+	// average delay in milliseconds between the time of the blocks and the time they are received by this node
+	AvgLatency           int64    `protobuf:"varint,6,opt,name=avg_latency,json=avgLatency,proto3" json:"avg_latency,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ProducerStats) Reset()         { *m = ProducerStats{} }
+func (m *ProducerStats) String() string { return proto.CompactTextString(m) }
+func (*ProducerStats) ProtoMessage()    {}
+func (*ProducerStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{60}
+}
+
+func (m *ProducerStats) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProducerStats.Unmarshal(m, b)
+}
+func (m *ProducerStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProducerStats.Marshal(b, m, deterministic)
+}
+func (m *ProducerStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProducerStats.Merge(m, src)
+}
+func (m *ProducerStats) XXX_Size() int {
+	return xxx_messageInfo_ProducerStats.Size(m)
+}
+func (m *ProducerStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProducerStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProducerStats proto.InternalMessageInfo
+
+func (m *ProducerStats) GetPubkey() string {
+	if m != nil {
+		return m.Pubkey
+	}
+	return ""
+}
+
+func (m *ProducerStats) GetProduced() int64 {
+	if m != nil {
+		return m.Produced
+	}
+	return 0
+}
+
+func (m *ProducerStats) GetMissed() int64 {
+	if m != nil {
+		return m.Missed
+	}
+	return 0
+}
+
+func (m *ProducerStats) GetLastProduced() int64 {
+	if m != nil {
+		return m.LastProduced
+	}
+	return 0
+}
+
+func (m *ProducerStats) GetLastMissedSlot() int64 {
+	if m != nil {
+		return m.LastMissedSlot
+	}
+	return 0
+}
+
+func (m *ProducerStats) GetAvgLatency() int64 {
+	if m != nil {
+		return m.AvgLatency
+	}
+	return 0
+}
+
+// The message defines get producer stats response.
+type GetProducerStatsResponse struct {
+	Stats                []*ProducerStats `protobuf:"bytes,1,rep,name=stats,proto3" json:"stats,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *GetProducerStatsResponse) Reset()         { *m = GetProducerStatsResponse{} }
+func (m *GetProducerStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetProducerStatsResponse) ProtoMessage()    {}
+func (*GetProducerStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{61}
+}
+
+func (m *GetProducerStatsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetProducerStatsResponse.Unmarshal(m, b)
+}
+func (m *GetProducerStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetProducerStatsResponse.Marshal(b, m, deterministic)
+}
+func (m *GetProducerStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetProducerStatsResponse.Merge(m, src)
+}
+func (m *GetProducerStatsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetProducerStatsResponse.Size(m)
+}
+func (m *GetProducerStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetProducerStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetProducerStatsResponse proto.InternalMessageInfo
+
+func (m *GetProducerStatsResponse) GetStats() []*ProducerStats {
+	if m != nil {
+		return m.Stats
+	}
+	return nil
+}
+
+// The message defines dev advance time request.
+type DevAdvanceTimeRequest struct {
+	// seconds to advance the chain time
+	Seconds              int64    `protobuf:"varint,1,opt,name=seconds,proto3" json:"seconds,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DevAdvanceTimeRequest) Reset()         { *m = DevAdvanceTimeRequest{} }
+func (m *DevAdvanceTimeRequest) String() string { return proto.CompactTextString(m) }
+func (*DevAdvanceTimeRequest) ProtoMessage()    {}
+func (*DevAdvanceTimeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{62}
+}
+
+func (m *DevAdvanceTimeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DevAdvanceTimeRequest.Unmarshal(m, b)
+}
+func (m *DevAdvanceTimeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DevAdvanceTimeRequest.Marshal(b, m, deterministic)
+}
+func (m *DevAdvanceTimeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DevAdvanceTimeRequest.Merge(m, src)
+}
+func (m *DevAdvanceTimeRequest) XXX_Size() int {
+	return xxx_messageInfo_DevAdvanceTimeRequest.Size(m)
+}
+func (m *DevAdvanceTimeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DevAdvanceTimeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DevAdvanceTimeRequest proto.InternalMessageInfo
+
+func (m *DevAdvanceTimeRequest) GetSeconds() int64 {
+	if m != nil {
+		return m.Seconds
+	}
+	return 0
+}
+
+// The message defines dev advance time response.
+type DevAdvanceTimeResponse struct {
+	// the chain time in nanoseconds after advanced, new transactions should be created at it
+	Time                 int64    `protobuf:"varint,1,opt,name=time,proto3" json:"time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DevAdvanceTimeResponse) Reset()         { *m = DevAdvanceTimeResponse{} }
+func (m *DevAdvanceTimeResponse) String() string { return proto.CompactTextString(m) }
+func (*DevAdvanceTimeResponse) ProtoMessage()    {}
+func (*DevAdvanceTimeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{63}
+}
+
+func (m *DevAdvanceTimeResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DevAdvanceTimeResponse.Unmarshal(m, b)
+}
+func (m *DevAdvanceTimeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DevAdvanceTimeResponse.Marshal(b, m, deterministic)
+}
+func (m *DevAdvanceTimeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DevAdvanceTimeResponse.Merge(m, src)
+}
+func (m *DevAdvanceTimeResponse) XXX_Size() int {
+	return xxx_messageInfo_DevAdvanceTimeResponse.Size(m)
+}
+func (m *DevAdvanceTimeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DevAdvanceTimeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DevAdvanceTimeResponse proto.InternalMessageInfo
+
+func (m *DevAdvanceTimeResponse) GetTime() int64 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
+}
+
+// The message defines dev reset response.
+type DevResetResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DevResetResponse) Reset()         { *m = DevResetResponse{} }
+func (m *DevResetResponse) String() string { return proto.CompactTextString(m) }
+func (*DevResetResponse) ProtoMessage()    {}
+func (*DevResetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{64}
+}
+
+func (m *DevResetResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DevResetResponse.Unmarshal(m, b)
+}
+func (m *DevResetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DevResetResponse.Marshal(b, m, deterministic)
+}
+func (m *DevResetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DevResetResponse.Merge(m, src)
+}
+func (m *DevResetResponse) XXX_Size() int {
+	return xxx_messageInfo_DevResetResponse.Size(m)
+}
+func (m *DevResetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DevResetResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DevResetResponse proto.InternalMessageInfo
+
+// The message defines get token info request.
+type GetTokenInfoRequest struct {
+	// token symbol
+	Symbol string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	// get data by longest chain's head block or last irreversible block
+	ByLongestChain bool `protobuf:"varint,2,opt,name=by_longest_chain,json=byLongestChain,proto3" json:"by_longest_chain,omitempty"`
+	// get data at the block with the given number, 0 means not specified unless use_block_number is set
+	BlockNumber int64 `protobuf:"varint,3,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	// get data at the block with the given hash, which takes precedence over block_number
+	BlockHash string `protobuf:"bytes,4,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	// use block_number even if it is 0, which is needed to get data at the genesis block
+	UseBlockNumber       bool     `protobuf:"varint,5,opt,name=use_block_number,json=useBlockNumber,proto3" json:"use_block_number,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetTokenInfoRequest) Reset()         { *m = GetTokenInfoRequest{} }
+func (m *GetTokenInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*GetTokenInfoRequest) ProtoMessage()    {}
+func (*GetTokenInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{65}
+}
+
+func (m *GetTokenInfoRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTokenInfoRequest.Unmarshal(m, b)
+}
+func (m *GetTokenInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTokenInfoRequest.Marshal(b, m, deterministic)
+}
+func (m *GetTokenInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTokenInfoRequest.Merge(m, src)
+}
+func (m *GetTokenInfoRequest) XXX_Size() int {
+	return xxx_messageInfo_GetTokenInfoRequest.Size(m)
+}
+func (m *GetTokenInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTokenInfoRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTokenInfoRequest proto.InternalMessageInfo
+
+func (m *GetTokenInfoRequest) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *GetTokenInfoRequest) GetByLongestChain() bool {
+	if m != nil {
+		return m.ByLongestChain
+	}
+	return false
+}
+
+func (m *GetTokenInfoRequest) GetBlockNumber() int64 {
+	if m != nil {
+		return m.BlockNumber
+	}
+	return 0
+}
+
+func (m *GetTokenInfoRequest) GetBlockHash() string {
+	if m != nil {
+		return m.BlockHash
+	}
+	return ""
+}
+
+func (m *GetTokenInfoRequest) GetUseBlockNumber() bool {
+	if m != nil {
+		return m.UseBlockNumber
+	}
+	return false
+}
+
+// The message defines the metadata of a token.
+type TokenInfo struct {
+	// token symbol
+	Symbol string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	// full name of the token
+	FullName string `protobuf:"bytes,2,opt,name=full_name,json=fullName,proto3" json:"full_name,omitempty"`
+	// issuer of the token
+	Issuer string `protobuf:"bytes,3,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	// amount of the issued tokens
+	Supply float64 `protobuf:"fixed64,4,opt,name=supply,proto3" json:"supply,omitempty"`
+	// max amount of the tokens could be issued
+	TotalSupply float64 `protobuf:"fixed64,5,opt,name=total_supply,json=totalSupply,proto3" json:"total_supply,omitempty"`
+	// decimal of the token
+	Decimal int32 `protobuf:"varint,6,opt,name=decimal,proto3" json:"decimal,omitempty"`
+	// whether the token can be transferred
+	CanTransfer bool `protobuf:"varint,7,opt,name=can_transfer,json=canTransfer,proto3" json:"can_transfer,omitempty"`
+	// whether a transfer needs the permission of the issuer
+	OnlyIssuerCanTransfer bool `protobuf:"varint,8,opt,name=only_issuer_can_transfer,json=onlyIssuerCanTransfer,proto3" json:"only_issuer_can_transfer,omitempty"`
+	// whether the issuer has paused the transfers
+	Paused               bool     `protobuf:"varint,9,opt,name=paused,proto3" json:"paused,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TokenInfo) Reset()         { *m = TokenInfo{} }
+func (m *TokenInfo) String() string { return proto.CompactTextString(m) }
+func (*TokenInfo) ProtoMessage()    {}
+func (*TokenInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{66}
+}
+
+func (m *TokenInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TokenInfo.Unmarshal(m, b)
+}
+func (m *TokenInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TokenInfo.Marshal(b, m, deterministic)
+}
+func (m *TokenInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenInfo.Merge(m, src)
+}
+func (m *TokenInfo) XXX_Size() int {
+	return xxx_messageInfo_TokenInfo.Size(m)
+}
+func (m *TokenInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenInfo proto.InternalMessageInfo
+
+func (m *TokenInfo) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *TokenInfo) GetFullName() string {
+	if m != nil {
+		return m.FullName
+	}
+	return ""
+}
+
+func (m *TokenInfo) GetIssuer() string {
+	if m != nil {
+		return m.Issuer
+	}
+	return ""
+}
+
+func (m *TokenInfo) GetSupply() float64 {
+	if m != nil {
+		return m.Supply
+	}
+	return 0
+}
+
+func (m *TokenInfo) GetTotalSupply() float64 {
+	if m != nil {
+		return m.TotalSupply
+	}
+	return 0
+}
+
+func (m *TokenInfo) GetDecimal() int32 {
+	if m != nil {
+		return m.Decimal
+	}
+	return 0
+}
+
+func (m *TokenInfo) GetCanTransfer() bool {
+	if m != nil {
+		return m.CanTransfer
+	}
+	return false
+}
+
+func (m *TokenInfo) GetOnlyIssuerCanTransfer() bool {
+	if m != nil {
+		return m.OnlyIssuerCanTransfer
+	}
+	return false
+}
+
+func (m *TokenInfo) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+// The message defines list tokens request.
+type ListTokensRequest struct {
+	// get data by longest chain's head block or last irreversible block
+	ByLongestChain bool `protobuf:"varint,1,opt,name=by_longest_chain,json=byLongestChain,proto3" json:"by_longest_chain,omitempty"`
+	// max number of returned tokens
+	Limit int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// cursor returned by the previous page, empty for the first page
+	Cursor               string   `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *SubscribeResponse) Reset()         { *m = SubscribeResponse{} }
-func (m *SubscribeResponse) String() string { return proto.CompactTextString(m) }
-func (*SubscribeResponse) ProtoMessage()    {}
-func (*SubscribeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{39}
+func (m *ListTokensRequest) Reset()         { *m = ListTokensRequest{} }
+func (m *ListTokensRequest) String() string { return proto.CompactTextString(m) }
+func (*ListTokensRequest) ProtoMessage()    {}
+func (*ListTokensRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{67}
 }
 
-func (m *SubscribeResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SubscribeResponse.Unmarshal(m, b)
+func (m *ListTokensRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListTokensRequest.Unmarshal(m, b)
 }
-func (m *SubscribeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SubscribeResponse.Marshal(b, m, deterministic)
+func (m *ListTokensRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListTokensRequest.Marshal(b, m, deterministic)
 }
-func (m *SubscribeResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SubscribeResponse.Merge(m, src)
+func (m *ListTokensRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListTokensRequest.Merge(m, src)
 }
-func (m *SubscribeResponse) XXX_Size() int {
-	return xxx_messageInfo_SubscribeResponse.Size(m)
+func (m *ListTokensRequest) XXX_Size() int {
+	return xxx_messageInfo_ListTokensRequest.Size(m)
 }
-func (m *SubscribeResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_SubscribeResponse.DiscardUnknown(m)
+func (m *ListTokensRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListTokensRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SubscribeResponse proto.InternalMessageInfo
+var xxx_messageInfo_ListTokensRequest proto.InternalMessageInfo
 
-func (m *SubscribeResponse) GetEvent() *Event {
+func (m *ListTokensRequest) GetByLongestChain() bool {
 	if m != nil {
-		return m.Event
+		return m.ByLongestChain
+	}
+	return false
+}
+
+func (m *ListTokensRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *ListTokensRequest) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
+// The message defines list tokens response.
+type ListTokensResponse struct {
+	// tokens in the order of symbol
+	Tokens []*TokenInfo `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens,omitempty"`
+	// cursor of the next page, empty if there are no more tokens
+	NextCursor           string   `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListTokensResponse) Reset()         { *m = ListTokensResponse{} }
+func (m *ListTokensResponse) String() string { return proto.CompactTextString(m) }
+func (*ListTokensResponse) ProtoMessage()    {}
+func (*ListTokensResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{68}
+}
+
+func (m *ListTokensResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListTokensResponse.Unmarshal(m, b)
+}
+func (m *ListTokensResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListTokensResponse.Marshal(b, m, deterministic)
+}
+func (m *ListTokensResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListTokensResponse.Merge(m, src)
+}
+func (m *ListTokensResponse) XXX_Size() int {
+	return xxx_messageInfo_ListTokensResponse.Size(m)
+}
+func (m *ListTokensResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListTokensResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListTokensResponse proto.InternalMessageInfo
+
+func (m *ListTokensResponse) GetTokens() []*TokenInfo {
+	if m != nil {
+		return m.Tokens
+	}
+	return nil
+}
+
+func (m *ListTokensResponse) GetNextCursor() string {
+	if m != nil {
+		return m.NextCursor
+	}
+	return ""
+}
+
+// The message defines the BLS public key of a witness for the aggregate attestations.
+type WitnessKey struct {
+	// BLS public key
+	Pubkey []byte `protobuf:"bytes,1,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	// proof of possession of the BLS secret key
+	Proof []byte `protobuf:"bytes,2,opt,name=proof,proto3" json:"proof,omitempty"`
+	// signature of the witness for the BLS public key
+	Signature            *Signature `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *WitnessKey) Reset()         { *m = WitnessKey{} }
+func (m *WitnessKey) String() string { return proto.CompactTextString(m) }
+func (*WitnessKey) ProtoMessage()    {}
+func (*WitnessKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{69}
+}
+
+func (m *WitnessKey) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WitnessKey.Unmarshal(m, b)
+}
+func (m *WitnessKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WitnessKey.Marshal(b, m, deterministic)
+}
+func (m *WitnessKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WitnessKey.Merge(m, src)
+}
+func (m *WitnessKey) XXX_Size() int {
+	return xxx_messageInfo_WitnessKey.Size(m)
+}
+func (m *WitnessKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_WitnessKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WitnessKey proto.InternalMessageInfo
+
+func (m *WitnessKey) GetPubkey() []byte {
+	if m != nil {
+		return m.Pubkey
+	}
+	return nil
+}
+
+func (m *WitnessKey) GetProof() []byte {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+func (m *WitnessKey) GetSignature() *Signature {
+	if m != nil {
+		return m.Signature
 	}
 	return nil
 }
@@ -3397,6 +5324,7 @@ func init() {
 	proto.RegisterEnum("rpcpb.Event_Topic", Event_Topic_name, Event_Topic_value)
 	proto.RegisterType((*EmptyRequest)(nil), "rpcpb.EmptyRequest")
 	proto.RegisterType((*NetworkInfo)(nil), "rpcpb.NetworkInfo")
+	proto.RegisterType((*GetRAMInfoRequest)(nil), "rpcpb.GetRAMInfoRequest")
 	proto.RegisterType((*RAMInfoResponse)(nil), "rpcpb.RAMInfoResponse")
 	proto.RegisterType((*NodeInfoResponse)(nil), "rpcpb.NodeInfoResponse")
 	proto.RegisterType((*AmountLimit)(nil), "rpcpb.AmountLimit")
@@ -3445,233 +5373,356 @@ func init() {
 	proto.RegisterType((*GetToken721MetadataResponse)(nil), "rpcpb.GetToken721MetadataResponse")
 	proto.RegisterType((*GetToken721OwnerResponse)(nil), "rpcpb.GetToken721OwnerResponse")
 	proto.RegisterType((*Event)(nil), "rpcpb.Event")
+	proto.RegisterType((*GetEventsRequest)(nil), "rpcpb.GetEventsRequest")
+	proto.RegisterType((*ReceiptEvent)(nil), "rpcpb.ReceiptEvent")
+	proto.RegisterType((*GetEventsResponse)(nil), "rpcpb.GetEventsResponse")
+	proto.RegisterType((*GetAccountTransactionsRequest)(nil), "rpcpb.GetAccountTransactionsRequest")
+	proto.RegisterType((*AccountTransaction)(nil), "rpcpb.AccountTransaction")
+	proto.RegisterType((*GetAccountTransactionsResponse)(nil), "rpcpb.GetAccountTransactionsResponse")
+	proto.RegisterType((*GetLIBAttestationRequest)(nil), "rpcpb.GetLIBAttestationRequest")
+	proto.RegisterType((*LIBAttestation)(nil), "rpcpb.LIBAttestation")
+	proto.RegisterType((*GetBlockHeaderRequest)(nil), "rpcpb.GetBlockHeaderRequest")
+	proto.RegisterType((*BlockHeader)(nil), "rpcpb.BlockHeader")
+	proto.RegisterType((*MerkleProof)(nil), "rpcpb.MerkleProof")
+	proto.RegisterType((*TxProofResponse)(nil), "rpcpb.TxProofResponse")
+	proto.RegisterType((*ReceiptProofResponse)(nil), "rpcpb.ReceiptProofResponse")
+	proto.RegisterType((*CancelTransactionRequest)(nil), "rpcpb.CancelTransactionRequest")
+	proto.RegisterType((*GetPendingTxsRequest)(nil), "rpcpb.GetPendingTxsRequest")
+	proto.RegisterType((*PendingTx)(nil), "rpcpb.PendingTx")
+	proto.RegisterType((*GetPendingTxsResponse)(nil), "rpcpb.GetPendingTxsResponse")
+	proto.RegisterType((*GetPendingTxCountResponse)(nil), "rpcpb.GetPendingTxCountResponse")
 	proto.RegisterType((*SubscribeRequest)(nil), "rpcpb.SubscribeRequest")
 	proto.RegisterType((*SubscribeRequest_Filter)(nil), "rpcpb.SubscribeRequest.Filter")
 	proto.RegisterType((*SubscribeResponse)(nil), "rpcpb.SubscribeResponse")
+	proto.RegisterType((*GetProducerStatsRequest)(nil), "rpcpb.GetProducerStatsRequest")
+	proto.RegisterType((*ProducerStats)(nil), "rpcpb.ProducerStats")
+	proto.RegisterType((*GetProducerStatsResponse)(nil), "rpcpb.GetProducerStatsResponse")
+	proto.RegisterType((*DevAdvanceTimeRequest)(nil), "rpcpb.DevAdvanceTimeRequest")
+	proto.RegisterType((*DevAdvanceTimeResponse)(nil), "rpcpb.DevAdvanceTimeResponse")
+	proto.RegisterType((*DevResetResponse)(nil), "rpcpb.DevResetResponse")
+	proto.RegisterType((*GetTokenInfoRequest)(nil), "rpcpb.GetTokenInfoRequest")
+	proto.RegisterType((*TokenInfo)(nil), "rpcpb.TokenInfo")
+	proto.RegisterType((*ListTokensRequest)(nil), "rpcpb.ListTokensRequest")
+	proto.RegisterType((*ListTokensResponse)(nil), "rpcpb.ListTokensResponse")
+	proto.RegisterType((*WitnessKey)(nil), "rpcpb.WitnessKey")
 }
 
 func init() { proto.RegisterFile("rpc/pb/rpc.proto", fileDescriptor_1b773bf3e696f610) }
 
 var fileDescriptor_1b773bf3e696f610 = []byte{
-	// 3477 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x39, 0x4d, 0x6f, 0x1b, 0xc9,
-	0x95, 0x6e, 0x7e, 0xf7, 0x23, 0x45, 0xd1, 0x25, 0xd9, 0xa6, 0xe9, 0x2f, 0xb9, 0xc7, 0x33, 0xfe,
-	0xc0, 0xac, 0x38, 0x96, 0xc7, 0xf6, 0xd8, 0xe3, 0xd9, 0x1d, 0x4a, 0xa6, 0x35, 0x82, 0x6d, 0x4a,
-	0xd3, 0xa4, 0xec, 0x1d, 0x60, 0x17, 0x3d, 0x4d, 0xb2, 0x44, 0x35, 0x4c, 0x76, 0x73, 0xbb, 0x9b,
-	0x36, 0xb5, 0x5a, 0x5f, 0xf6, 0x18, 0x04, 0x09, 0x06, 0x73, 0x48, 0x0e, 0xb9, 0xe4, 0x3a, 0x3f,
-	0x20, 0x09, 0x90, 0x9f, 0x90, 0x63, 0x0e, 0x39, 0xe6, 0x90, 0xfc, 0x80, 0x00, 0x73, 0x0e, 0x10,
-	0xd4, 0xab, 0xaa, 0xee, 0x66, 0xb3, 0x69, 0x2b, 0x40, 0x4e, 0xec, 0xf7, 0xea, 0xd5, 0x7b, 0xaf,
-	0xea, 0x7d, 0xd6, 0x23, 0x54, 0xdc, 0x71, 0xaf, 0x3e, 0xee, 0xd6, 0xdd, 0x71, 0x6f, 0x7d, 0xec,
-	0x3a, 0xbe, 0x43, 0xb2, 0xee, 0xb8, 0x37, 0xee, 0xd6, 0x2e, 0x0e, 0x1c, 0x67, 0x30, 0xa4, 0x75,
-	0x73, 0x6c, 0xd5, 0x4d, 0xdb, 0x76, 0x7c, 0xd3, 0xb7, 0x1c, 0xdb, 0xe3, 0x44, 0x5a, 0x19, 0x4a,
-	0xcd, 0xd1, 0xd8, 0x3f, 0xd2, 0xe9, 0xff, 0x4c, 0xa8, 0xe7, 0x6b, 0x8f, 0xa0, 0xd8, 0xa2, 0xfe,
-	0x1b, 0xc7, 0x7d, 0xb5, 0x63, 0x1f, 0x38, 0xa4, 0x0c, 0x29, 0xab, 0x5f, 0x55, 0xd6, 0x94, 0x1b,
-	0xaa, 0x9e, 0xb2, 0xfa, 0xe4, 0x12, 0xc0, 0x98, 0x52, 0xd7, 0xe8, 0x39, 0x13, 0xdb, 0xaf, 0xa6,
-	0xd6, 0x94, 0x1b, 0x59, 0x5d, 0x65, 0x98, 0x2d, 0x86, 0xd0, 0x7e, 0x50, 0x60, 0x59, 0x6f, 0x3c,
-	0x67, 0x5b, 0x75, 0xea, 0x8d, 0x1d, 0xdb, 0xa3, 0xe4, 0x3c, 0x14, 0x26, 0x1e, 0xed, 0x1b, 0xae,
-	0x39, 0x42, 0x46, 0x69, 0x3d, 0xcf, 0x60, 0xdd, 0x1c, 0x91, 0x0f, 0x60, 0xc9, 0x7c, 0x6d, 0x5a,
-	0x43, 0xb3, 0x3b, 0xa4, 0xb8, 0x9e, 0xc2, 0xf5, 0x52, 0x80, 0x64, 0x44, 0x17, 0x40, 0xf5, 0x1d,
-	0xdf, 0x1c, 0x22, 0x41, 0x1a, 0x09, 0x0a, 0x88, 0x60, 0x8b, 0x97, 0x00, 0x3c, 0x3a, 0x1c, 0x1a,
-	0x63, 0xd7, 0xea, 0xd1, 0x6a, 0x66, 0x4d, 0xb9, 0xa1, 0xe8, 0x2a, 0xc3, 0xec, 0x31, 0x04, 0xdb,
-	0xdb, 0x9d, 0x1c, 0x89, 0xd5, 0x2c, 0xae, 0x16, 0xba, 0x93, 0x23, 0x5c, 0xd4, 0x7e, 0xa6, 0x40,
-	0xa5, 0xe5, 0xf4, 0xe9, 0x8c, 0xb6, 0x97, 0x00, 0xba, 0x13, 0x6b, 0xd8, 0x37, 0x7c, 0x6b, 0x44,
-	0xc5, 0xc1, 0x55, 0xc4, 0x74, 0xac, 0x11, 0x1e, 0x66, 0x60, 0xf9, 0xc6, 0xa1, 0xe9, 0x1d, 0xa2,
-	0xb2, 0xaa, 0x9e, 0x1f, 0x58, 0xfe, 0x57, 0xa6, 0x77, 0x48, 0x08, 0x64, 0x46, 0x4e, 0x9f, 0xa2,
-	0x8a, 0xaa, 0x8e, 0xdf, 0xe4, 0x63, 0xc8, 0xdb, 0xfc, 0x36, 0x51, 0xb7, 0xe2, 0x06, 0x59, 0x47,
-	0xa3, 0xac, 0x47, 0xee, 0x58, 0x97, 0x24, 0xda, 0x03, 0x28, 0x36, 0x46, 0xec, 0x1e, 0x9f, 0x59,
-	0x23, 0xcb, 0x27, 0xab, 0x90, 0xf5, 0x9d, 0x57, 0xd4, 0x16, 0x5a, 0x70, 0x80, 0x61, 0x5f, 0x9b,
-	0xc3, 0x09, 0x15, 0xe2, 0x39, 0xa0, 0x7d, 0x03, 0xb9, 0x46, 0x8f, 0xd9, 0x95, 0xd4, 0xa0, 0xd0,
-	0x73, 0x6c, 0xdf, 0x35, 0x7b, 0xbe, 0xd8, 0x18, 0xc0, 0xe4, 0x0a, 0x14, 0x4d, 0xa4, 0x32, 0x6c,
-	0x73, 0x24, 0x39, 0x00, 0x47, 0xb5, 0xcc, 0x11, 0x65, 0x67, 0xe8, 0x9b, 0xbe, 0x29, 0xcf, 0xc0,
-	0xbe, 0xb5, 0x3f, 0x67, 0x40, 0xed, 0x4c, 0x75, 0xda, 0xa3, 0xd6, 0xd8, 0x27, 0xe7, 0x20, 0xef,
-	0x4f, 0xf9, 0xf9, 0x39, 0xf7, 0x9c, 0x3f, 0xc5, 0xe3, 0x5f, 0x00, 0x75, 0x60, 0x7a, 0xc6, 0xc4,
-	0x33, 0x07, 0x9c, 0xb3, 0xa2, 0x17, 0x06, 0xa6, 0xb7, 0xcf, 0x60, 0xf2, 0x39, 0xa8, 0xae, 0x39,
-	0x12, 0x8b, 0xe9, 0xb5, 0xf4, 0x8d, 0xe2, 0xc6, 0x65, 0x71, 0x13, 0x01, 0xeb, 0x75, 0xdd, 0x1c,
-	0x21, 0x75, 0xd3, 0xf6, 0xdd, 0x23, 0xbd, 0xe0, 0x0a, 0x90, 0x3c, 0x82, 0xa2, 0xe7, 0x9b, 0xfe,
-	0xc4, 0x33, 0x7a, 0xec, 0x7e, 0xd9, 0x45, 0x96, 0x37, 0x2e, 0xcc, 0x6d, 0x6f, 0x23, 0xcd, 0x96,
-	0xd3, 0xa7, 0x3a, 0x78, 0xc1, 0x37, 0xa9, 0x42, 0x7e, 0x44, 0x3d, 0x14, 0x9c, 0xe5, 0x06, 0x13,
-	0x20, 0x5b, 0x71, 0xa9, 0x3f, 0x71, 0x6d, 0xaf, 0x9a, 0x5b, 0x4b, 0xb3, 0x15, 0x01, 0x92, 0x4f,
-	0xa1, 0xe0, 0x72, 0xae, 0x5e, 0x35, 0x8f, 0xda, 0x56, 0xe7, 0xb5, 0xe5, 0xbf, 0x7a, 0x40, 0x59,
-	0xfb, 0x1c, 0x96, 0x66, 0x8e, 0x40, 0x2a, 0x90, 0x7e, 0x45, 0x8f, 0xc4, 0x3d, 0xb1, 0xcf, 0x59,
-	0xe3, 0xa5, 0x85, 0xf1, 0x1e, 0xa6, 0x3e, 0x53, 0x6a, 0x5f, 0x42, 0x5e, 0x5e, 0xf1, 0x05, 0x50,
-	0x0f, 0x26, 0x76, 0x8f, 0xdb, 0x48, 0x98, 0x90, 0x21, 0xd0, 0x42, 0x55, 0xc8, 0x33, 0x73, 0x52,
-	0x11, 0x7d, 0xaa, 0x2e, 0x41, 0xed, 0xb7, 0x0a, 0x40, 0x78, 0x07, 0xa4, 0x08, 0xf9, 0xf6, 0xfe,
-	0xd6, 0x56, 0xb3, 0xdd, 0xae, 0x9c, 0x22, 0xcb, 0x50, 0xdc, 0x6e, 0xb4, 0x0d, 0x7d, 0xbf, 0x65,
-	0xec, 0xee, 0x77, 0x2a, 0x0a, 0x39, 0x0b, 0x64, 0xb3, 0xf1, 0xac, 0xd1, 0xda, 0x6a, 0x1a, 0xad,
-	0xdd, 0x8e, 0xd1, 0x6c, 0xed, 0xee, 0x6f, 0x7f, 0x55, 0x49, 0x91, 0x15, 0x58, 0x7e, 0xa9, 0xef,
-	0xb6, 0xb6, 0x8d, 0xbd, 0x86, 0xde, 0x78, 0xde, 0xec, 0x34, 0xf5, 0x4a, 0x9a, 0x9c, 0x86, 0x25,
-	0x7d, 0xbf, 0xd5, 0xd9, 0x79, 0xde, 0x34, 0x9a, 0xba, 0xbe, 0xab, 0x57, 0x32, 0x8c, 0x3b, 0x83,
-	0x19, 0xb3, 0x6c, 0xb8, 0xa9, 0xf3, 0x9f, 0xc6, 0x93, 0x5d, 0xfd, 0x79, 0xa3, 0x53, 0xc9, 0x31,
-	0x09, 0x8f, 0xf7, 0xf7, 0x9e, 0xed, 0x6c, 0x35, 0x3a, 0x4d, 0xa3, 0xdd, 0xec, 0x18, 0x5b, 0xbb,
-	0x8f, 0x9b, 0x95, 0x3c, 0x63, 0xb6, 0xdf, 0x7a, 0xda, 0xda, 0x7d, 0xd9, 0x12, 0xcc, 0x0a, 0xda,
-	0x0f, 0x69, 0x28, 0x76, 0x5c, 0xd3, 0xf6, 0xb8, 0x27, 0x32, 0x2f, 0x8c, 0x38, 0x18, 0x7e, 0x33,
-	0x1c, 0x46, 0x24, 0xbf, 0x38, 0xfc, 0x26, 0x97, 0x01, 0xe8, 0x74, 0x6c, 0xb9, 0x98, 0xd0, 0x44,
-	0x6a, 0x88, 0x60, 0xa4, 0x4b, 0x22, 0x24, 0x72, 0x03, 0x73, 0x49, 0x9d, 0xc1, 0x72, 0x71, 0xc8,
-	0x42, 0x4d, 0xa6, 0x86, 0x81, 0xe9, 0x05, 0xa1, 0xd7, 0xa7, 0x43, 0xf3, 0xa8, 0x9a, 0xe3, 0x76,
-	0x42, 0x80, 0x05, 0x7f, 0xef, 0xd0, 0xb4, 0x6c, 0xc3, 0xea, 0x57, 0xf3, 0x6b, 0xca, 0x8d, 0x25,
-	0x3d, 0x8f, 0xf0, 0x4e, 0x9f, 0x5c, 0x87, 0x3c, 0x57, 0xde, 0xab, 0x16, 0xd0, 0x61, 0x96, 0x84,
-	0xc3, 0xf0, 0xa8, 0xd4, 0xe5, 0x2a, 0xb3, 0x9f, 0x67, 0x0d, 0x6c, 0xea, 0x7a, 0x55, 0x95, 0x3b,
-	0x9d, 0x00, 0xc9, 0x45, 0x50, 0xc7, 0x93, 0xee, 0xd0, 0xf2, 0x0e, 0xa9, 0x5b, 0x05, 0x9e, 0x78,
-	0x02, 0x04, 0x0b, 0x5d, 0x97, 0x1e, 0x50, 0xd7, 0xa5, 0x7d, 0xc3, 0x9f, 0x56, 0x8b, 0x3c, 0x74,
-	0x25, 0xaa, 0x33, 0x25, 0x77, 0xa1, 0x64, 0x62, 0xf2, 0x10, 0x47, 0x2a, 0xa1, 0x1a, 0x32, 0xdf,
-	0x44, 0xf2, 0x8a, 0x5e, 0x34, 0x23, 0x49, 0xa6, 0x0e, 0xe0, 0x4f, 0x0d, 0xe1, 0xc3, 0xd5, 0x25,
-	0x4c, 0x52, 0x95, 0xb8, 0xb3, 0xeb, 0xaa, 0x2f, 0x3f, 0xb5, 0xdf, 0x2b, 0xb0, 0x12, 0x31, 0x56,
-	0x90, 0x38, 0x1f, 0x40, 0x8e, 0x47, 0x1d, 0x9a, 0xad, 0xbc, 0x71, 0x55, 0x32, 0x99, 0xa7, 0x15,
-	0xa1, 0xaa, 0x8b, 0x0d, 0xe4, 0x53, 0x28, 0xfa, 0x21, 0x15, 0x9a, 0x38, 0xd4, 0x3c, 0xba, 0x3f,
-	0x4a, 0xa6, 0xdd, 0x81, 0x1c, 0xe7, 0xc3, 0x9c, 0x71, 0xaf, 0xd9, 0x7a, 0xbc, 0xd3, 0xda, 0xae,
-	0x9c, 0x22, 0x00, 0xb9, 0xbd, 0xc6, 0xd6, 0xd3, 0xe6, 0xe3, 0x8a, 0x42, 0x2a, 0x50, 0xda, 0xd1,
-	0xf5, 0xe6, 0x8b, 0xa6, 0xde, 0xde, 0xd9, 0x7c, 0xd6, 0xac, 0xa4, 0xb4, 0xdf, 0x29, 0xa0, 0xb6,
-	0xad, 0x81, 0x6d, 0xfa, 0x13, 0x97, 0x92, 0xcf, 0x40, 0x35, 0x87, 0x03, 0xc7, 0xb5, 0xfc, 0xc3,
-	0x91, 0x50, 0xbb, 0x26, 0xc4, 0x06, 0x44, 0xeb, 0x0d, 0x49, 0xa1, 0x87, 0xc4, 0xcc, 0x58, 0x9e,
-	0xa4, 0x40, 0x85, 0x4b, 0x7a, 0x88, 0xc0, 0x2a, 0xc9, 0x2c, 0xd7, 0x33, 0x58, 0xfc, 0xa7, 0xf9,
-	0x32, 0xc7, 0x3c, 0xa5, 0x47, 0xda, 0xa7, 0xa0, 0x06, 0x4c, 0x99, 0xf2, 0x22, 0x1e, 0x2a, 0xa7,
-	0xc8, 0x12, 0xa8, 0xed, 0xe6, 0xd6, 0xde, 0xc6, 0xdd, 0x7b, 0x4f, 0x6f, 0x57, 0x14, 0xb6, 0xd6,
-	0x7c, 0xbc, 0x71, 0xf7, 0xee, 0xed, 0x07, 0x95, 0x94, 0xf6, 0x9b, 0x34, 0x90, 0x99, 0xcb, 0xc4,
-	0x82, 0x1d, 0x04, 0x86, 0xb2, 0x30, 0x30, 0x52, 0xef, 0x0e, 0x8c, 0xf4, 0xbb, 0x02, 0x23, 0xb3,
-	0x28, 0x30, 0xb2, 0x8b, 0x02, 0x23, 0xb7, 0x30, 0x30, 0xf2, 0xef, 0x0c, 0x8c, 0xb8, 0xff, 0x16,
-	0x4e, 0xe6, 0xbf, 0x8b, 0xe3, 0xe9, 0x13, 0x80, 0xc0, 0x22, 0x5e, 0x15, 0x90, 0x5d, 0x25, 0x6e,
-	0x5d, 0x3d, 0x42, 0x33, 0x1b, 0x81, 0xc5, 0x78, 0x04, 0xde, 0x87, 0x72, 0x00, 0x18, 0x9e, 0x35,
-	0xf0, 0x44, 0x88, 0xcd, 0xf3, 0x5c, 0x0a, 0xe8, 0xda, 0xd6, 0xc0, 0xd3, 0xfe, 0x92, 0x86, 0xec,
-	0xe6, 0xd0, 0xe9, 0xbd, 0x4a, 0x4c, 0x6c, 0x55, 0xc8, 0xbf, 0xa6, 0xae, 0x17, 0x1a, 0x4a, 0x82,
-	0x2c, 0xe4, 0xc7, 0xa6, 0x4b, 0x6d, 0xd1, 0x6e, 0xf0, 0x9a, 0x0c, 0x1c, 0x85, 0x25, 0xf7, 0x1a,
-	0x94, 0xfd, 0xa9, 0x31, 0xa2, 0xee, 0xab, 0x21, 0xe5, 0x34, 0x19, 0xa4, 0x29, 0xf9, 0xd3, 0xe7,
-	0x88, 0x44, 0xaa, 0x3b, 0x70, 0x36, 0x8c, 0xf0, 0x19, 0x6a, 0x5e, 0x0f, 0x57, 0x82, 0xd8, 0x8e,
-	0x6c, 0x3a, 0x0b, 0x39, 0x7b, 0x32, 0xea, 0x52, 0x57, 0x64, 0x40, 0x01, 0x31, 0x6d, 0xdf, 0x58,
-	0xbe, 0x4d, 0x3d, 0x0f, 0x33, 0xa0, 0xaa, 0x4b, 0x30, 0xf0, 0xc3, 0x42, 0xc4, 0x0f, 0x67, 0x7a,
-	0x02, 0x35, 0xd6, 0x13, 0x9c, 0x87, 0x82, 0x3f, 0x15, 0x8d, 0x24, 0xf0, 0x93, 0xfb, 0x53, 0x6c,
-	0x23, 0xc9, 0x87, 0x90, 0xb1, 0xec, 0x03, 0x07, 0x6d, 0x50, 0xdc, 0x38, 0x2d, 0x2e, 0x18, 0xef,
-	0x70, 0x1d, 0x5b, 0x26, 0x5c, 0x26, 0xf7, 0xa0, 0x14, 0x49, 0x08, 0x5e, 0x2c, 0xe5, 0x45, 0x63,
-	0x65, 0x86, 0xae, 0xd6, 0x86, 0x0c, 0x36, 0xb7, 0xb2, 0x63, 0x53, 0xb0, 0x8d, 0xe5, 0x1d, 0xdb,
-	0x59, 0xc8, 0xf9, 0x87, 0x2e, 0x35, 0xfb, 0xa2, 0xb9, 0x15, 0x10, 0x33, 0x46, 0xd7, 0xf4, 0x7b,
-	0x87, 0x86, 0x65, 0xf7, 0xe9, 0x14, 0x7b, 0x98, 0xac, 0x0e, 0x88, 0xda, 0x61, 0x18, 0xed, 0x3b,
-	0x05, 0x96, 0x50, 0xc3, 0x20, 0x23, 0xde, 0x89, 0x65, 0xc4, 0x0b, 0xd1, 0x73, 0x2c, 0xca, 0x85,
-	0x1a, 0x64, 0xbb, 0x6c, 0x5d, 0x64, 0xc1, 0xd2, 0xcc, 0x1e, 0xbe, 0xa4, 0x5d, 0x4f, 0xce, 0x7c,
-	0xf1, 0x6c, 0xa7, 0x68, 0x7f, 0x48, 0xc1, 0xe9, 0x2d, 0x0c, 0xc4, 0x58, 0x43, 0x6e, 0x53, 0x3f,
-	0xda, 0x5e, 0xb0, 0x0e, 0x14, 0xbb, 0x8b, 0x9b, 0x50, 0xc1, 0x67, 0x41, 0xcf, 0x19, 0x1a, 0x51,
-	0xaf, 0x54, 0xf5, 0x65, 0x89, 0x7f, 0x21, 0xbc, 0x33, 0x1a, 0xf3, 0xe9, 0xd9, 0x98, 0xbf, 0x04,
-	0x70, 0x48, 0xcd, 0xbe, 0xc1, 0x0f, 0x92, 0x41, 0xdb, 0xaa, 0x0c, 0xc3, 0xa3, 0xe0, 0x23, 0x58,
-	0x0e, 0x97, 0xa3, 0x9e, 0xb8, 0x14, 0xd0, 0xc8, 0x8e, 0x72, 0x68, 0x75, 0x05, 0x17, 0xee, 0x86,
-	0x85, 0xa1, 0xd5, 0xe5, 0x4c, 0xae, 0x41, 0x39, 0x58, 0xe4, 0x3c, 0xb8, 0x3f, 0x96, 0x24, 0x05,
-	0xb2, 0xb8, 0x0a, 0x25, 0xe1, 0x9f, 0xc6, 0xd0, 0xf2, 0x78, 0x52, 0x51, 0xf5, 0xa2, 0xc0, 0x3d,
-	0xb3, 0x3c, 0x9f, 0xdc, 0x80, 0x0a, 0x63, 0x34, 0x43, 0xc6, 0x33, 0x09, 0x13, 0xf0, 0x32, 0xa4,
-	0xd4, 0x3e, 0x80, 0xa5, 0x0e, 0xf6, 0xba, 0x91, 0xd4, 0x1b, 0x0f, 0x67, 0x6d, 0x1b, 0xce, 0x6c,
-	0x53, 0x1f, 0x35, 0xd8, 0x3c, 0x7a, 0x0f, 0x31, 0xef, 0xd5, 0x47, 0xe3, 0x21, 0xf5, 0x79, 0x11,
-	0x29, 0xe8, 0x01, 0xac, 0x3d, 0x87, 0x73, 0x21, 0xa3, 0x16, 0x46, 0x9f, 0x64, 0x15, 0x06, 0xa7,
-	0x32, 0x13, 0x9c, 0xef, 0x62, 0xf7, 0x39, 0x2c, 0x3d, 0x71, 0x9d, 0xff, 0xa5, 0xf6, 0xa6, 0x39,
-	0x34, 0xed, 0x1e, 0x3a, 0x3a, 0xcf, 0xa3, 0xc8, 0x44, 0xd1, 0x05, 0x94, 0xd4, 0x68, 0x69, 0xff,
-	0x0d, 0x85, 0x17, 0x8e, 0x8f, 0x0f, 0x25, 0xb6, 0xcf, 0x19, 0x63, 0x5d, 0x11, 0xfd, 0x3f, 0x87,
-	0xb0, 0xb5, 0x75, 0x7c, 0xea, 0x89, 0xde, 0x9f, 0x03, 0xec, 0x85, 0xd7, 0x1b, 0x52, 0x93, 0x75,
-	0x2d, 0x7c, 0x95, 0x57, 0x9b, 0x92, 0x40, 0x32, 0xae, 0x9e, 0xf6, 0x2d, 0xd4, 0xb6, 0xa9, 0xbf,
-	0xe7, 0x3a, 0xfd, 0x49, 0x8f, 0xba, 0x52, 0x92, 0x3c, 0x6d, 0x95, 0x55, 0x90, 0x5e, 0xa0, 0xa9,
-	0xaa, 0x4b, 0x90, 0x99, 0xae, 0x7b, 0x64, 0x0c, 0x1d, 0x7b, 0x40, 0x3d, 0xdf, 0x40, 0xef, 0x13,
-	0xe7, 0x2e, 0x77, 0x8f, 0x9e, 0x71, 0x34, 0xba, 0xbf, 0xf6, 0x27, 0x05, 0x2e, 0x24, 0x8a, 0x10,
-	0x21, 0x71, 0x16, 0x72, 0xe3, 0x49, 0x37, 0x6c, 0xd6, 0x05, 0xc4, 0x3a, 0xf8, 0xa1, 0xd3, 0x13,
-	0x21, 0xc0, 0x3e, 0x19, 0x66, 0xe2, 0x0e, 0x45, 0x32, 0x66, 0x9f, 0xe4, 0x0c, 0xe4, 0x58, 0x38,
-	0x59, 0x7d, 0x91, 0x7d, 0xb3, 0x36, 0xf5, 0x77, 0x30, 0x61, 0x58, 0x9e, 0x31, 0x16, 0x12, 0xd1,
-	0xc3, 0x0b, 0x3a, 0x58, 0x9e, 0xd4, 0x81, 0xc9, 0x14, 0xe9, 0x21, 0xc7, 0x65, 0x8a, 0x0c, 0xc0,
-	0x2e, 0xd8, 0x1e, 0x5a, 0x36, 0x45, 0x8f, 0x2e, 0xe8, 0x02, 0x0a, 0x2f, 0xb8, 0x10, 0xb9, 0x60,
-	0xed, 0x00, 0x2a, 0xdb, 0xa2, 0x72, 0x07, 0xa7, 0x61, 0x2e, 0xed, 0xbc, 0x61, 0x77, 0x12, 0x56,
-	0x79, 0x6e, 0xe4, 0x32, 0xc7, 0xcb, 0x1d, 0x8c, 0x72, 0x44, 0xfb, 0x96, 0x69, 0x47, 0x28, 0xb9,
-	0xfd, 0xca, 0x1c, 0x2f, 0x29, 0xb5, 0xbf, 0xab, 0x90, 0x6f, 0x88, 0x7b, 0x27, 0x90, 0x89, 0x24,
-	0x0f, 0xfc, 0x66, 0x56, 0xea, 0x72, 0xcf, 0x12, 0x0c, 0x24, 0x48, 0x6e, 0x03, 0xcb, 0xf9, 0x06,
-	0x26, 0xf4, 0x34, 0x26, 0xb5, 0xb3, 0x41, 0x0b, 0x80, 0xfc, 0xd6, 0xb7, 0x4d, 0x8f, 0x3f, 0x84,
-	0x07, 0xfc, 0x83, 0x6d, 0x61, 0xcf, 0x45, 0xdc, 0x92, 0x49, 0xdc, 0x22, 0x87, 0x0c, 0x79, 0xd7,
-	0x1c, 0xe1, 0x96, 0x06, 0x14, 0xc7, 0xd4, 0x1d, 0x59, 0x9e, 0x87, 0xa5, 0x20, 0x8b, 0xa5, 0xe0,
-	0x4a, 0x6c, 0xd7, 0x5e, 0x48, 0xc1, 0x1f, 0x99, 0xd1, 0x3d, 0x64, 0x03, 0x72, 0x03, 0xd7, 0x99,
-	0x8c, 0xf9, 0x73, 0xb0, 0x18, 0xb4, 0x82, 0x81, 0x9a, 0xb8, 0xc8, 0x37, 0x0a, 0x4a, 0xf2, 0x05,
-	0x2c, 0x1f, 0x60, 0x58, 0x19, 0xe2, 0xb8, 0xb2, 0xcd, 0x59, 0x15, 0x9b, 0x67, 0x82, 0x4e, 0x2f,
-	0x1f, 0x44, 0x41, 0x8f, 0xac, 0x03, 0x30, 0x33, 0xe2, 0x49, 0xe5, 0xcb, 0x61, 0x59, 0xec, 0x0c,
-	0x9c, 0x54, 0x7d, 0x2d, 0xbe, 0xbc, 0xda, 0xbf, 0x03, 0xec, 0x0d, 0x69, 0x7f, 0xc0, 0x43, 0xb1,
-	0x0a, 0xf9, 0x31, 0x42, 0xae, 0x8c, 0x0c, 0x01, 0x46, 0x82, 0x3b, 0x15, 0x0d, 0xee, 0xda, 0x8f,
-	0x0a, 0xe4, 0xc5, 0x6d, 0x63, 0x68, 0x4e, 0x5c, 0xec, 0x2f, 0x70, 0x9c, 0x22, 0x5c, 0xa4, 0x24,
-	0x90, 0x1d, 0x86, 0x63, 0x05, 0x01, 0x4b, 0xe7, 0x01, 0x75, 0x71, 0x48, 0x33, 0x30, 0x65, 0x80,
-	0x2f, 0x47, 0xf1, 0xdb, 0xa6, 0x87, 0x4d, 0x2f, 0x8a, 0x47, 0x22, 0x1e, 0xe7, 0x2a, 0xc7, 0xb0,
-	0xe5, 0x0f, 0xa1, 0x6c, 0xd9, 0x3d, 0x97, 0x9a, 0x1e, 0x35, 0xbc, 0x31, 0xa5, 0x7d, 0xd1, 0x5b,
-	0x2e, 0x49, 0x6c, 0x9b, 0x21, 0x99, 0x97, 0x47, 0x9f, 0x64, 0x1c, 0x20, 0x8f, 0xa0, 0xc4, 0x39,
-	0xf5, 0xb9, 0x53, 0x70, 0x03, 0x9d, 0x8f, 0x9b, 0x37, 0xb8, 0x1a, 0xbd, 0x28, 0xc8, 0x19, 0x50,
-	0xfb, 0x1a, 0xf2, 0xc2, 0x5f, 0x58, 0x8b, 0x17, 0x0c, 0x97, 0x44, 0xf6, 0x0c, 0x11, 0xcc, 0xb1,
-	0x27, 0x1e, 0xed, 0xcb, 0xdc, 0xc7, 0xbe, 0xf9, 0x14, 0x86, 0x5d, 0x0f, 0x7f, 0x5f, 0x72, 0xa0,
-	0x66, 0x43, 0x66, 0xc7, 0xa7, 0xa3, 0xb9, 0xf9, 0xd8, 0x65, 0x8c, 0xfa, 0x57, 0xf4, 0xc8, 0x18,
-	0x9b, 0x96, 0x2b, 0xb2, 0x91, 0x6a, 0x79, 0x4f, 0xe9, 0xd1, 0x9e, 0x69, 0xa1, 0x61, 0xde, 0x50,
-	0x6b, 0x70, 0xe8, 0x0b, 0x76, 0x02, 0x62, 0x1d, 0x7b, 0xe8, 0x8a, 0x22, 0x91, 0x44, 0x30, 0xb5,
-	0x27, 0x90, 0x45, 0xf7, 0x4b, 0x8c, 0xbd, 0x9b, 0x90, 0xb5, 0x7c, 0x3a, 0x62, 0x96, 0x61, 0xd7,
-	0xb2, 0x12, 0xbb, 0x16, 0xa6, 0xa8, 0xce, 0x29, 0x6a, 0x3f, 0x51, 0x00, 0xc2, 0x28, 0x48, 0xe4,
-	0x76, 0x05, 0x8a, 0xe8, 0xdc, 0xd8, 0x20, 0x70, 0x9e, 0xaa, 0x0e, 0x88, 0x62, 0x3d, 0x82, 0x17,
-	0x8a, 0x4b, 0xbf, 0x4f, 0x1c, 0xbb, 0x6e, 0xd6, 0x3f, 0x79, 0x87, 0xce, 0xb0, 0x2f, 0x1b, 0x81,
-	0x00, 0x51, 0xfb, 0x06, 0x2a, 0xf1, 0x88, 0x4c, 0x98, 0x99, 0xd4, 0xa3, 0x33, 0x93, 0x04, 0xa3,
-	0x07, 0x1c, 0xa2, 0xe3, 0x94, 0x5d, 0x28, 0x46, 0xc2, 0x35, 0x81, 0xeb, 0xad, 0x59, 0xae, 0xab,
-	0x49, 0xb1, 0x1e, 0x61, 0xa8, 0x7d, 0x0d, 0xa7, 0xb7, 0xa9, 0x2f, 0x96, 0x23, 0x35, 0x7d, 0xee,
-	0xfa, 0x4e, 0x5e, 0x94, 0x7e, 0x54, 0xa0, 0xb0, 0x25, 0x47, 0x73, 0x71, 0x47, 0x22, 0x90, 0xc1,
-	0x69, 0x17, 0x2f, 0x3d, 0xf8, 0xcd, 0xea, 0xfb, 0xd0, 0xb4, 0x07, 0x13, 0x3e, 0x44, 0xc3, 0xb9,
-	0x90, 0x84, 0xa3, 0xcf, 0x08, 0xee, 0x3d, 0xc1, 0x33, 0xe2, 0x3a, 0x64, 0xcc, 0xae, 0x25, 0x53,
-	0xa2, 0xb4, 0x96, 0x14, 0xbc, 0xde, 0xd8, 0xdc, 0xd1, 0x91, 0xa0, 0xd6, 0x87, 0x74, 0x63, 0x73,
-	0x27, 0xf1, 0x50, 0x04, 0x32, 0xa6, 0x3b, 0x90, 0xce, 0x80, 0xdf, 0x73, 0x0f, 0xb6, 0xf4, 0x89,
-	0x1e, 0x6c, 0x5a, 0x0b, 0xc8, 0x36, 0xf5, 0xa5, 0x78, 0x79, 0x93, 0xf1, 0xe3, 0x9f, 0xfc, 0x16,
-	0xdf, 0xc2, 0xf9, 0x08, 0xbf, 0xb6, 0xef, 0xb8, 0xe6, 0x80, 0x2e, 0x62, 0x2b, 0xfc, 0x20, 0x35,
-	0x33, 0x91, 0x3b, 0xb0, 0xe8, 0xb0, 0x2f, 0x2e, 0x94, 0x03, 0x89, 0xe2, 0x33, 0x89, 0xe2, 0x5d,
-	0xec, 0x5d, 0xe6, 0xc4, 0x8b, 0x4a, 0x2c, 0xe7, 0xa9, 0x4a, 0x38, 0x4f, 0xc5, 0x09, 0x73, 0xd8,
-	0xb5, 0xa6, 0xc4, 0x84, 0x39, 0xda, 0xb2, 0xf2, 0x65, 0xd1, 0xe2, 0xf1, 0x3c, 0x51, 0x44, 0x1c,
-	0x6f, 0x03, 0xb5, 0x11, 0x5c, 0x99, 0x97, 0xf9, 0x84, 0x29, 0xee, 0x9d, 0xfc, 0xe0, 0x49, 0x47,
-	0x4c, 0x27, 0x1e, 0xf1, 0xff, 0x60, 0x6d, 0xb1, 0xb8, 0xb0, 0x81, 0xc2, 0x9b, 0x63, 0x6f, 0x1d,
-	0xe6, 0x22, 0x02, 0xfa, 0x17, 0x1c, 0x96, 0xc2, 0xb9, 0x36, 0xb5, 0xfb, 0x49, 0x23, 0xa7, 0xa4,
-	0x96, 0xfa, 0x1e, 0x94, 0xc7, 0x2e, 0x35, 0x22, 0x33, 0xad, 0xd4, 0x82, 0x99, 0x56, 0x69, 0xec,
-	0xd2, 0x00, 0xd2, 0x5c, 0x6c, 0xb7, 0x3b, 0xce, 0xab, 0xb0, 0x58, 0x4b, 0x31, 0x91, 0xd6, 0x46,
-	0x99, 0x6d, 0x6d, 0x12, 0xaa, 0x7f, 0xea, 0xe4, 0xd5, 0x5f, 0x73, 0xe1, 0xec, 0x9c, 0xcc, 0xf7,
-	0xf5, 0xbc, 0xc1, 0x9f, 0x02, 0xa9, 0xe8, 0x9f, 0x02, 0x27, 0x37, 0xa6, 0x8e, 0xfe, 0x8a, 0x32,
-	0xef, 0x6f, 0xdc, 0x7e, 0xcf, 0x51, 0xd3, 0xe1, 0x51, 0x6b, 0x50, 0x40, 0x51, 0x3b, 0x8f, 0x65,
-	0x16, 0x08, 0x60, 0xcd, 0x0b, 0xcf, 0x71, 0x7f, 0xe3, 0x76, 0xb4, 0x77, 0x4f, 0xfe, 0x0b, 0xe3,
-	0xbc, 0xe0, 0xc5, 0x7a, 0x66, 0x31, 0xc4, 0xe6, 0xbc, 0xfa, 0xff, 0xc4, 0x41, 0x1e, 0x60, 0x47,
-	0x2f, 0x85, 0x3e, 0xa7, 0xbe, 0xc9, 0xa2, 0x2b, 0x38, 0x49, 0x0d, 0x0a, 0x23, 0x81, 0x93, 0x33,
-	0x74, 0x09, 0x6b, 0x9f, 0x40, 0x35, 0xb2, 0x75, 0xf7, 0x8d, 0xcd, 0x9e, 0x56, 0x62, 0xdf, 0x2a,
-	0x64, 0x1d, 0x86, 0x90, 0x1a, 0x23, 0xa0, 0xfd, 0x54, 0x81, 0x6c, 0xf3, 0x35, 0xc5, 0x37, 0x47,
-	0xd6, 0x77, 0xc6, 0x56, 0x4f, 0xbc, 0xe9, 0x65, 0xba, 0xc3, 0xc5, 0xf5, 0x0e, 0x5b, 0xd1, 0x39,
-	0x41, 0x10, 0xfb, 0xa9, 0x48, 0xec, 0xcb, 0xc7, 0x55, 0x3a, 0xf2, 0xb8, 0xba, 0x0d, 0x59, 0xdc,
-	0x47, 0x56, 0xa1, 0xb2, 0xb5, 0xdb, 0xea, 0xe8, 0x8d, 0xad, 0x8e, 0xa1, 0x37, 0xb7, 0x9a, 0x3b,
-	0x7b, 0x9d, 0xca, 0x29, 0x42, 0xa0, 0x1c, 0x60, 0x9b, 0x2f, 0x9a, 0xad, 0x4e, 0x45, 0xd1, 0x7e,
-	0xad, 0x40, 0xa5, 0x3d, 0xe9, 0x7a, 0x3d, 0xd7, 0xea, 0x06, 0x3e, 0x73, 0x0b, 0x72, 0x28, 0x98,
-	0x87, 0x60, 0xb2, 0x6a, 0x82, 0x82, 0xdc, 0x63, 0xe1, 0x3a, 0xf4, 0xa9, 0x2b, 0xa2, 0x43, 0xfe,
-	0x19, 0x13, 0x67, 0xba, 0xfe, 0x04, 0xa9, 0x74, 0x41, 0x5d, 0xbb, 0x09, 0x39, 0x8e, 0x61, 0x5d,
-	0x82, 0xfc, 0x5b, 0xc9, 0x08, 0x32, 0x0d, 0x48, 0xd4, 0x4e, 0x5f, 0xbb, 0x0f, 0xa7, 0x23, 0xdc,
-	0xc4, 0xed, 0x6a, 0x90, 0xa5, 0x4c, 0x1d, 0xa4, 0x0f, 0xa7, 0x1b, 0xa8, 0xa2, 0xce, 0x97, 0x36,
-	0xfe, 0x76, 0x1a, 0xa0, 0x31, 0xb6, 0xda, 0xd4, 0x7d, 0x6d, 0xf5, 0x28, 0xf9, 0x1a, 0x8a, 0xdb,
-	0xd4, 0x97, 0xff, 0xd3, 0x11, 0x59, 0xbf, 0xa2, 0x7f, 0x5a, 0xd6, 0xce, 0xc9, 0x7f, 0xd5, 0x62,
-	0xff, 0xe6, 0x69, 0xab, 0xff, 0xff, 0xc7, 0xbf, 0x7e, 0x9f, 0x2a, 0x93, 0x52, 0x7d, 0x10, 0xe1,
-	0xd1, 0x81, 0x12, 0x4b, 0x68, 0x72, 0x30, 0x92, 0xcc, 0x53, 0xfe, 0xe3, 0x33, 0x37, 0x3f, 0xd1,
-	0xce, 0x20, 0xd3, 0x65, 0xb2, 0xc4, 0x98, 0x86, 0x5c, 0x5a, 0x00, 0xdb, 0xd4, 0x97, 0x8d, 0x66,
-	0x22, 0x4f, 0xf9, 0x8a, 0x89, 0xfd, 0x45, 0xaa, 0xad, 0x20, 0xc7, 0x25, 0x52, 0x64, 0x1c, 0x25,
-	0x87, 0xff, 0xc2, 0x83, 0x77, 0xa6, 0x7c, 0x8c, 0x40, 0x56, 0x83, 0x04, 0x16, 0x99, 0x2a, 0xd4,
-	0x6a, 0x8b, 0xa7, 0xec, 0xda, 0x05, 0xe4, 0x7a, 0x86, 0xac, 0x30, 0xae, 0x92, 0x4f, 0xfd, 0x98,
-	0xa5, 0xc9, 0xb7, 0xa4, 0x0f, 0xab, 0xc8, 0x5d, 0xe4, 0xbf, 0xcd, 0x23, 0xce, 0x77, 0x81, 0x98,
-	0xb9, 0xec, 0xa9, 0x5d, 0x43, 0xe6, 0x97, 0xc9, 0x45, 0xce, 0x3c, 0xc6, 0x46, 0x4a, 0x71, 0xa0,
-	0x3c, 0x3b, 0x0d, 0x21, 0x17, 0x05, 0xa7, 0xc4, 0x21, 0x49, 0x6d, 0x35, 0x69, 0x44, 0xa6, 0xdd,
-	0x44, 0x59, 0x1f, 0x90, 0xab, 0x4c, 0x56, 0x64, 0x97, 0x90, 0x52, 0x3f, 0x96, 0x53, 0x8e, 0xb7,
-	0xe4, 0x0d, 0x54, 0xe2, 0x53, 0x13, 0x72, 0x79, 0x4e, 0xe4, 0xcc, 0x38, 0x65, 0x81, 0xd0, 0x7f,
-	0x43, 0xa1, 0xd7, 0xc9, 0x87, 0x11, 0xa1, 0x7c, 0x5f, 0xfd, 0x98, 0x57, 0xab, 0x19, 0xc1, 0x14,
-	0xad, 0x2f, 0x5f, 0xc8, 0xd5, 0x50, 0xe4, 0x6c, 0xcb, 0x58, 0x2b, 0xcf, 0x36, 0x9a, 0xb3, 0x62,
-	0x04, 0xb2, 0x7e, 0xcc, 0x9a, 0xae, 0xb7, 0xf5, 0xe3, 0x78, 0x2a, 0x7c, 0x4b, 0x7e, 0xae, 0xc0,
-	0x72, 0xac, 0x66, 0x90, 0x4b, 0xa1, 0xb0, 0x84, 0x5a, 0x52, 0xbb, 0xbc, 0x68, 0x59, 0x1c, 0xf4,
-	0x0b, 0xd4, 0xe0, 0x3e, 0xb9, 0x8b, 0x96, 0x8c, 0x50, 0xd4, 0x8f, 0x45, 0xd1, 0x79, 0x5b, 0x3f,
-	0xc6, 0xfc, 0x9c, 0xa8, 0xd1, 0x2f, 0x15, 0x6c, 0xe8, 0x62, 0x15, 0xe5, 0x7d, 0x4a, 0x5d, 0x8d,
-	0x2d, 0xcf, 0xd7, 0x22, 0xed, 0x4b, 0xd4, 0xeb, 0x21, 0xf9, 0x2c, 0xd0, 0x2b, 0x24, 0x3a, 0x99,
-	0x6a, 0xbf, 0x52, 0x60, 0x25, 0xa1, 0x46, 0xcc, 0xe9, 0x36, 0x5b, 0xb4, 0x6a, 0xda, 0xfc, 0x72,
-	0xbc, 0xbc, 0x68, 0x9b, 0xa8, 0xdc, 0x23, 0xf2, 0x30, 0xaa, 0x9c, 0xa4, 0x0a, 0x75, 0x92, 0x65,
-	0x2e, 0x51, 0xbd, 0xef, 0x15, 0x74, 0xd6, 0x99, 0x3a, 0xf4, 0x3e, 0xdd, 0xae, 0xcc, 0x2f, 0xcf,
-	0xd4, 0x2f, 0xed, 0x3f, 0x50, 0xb1, 0x07, 0xe4, 0x7e, 0x54, 0x31, 0x24, 0x39, 0xa1, 0x56, 0x3c,
-	0xdf, 0x06, 0x13, 0xa2, 0x77, 0xe6, 0xdb, 0xf8, 0xe4, 0x69, 0x36, 0xdf, 0x06, 0x3c, 0x7e, 0xc1,
-	0xed, 0x10, 0x9f, 0xbe, 0x91, 0x88, 0x13, 0x2c, 0x18, 0xfe, 0x45, 0x6d, 0xb1, 0x68, 0x78, 0xa7,
-	0x3d, 0x40, 0xa1, 0x77, 0xc8, 0x6d, 0x26, 0x34, 0x4e, 0x15, 0xf5, 0x94, 0xf9, 0xc3, 0x0e, 0xf0,
-	0xb0, 0xc1, 0x23, 0xec, 0x7c, 0x28, 0x2d, 0xf6, 0x40, 0xa9, 0x2d, 0xc7, 0xde, 0x4d, 0xda, 0xc7,
-	0x28, 0xf5, 0x23, 0x72, 0x0d, 0xab, 0x80, 0xc0, 0xd6, 0x8f, 0x17, 0xdc, 0xea, 0xd1, 0xcc, 0xab,
-	0x47, 0xf4, 0xd0, 0x64, 0x6d, 0x5e, 0xde, 0xec, 0x03, 0x26, 0x1a, 0x27, 0x0b, 0xde, 0x18, 0xda,
-	0x65, 0x54, 0xa4, 0xaa, 0xad, 0x44, 0x15, 0x11, 0x44, 0x0f, 0x95, 0x5b, 0xe4, 0x3b, 0x05, 0xdb,
-	0x9d, 0xc4, 0xfe, 0x9d, 0x7c, 0xb4, 0x90, 0xff, 0xcc, 0x7b, 0xa2, 0x76, 0xfd, 0xbd, 0x74, 0x42,
-	0x1b, 0x51, 0x17, 0xb4, 0xf3, 0x09, 0xda, 0x70, 0x52, 0xa6, 0xd3, 0xb7, 0xb0, 0x1c, 0x6b, 0xea,
-	0x83, 0xbb, 0x9f, 0xff, 0x8b, 0x33, 0xc8, 0x60, 0x0b, 0xde, 0x01, 0x1a, 0x41, 0x99, 0x25, 0x2d,
-	0x5f, 0xf7, 0x18, 0xc5, 0x94, 0x49, 0xd0, 0x61, 0xb9, 0x39, 0xa5, 0xbd, 0x13, 0x4a, 0x98, 0xaf,
-	0x6f, 0x21, 0x4f, 0xca, 0xd8, 0x20, 0xcf, 0x97, 0xa0, 0x06, 0x2d, 0x0d, 0x39, 0xb7, 0xa0, 0x65,
-	0x0a, 0x1a, 0x87, 0xb9, 0xee, 0x47, 0x36, 0x0e, 0x1a, 0xd4, 0x3d, 0xb9, 0xf6, 0x50, 0xb9, 0xf5,
-	0x89, 0xd2, 0xcd, 0xe1, 0x9f, 0x2b, 0x77, 0xfe, 0x11, 0x00, 0x00, 0xff, 0xff, 0x0d, 0x63, 0x19,
-	0x0d, 0xc4, 0x25, 0x00, 0x00,
+	// 4983 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x3a, 0x49, 0x70, 0x1b, 0x49,
+	0x72, 0xd3, 0x00, 0x71, 0x25, 0x40, 0x10, 0x2a, 0x51, 0x12, 0xd4, 0xba, 0xa8, 0x9e, 0x4b, 0xa3,
+	0x1d, 0x13, 0x23, 0xce, 0xa1, 0x91, 0x66, 0xc6, 0x1e, 0x90, 0x84, 0x38, 0x0c, 0x49, 0x24, 0xb7,
+	0x09, 0x69, 0x66, 0x23, 0x6c, 0xf7, 0x36, 0x81, 0x22, 0xd8, 0x23, 0xa0, 0x1b, 0xee, 0x6e, 0x50,
+	0xa4, 0xe5, 0x09, 0x3b, 0xf6, 0xe9, 0x88, 0xb5, 0x63, 0x63, 0x1f, 0xf6, 0xc3, 0x1f, 0xfb, 0xb9,
+	0xe1, 0xb7, 0x1d, 0x61, 0x87, 0x5f, 0x7e, 0xf8, 0xb1, 0x4f, 0x3f, 0xfc, 0xb3, 0xfd, 0xf0, 0xc7,
+	0xef, 0xf5, 0xd7, 0x0e, 0x47, 0x65, 0x55, 0x75, 0x57, 0x1f, 0x10, 0xb9, 0x1b, 0x7e, 0x78, 0x5f,
+	0x40, 0x66, 0x65, 0x65, 0x56, 0x65, 0x65, 0x66, 0x65, 0x65, 0x36, 0xb4, 0xfc, 0xe9, 0xa0, 0x33,
+	0x3d, 0xe8, 0xf8, 0xd3, 0xc1, 0xea, 0xd4, 0xf7, 0x42, 0x8f, 0x94, 0xfc, 0xe9, 0x60, 0x7a, 0xa0,
+	0x5f, 0x1f, 0x79, 0xde, 0x68, 0x4c, 0x3b, 0xf6, 0xd4, 0xe9, 0xd8, 0xae, 0xeb, 0x85, 0x76, 0xe8,
+	0x78, 0x6e, 0xc0, 0x89, 0x8c, 0x26, 0x34, 0x7a, 0x93, 0x69, 0x78, 0x6a, 0xd2, 0xdf, 0x9b, 0xd1,
+	0x20, 0x34, 0x3e, 0x87, 0xfa, 0x0e, 0x0d, 0x5f, 0x7a, 0xfe, 0x8b, 0x6d, 0xf7, 0xd0, 0x23, 0x4d,
+	0x28, 0x38, 0xc3, 0xb6, 0xb6, 0xa2, 0xdd, 0xa9, 0x99, 0x05, 0x67, 0x48, 0x6e, 0x00, 0x4c, 0x29,
+	0xf5, 0xad, 0x81, 0x37, 0x73, 0xc3, 0x76, 0x61, 0x45, 0xbb, 0x53, 0x32, 0x6b, 0x0c, 0xb3, 0xc1,
+	0x10, 0xc6, 0x1f, 0xc2, 0x85, 0x2d, 0x1a, 0x9a, 0xdd, 0xa7, 0x6c, 0xb2, 0x60, 0x49, 0x6e, 0x43,
+	0xe3, 0x60, 0xec, 0x0d, 0x5e, 0x58, 0xee, 0x6c, 0x72, 0x40, 0x7d, 0xe4, 0x56, 0x34, 0xeb, 0x88,
+	0xdb, 0x41, 0x14, 0x63, 0xcb, 0x49, 0x8e, 0xec, 0xe0, 0x08, 0xd9, 0xd6, 0xcc, 0x1a, 0x62, 0xbe,
+	0xb2, 0x83, 0x23, 0x72, 0x07, 0x5a, 0xb3, 0x80, 0x5a, 0x09, 0x2e, 0xc5, 0x15, 0xed, 0x4e, 0xd5,
+	0x6c, 0xce, 0x02, 0xba, 0x1e, 0x33, 0x32, 0x7e, 0xa6, 0xc1, 0x52, 0x24, 0x3e, 0x98, 0x7a, 0x6e,
+	0x40, 0xc9, 0x55, 0xa8, 0xce, 0x02, 0x3a, 0xb4, 0x7c, 0x7b, 0x22, 0x64, 0x57, 0x18, 0x6c, 0xda,
+	0x13, 0xf2, 0x26, 0x2c, 0xda, 0xc7, 0xb6, 0x33, 0xb6, 0x0f, 0xc6, 0x14, 0xc7, 0x0b, 0x38, 0xde,
+	0x88, 0x90, 0x8c, 0xe8, 0x1a, 0xd4, 0x42, 0x2f, 0xb4, 0xc7, 0x48, 0x50, 0x44, 0x82, 0x2a, 0x22,
+	0xd8, 0xe0, 0x0d, 0x80, 0x80, 0x8e, 0xc7, 0xd6, 0xd4, 0x77, 0x06, 0xb4, 0xbd, 0xb0, 0xa2, 0xdd,
+	0xd1, 0xcc, 0x1a, 0xc3, 0xec, 0x31, 0x04, 0x9b, 0x7b, 0x30, 0x3b, 0x15, 0xa3, 0x25, 0x1c, 0xad,
+	0x1e, 0xcc, 0x4e, 0x71, 0xd0, 0xf8, 0x13, 0x0d, 0x5a, 0x3b, 0xde, 0x90, 0x26, 0x56, 0xcb, 0x54,
+	0x31, 0x73, 0xc6, 0x43, 0x2b, 0x74, 0x26, 0x54, 0x68, 0xbe, 0x86, 0x98, 0xbe, 0x33, 0xc1, 0xcd,
+	0x8c, 0x9c, 0x50, 0xd5, 0x53, 0x65, 0xe4, 0x84, 0xa8, 0x25, 0x02, 0x0b, 0x13, 0x6f, 0x48, 0x71,
+	0x89, 0x35, 0x13, 0xff, 0x93, 0xf7, 0xa1, 0xe2, 0xf2, 0xe3, 0xc4, 0xb5, 0xd5, 0xd7, 0xc8, 0x2a,
+	0x5a, 0xc5, 0xaa, 0x72, 0xc8, 0xa6, 0x24, 0x31, 0x1e, 0x40, 0xbd, 0x3b, 0x61, 0x07, 0xf9, 0xc4,
+	0x99, 0x38, 0x21, 0x59, 0x86, 0x52, 0xe8, 0xbd, 0xa0, 0xae, 0x58, 0x05, 0x07, 0x18, 0xf6, 0xd8,
+	0x1e, 0xcf, 0xa8, 0x10, 0xcf, 0x01, 0xe3, 0x07, 0x50, 0xee, 0x0e, 0x98, 0x61, 0x11, 0x1d, 0xaa,
+	0x03, 0xcf, 0x0d, 0x7d, 0x7b, 0x10, 0x8a, 0x89, 0x11, 0x4c, 0x6e, 0x41, 0xdd, 0x46, 0x2a, 0xcb,
+	0xb5, 0x27, 0x92, 0x03, 0x70, 0xd4, 0x8e, 0x3d, 0xa1, 0x6c, 0x0f, 0x43, 0x3b, 0xb4, 0xe5, 0x1e,
+	0xd8, 0x7f, 0xe3, 0xdf, 0x16, 0xa0, 0xd6, 0x3f, 0x31, 0xe9, 0x80, 0x3a, 0xd3, 0x90, 0x5c, 0x81,
+	0x4a, 0x78, 0xc2, 0xf7, 0xcf, 0xb9, 0x97, 0xc3, 0x13, 0xdc, 0xfe, 0x35, 0xa8, 0x8d, 0xec, 0xc0,
+	0x9a, 0x05, 0xf6, 0x88, 0x73, 0xd6, 0xcc, 0xea, 0xc8, 0x0e, 0x9e, 0x31, 0x98, 0x7c, 0x06, 0x35,
+	0xdf, 0x9e, 0x88, 0xc1, 0xe2, 0x4a, 0xf1, 0x4e, 0x7d, 0xed, 0xa6, 0xd0, 0x44, 0xc4, 0x7a, 0xd5,
+	0xb4, 0x27, 0x48, 0xdd, 0x73, 0x43, 0xff, 0xd4, 0xac, 0xfa, 0x02, 0x24, 0x9f, 0x43, 0x3d, 0x08,
+	0xed, 0x70, 0x16, 0x58, 0x03, 0xa6, 0x5f, 0xa6, 0xc8, 0xe6, 0xda, 0xb5, 0xcc, 0xf4, 0x7d, 0xa4,
+	0xd9, 0xf0, 0x86, 0xd4, 0x84, 0x20, 0xfa, 0x4f, 0xda, 0x50, 0x99, 0xd0, 0x00, 0x05, 0x97, 0xf8,
+	0x81, 0x09, 0x90, 0x8d, 0xf8, 0x34, 0x9c, 0xf9, 0x6e, 0xd0, 0x2e, 0xaf, 0x14, 0xd9, 0x88, 0x00,
+	0xc9, 0x47, 0x50, 0xf5, 0x39, 0xd7, 0xa0, 0x5d, 0xc1, 0xd5, 0xb6, 0xb3, 0xab, 0xe5, 0xbf, 0x66,
+	0x44, 0xa9, 0x7f, 0x06, 0x8b, 0x89, 0x2d, 0x90, 0x16, 0x14, 0x5f, 0xd0, 0x53, 0xa1, 0x27, 0xf6,
+	0x37, 0x79, 0x78, 0x45, 0x71, 0x78, 0x0f, 0x0b, 0x9f, 0x6a, 0xfa, 0x97, 0x50, 0x91, 0x2a, 0xbe,
+	0x06, 0xb5, 0xc3, 0x99, 0x3b, 0xe0, 0x67, 0x24, 0x8e, 0x90, 0x21, 0xf0, 0x84, 0xda, 0x50, 0x61,
+	0xc7, 0x49, 0x85, 0xfb, 0xd7, 0x4c, 0x09, 0x1a, 0x7f, 0xab, 0x01, 0xc4, 0x3a, 0x20, 0x75, 0xa8,
+	0xec, 0x3f, 0xdb, 0xd8, 0xe8, 0xed, 0xef, 0xb7, 0xde, 0x20, 0x4b, 0x50, 0xdf, 0xea, 0xee, 0x5b,
+	0xe6, 0xb3, 0x1d, 0x6b, 0xf7, 0x59, 0xbf, 0xa5, 0x91, 0xcb, 0x40, 0xd6, 0xbb, 0x4f, 0xba, 0x3b,
+	0x1b, 0x3d, 0x6b, 0x67, 0xb7, 0x6f, 0xf5, 0x76, 0x76, 0x9f, 0x6d, 0x7d, 0xd5, 0x2a, 0x90, 0x8b,
+	0xb0, 0xf4, 0xb5, 0xb9, 0xbb, 0xb3, 0x65, 0xed, 0x75, 0xcd, 0xee, 0xd3, 0x5e, 0xbf, 0x67, 0xb6,
+	0x8a, 0xe4, 0x02, 0x2c, 0x9a, 0xcf, 0x76, 0xfa, 0xdb, 0x4f, 0x7b, 0x56, 0xcf, 0x34, 0x77, 0xcd,
+	0xd6, 0x02, 0xe3, 0xce, 0x60, 0xc6, 0xac, 0x14, 0x4f, 0xea, 0x7f, 0x63, 0x3d, 0xda, 0x35, 0x9f,
+	0x76, 0xfb, 0xad, 0x32, 0x93, 0xb0, 0xf9, 0x6c, 0xef, 0xc9, 0xf6, 0x46, 0xb7, 0xdf, 0xb3, 0xf6,
+	0x7b, 0x7d, 0x6b, 0x63, 0x77, 0xb3, 0xd7, 0xaa, 0x30, 0x66, 0xcf, 0x76, 0x1e, 0xef, 0xec, 0x7e,
+	0xbd, 0x23, 0x98, 0x55, 0x8d, 0x9f, 0x15, 0xa1, 0xde, 0xf7, 0x6d, 0x37, 0xe0, 0x96, 0xc8, 0xac,
+	0x50, 0x31, 0x30, 0xfc, 0xcf, 0x70, 0xe8, 0x91, 0x5c, 0x71, 0xf8, 0x9f, 0xdc, 0x04, 0xa0, 0x27,
+	0x53, 0xc7, 0xc7, 0x88, 0x2a, 0x42, 0x83, 0x82, 0x91, 0x26, 0x89, 0x50, 0x7b, 0x21, 0x32, 0x49,
+	0x93, 0xc1, 0x72, 0x70, 0xcc, 0x5c, 0x4d, 0x86, 0x86, 0x91, 0x1d, 0x44, 0xae, 0x37, 0xa4, 0x63,
+	0xfb, 0xb4, 0x5d, 0xe6, 0xe7, 0x84, 0x00, 0x73, 0xfe, 0xc1, 0x91, 0xed, 0xb8, 0x96, 0x33, 0x6c,
+	0x57, 0x56, 0xb4, 0x3b, 0x8b, 0x66, 0x05, 0xe1, 0xed, 0x21, 0x79, 0x17, 0x2a, 0x7c, 0xf1, 0x41,
+	0xbb, 0x8a, 0x06, 0xb3, 0x28, 0x0c, 0x86, 0x7b, 0xa5, 0x29, 0x47, 0xd9, 0xf9, 0x05, 0xce, 0xc8,
+	0xa5, 0x7e, 0xd0, 0xae, 0x71, 0xa3, 0x13, 0x20, 0xb9, 0x0e, 0xb5, 0xe9, 0xec, 0x60, 0xec, 0x04,
+	0x47, 0xd4, 0x6f, 0x03, 0x0f, 0x3c, 0x11, 0x82, 0xb9, 0xae, 0x4f, 0x0f, 0xa9, 0xef, 0xd3, 0xa1,
+	0x15, 0x9e, 0xb4, 0xeb, 0xdc, 0x75, 0x25, 0xaa, 0x7f, 0x42, 0x3e, 0x86, 0x86, 0x8d, 0xc1, 0x43,
+	0x6c, 0xa9, 0xb1, 0x52, 0x54, 0xe2, 0x8d, 0x12, 0x57, 0xcc, 0xba, 0x1d, 0x03, 0xa4, 0x03, 0x10,
+	0x9e, 0x58, 0xc2, 0x86, 0xdb, 0x8b, 0x18, 0xa4, 0x5a, 0x69, 0x63, 0x37, 0x6b, 0xa1, 0xfc, 0x6b,
+	0xfc, 0xbd, 0x06, 0x17, 0x95, 0xc3, 0x8a, 0x02, 0xe7, 0x03, 0x28, 0x73, 0xaf, 0xc3, 0x63, 0x6b,
+	0xae, 0xdd, 0x96, 0x4c, 0xb2, 0xb4, 0xc2, 0x55, 0x4d, 0x31, 0x81, 0x7c, 0x04, 0xf5, 0x30, 0xa6,
+	0xc2, 0x23, 0x8e, 0x57, 0xae, 0xce, 0x57, 0xc9, 0x8c, 0x0f, 0xa1, 0xcc, 0xf9, 0x30, 0x63, 0xdc,
+	0xeb, 0xed, 0x6c, 0x6e, 0xef, 0x6c, 0xb5, 0xde, 0x20, 0x00, 0xe5, 0xbd, 0xee, 0xc6, 0xe3, 0xde,
+	0x66, 0x4b, 0x23, 0x2d, 0x68, 0x6c, 0x9b, 0x66, 0xef, 0x79, 0xcf, 0xdc, 0xdf, 0x5e, 0x7f, 0xd2,
+	0x6b, 0x15, 0x8c, 0x7f, 0xd2, 0xa0, 0xb6, 0xef, 0x8c, 0x5c, 0x3b, 0x9c, 0xf9, 0x94, 0x7c, 0x0a,
+	0x35, 0x7b, 0x3c, 0xf2, 0x7c, 0x27, 0x3c, 0x9a, 0x88, 0x65, 0xeb, 0x42, 0x6c, 0x44, 0xb4, 0xda,
+	0x95, 0x14, 0x66, 0x4c, 0xcc, 0x0e, 0x2b, 0x90, 0x14, 0xb8, 0xe0, 0x86, 0x19, 0x23, 0xf0, 0x9a,
+	0x66, 0x27, 0x37, 0xb0, 0x98, 0xff, 0x17, 0xf9, 0x30, 0xc7, 0x3c, 0xa6, 0xa7, 0xc6, 0x06, 0xd4,
+	0x22, 0xa6, 0x6c, 0xf1, 0xc2, 0x1f, 0x5a, 0x6f, 0x90, 0x45, 0xa8, 0xed, 0xf7, 0x36, 0xf6, 0xd6,
+	0x3e, 0xfe, 0xe4, 0xf1, 0xbd, 0x96, 0xc6, 0xc6, 0x7a, 0x9b, 0x6b, 0x1f, 0x7f, 0x7c, 0xef, 0x41,
+	0xab, 0xa0, 0x8c, 0x99, 0xf7, 0x5a, 0x45, 0xe3, 0x6f, 0x8a, 0x40, 0x12, 0xba, 0xe5, 0xb7, 0xbd,
+	0xf4, 0x13, 0x6d, 0xae, 0x9f, 0x14, 0x5e, 0xef, 0x27, 0xc5, 0xd7, 0xf9, 0xc9, 0xc2, 0x3c, 0x3f,
+	0x29, 0xcd, 0xf3, 0x93, 0xf2, 0x5c, 0x3f, 0xa9, 0xbc, 0xd6, 0x4f, 0xd2, 0xe6, 0x5c, 0x3d, 0x9f,
+	0x39, 0xcf, 0x77, 0xaf, 0x0f, 0x00, 0xa2, 0x03, 0x0a, 0xda, 0xb0, 0x52, 0x54, 0x0c, 0x3d, 0x3a,
+	0x6c, 0x53, 0xa1, 0x49, 0x3a, 0x64, 0x3d, 0xed, 0x90, 0xf7, 0xa1, 0x19, 0x01, 0x56, 0xe0, 0x8c,
+	0x82, 0x76, 0x63, 0x0e, 0xcf, 0xc5, 0x88, 0x6e, 0xdf, 0x19, 0x05, 0xc6, 0x8f, 0x16, 0xa0, 0x84,
+	0x39, 0x53, 0x6e, 0x9c, 0x6b, 0x43, 0xe5, 0x98, 0xfa, 0x41, 0x7c, 0x50, 0x12, 0x64, 0x11, 0x60,
+	0x6a, 0xfb, 0xd4, 0x15, 0xd9, 0x07, 0xbf, 0xa2, 0x81, 0xa3, 0xf0, 0x06, 0x7e, 0x0b, 0x9a, 0xe1,
+	0x89, 0x35, 0xa1, 0xfe, 0x8b, 0x31, 0xe5, 0x34, 0x0b, 0x48, 0xd3, 0x08, 0x4f, 0x9e, 0x22, 0x12,
+	0xa9, 0x3e, 0x84, 0xcb, 0xb1, 0xc3, 0x27, 0xa8, 0xf9, 0xf5, 0x78, 0x31, 0x72, 0x75, 0x65, 0xd2,
+	0x65, 0x28, 0x8b, 0xbc, 0x8f, 0x07, 0x44, 0x01, 0xb1, 0xd5, 0xbe, 0x74, 0x42, 0x97, 0x06, 0x01,
+	0x06, 0xc4, 0x9a, 0x29, 0xc1, 0xc8, 0x0e, 0xab, 0x8a, 0x1d, 0x26, 0x52, 0x84, 0x5a, 0x2a, 0x45,
+	0xb8, 0x0a, 0xd5, 0xf0, 0x44, 0x24, 0xb6, 0xc0, 0x77, 0x1e, 0x9e, 0x60, 0x5a, 0x4b, 0xde, 0x86,
+	0x05, 0xc7, 0x3d, 0xf4, 0xf0, 0x0c, 0xea, 0x6b, 0x17, 0x84, 0x82, 0x51, 0x87, 0xab, 0x98, 0x41,
+	0xe1, 0x30, 0xf9, 0x04, 0x1a, 0x4a, 0x7c, 0x08, 0x52, 0x11, 0x50, 0xf5, 0x95, 0x04, 0x1d, 0xe6,
+	0x90, 0xa1, 0x1d, 0x52, 0xcb, 0xf7, 0x3c, 0x1e, 0x02, 0x6b, 0x66, 0x0d, 0x31, 0xa6, 0xe7, 0x85,
+	0xfa, 0x3e, 0x2c, 0x30, 0x21, 0x51, 0x7e, 0xa7, 0x61, 0xd6, 0x8d, 0xff, 0x99, 0x5e, 0xc2, 0x23,
+	0x9f, 0xda, 0x43, 0x91, 0x8b, 0x0b, 0x88, 0x9d, 0xd5, 0x81, 0x1d, 0x0e, 0x8e, 0x2c, 0xc7, 0x1d,
+	0xd2, 0x13, 0xcc, 0x78, 0x4a, 0x26, 0x20, 0x6a, 0x9b, 0x61, 0x8c, 0x9f, 0x68, 0xb0, 0x88, 0x1b,
+	0x88, 0xe2, 0xe7, 0x87, 0xa9, 0xf8, 0x79, 0x4d, 0xdd, 0xe6, 0xbc, 0xc8, 0x69, 0x40, 0x09, 0xb3,
+	0x72, 0x11, 0x33, 0x1b, 0x89, 0x39, 0x7c, 0xc8, 0x78, 0x37, 0x3f, 0x4e, 0xa6, 0x63, 0xa3, 0x66,
+	0xfc, 0xbc, 0x00, 0x17, 0x36, 0xd0, 0x4f, 0x53, 0xe9, 0xbb, 0x4b, 0x43, 0x35, 0x19, 0x61, 0xf9,
+	0x2a, 0xe6, 0x22, 0xef, 0x41, 0x0b, 0x5f, 0x31, 0x03, 0x6f, 0x6c, 0xa9, 0x46, 0x5b, 0x33, 0x97,
+	0x24, 0xfe, 0x39, 0x47, 0x27, 0x42, 0x42, 0x31, 0x19, 0x12, 0x6e, 0x00, 0x1c, 0x51, 0x7b, 0xc8,
+	0x9f, 0x17, 0x68, 0xb2, 0x45, 0xb3, 0xc6, 0x30, 0xdc, 0x49, 0xde, 0x81, 0xa5, 0x78, 0x58, 0x35,
+	0xd4, 0xc5, 0x88, 0x46, 0xe6, 0x9f, 0x63, 0xe7, 0x40, 0x70, 0xe1, 0x56, 0x5a, 0x1d, 0x3b, 0x07,
+	0x9c, 0xc9, 0x5b, 0xd0, 0x8c, 0x06, 0x39, 0x0f, 0x6e, 0xae, 0x0d, 0x49, 0x81, 0x2c, 0x6e, 0x43,
+	0x43, 0x98, 0xaf, 0x35, 0x76, 0x02, 0x1e, 0x73, 0x6a, 0x66, 0x5d, 0xe0, 0x9e, 0x38, 0x41, 0xc8,
+	0x9e, 0x42, 0x8c, 0x51, 0x82, 0x8c, 0x07, 0x1a, 0x26, 0xe0, 0xeb, 0x98, 0xd2, 0x78, 0x13, 0x16,
+	0xfb, 0x98, 0x19, 0x2b, 0x91, 0x39, 0xed, 0xed, 0xc6, 0x16, 0x5c, 0xda, 0xa2, 0x21, 0xae, 0x60,
+	0xfd, 0xf4, 0x0c, 0x62, 0x9e, 0xd9, 0x4f, 0xa6, 0x63, 0x1a, 0xf2, 0x2b, 0xa7, 0x6a, 0x46, 0xb0,
+	0xf1, 0x14, 0xae, 0xc4, 0x8c, 0xf8, 0x63, 0x4c, 0xb2, 0x8a, 0x7d, 0x57, 0x4b, 0xf8, 0xee, 0xeb,
+	0xd8, 0x7d, 0x06, 0x8b, 0x8f, 0x7c, 0xef, 0xf7, 0xa9, 0xbb, 0x6e, 0x8f, 0x6d, 0x77, 0x80, 0x86,
+	0xce, 0xc3, 0x2c, 0x32, 0xd1, 0x4c, 0x01, 0xe5, 0xa5, 0x65, 0xc6, 0xef, 0x40, 0xf5, 0xb9, 0x17,
+	0xe2, 0xb3, 0x8a, 0xcd, 0xf3, 0xa6, 0x78, 0xed, 0x88, 0xd7, 0x02, 0x87, 0x30, 0x11, 0xf6, 0x42,
+	0x1a, 0x88, 0x97, 0x02, 0x07, 0xd8, 0x7b, 0x70, 0x30, 0xa6, 0x36, 0xcb, 0x71, 0xf8, 0x28, 0xbf,
+	0x8c, 0x1a, 0x02, 0xc9, 0xb8, 0x06, 0xc6, 0x0f, 0x41, 0xdf, 0xa2, 0xe1, 0x9e, 0xef, 0x0d, 0x67,
+	0x03, 0xea, 0x4b, 0x49, 0x72, 0xb7, 0x6d, 0x76, 0xc1, 0x0c, 0xa2, 0x95, 0xd6, 0x4c, 0x09, 0xb2,
+	0xa3, 0x3b, 0x38, 0xb5, 0xc6, 0x9e, 0x3b, 0xa2, 0x41, 0x68, 0xa1, 0xf5, 0x89, 0x7d, 0x37, 0x0f,
+	0x4e, 0x9f, 0x70, 0x34, 0x9a, 0xbf, 0xf1, 0x2f, 0x1a, 0x5c, 0xcb, 0x15, 0x21, 0x5c, 0xe2, 0x32,
+	0x94, 0xa7, 0xb3, 0x83, 0x38, 0xb5, 0x17, 0x10, 0xcb, 0xf7, 0xc7, 0xde, 0x40, 0xb8, 0x00, 0xfb,
+	0xcb, 0x30, 0x33, 0x7f, 0x2c, 0x62, 0x35, 0xfb, 0x4b, 0x2e, 0x41, 0x99, 0xb9, 0x93, 0x33, 0x14,
+	0xc1, 0xb9, 0xe4, 0xd2, 0x70, 0x1b, 0x03, 0x86, 0x13, 0x58, 0x53, 0x21, 0x11, 0x2d, 0xbc, 0x6a,
+	0x82, 0x13, 0xc8, 0x35, 0x30, 0x99, 0x22, 0x3c, 0x94, 0xb9, 0x4c, 0x0e, 0xa1, 0x82, 0xdd, 0xb1,
+	0xe3, 0x52, 0xb4, 0xe8, 0xaa, 0x29, 0xa0, 0x58, 0xc1, 0x55, 0x45, 0xc1, 0xc6, 0x21, 0xb4, 0xb6,
+	0xc4, 0xc5, 0x1e, 0xed, 0x86, 0x99, 0xb4, 0xf7, 0x92, 0xe9, 0x24, 0x4e, 0x02, 0xf8, 0x21, 0x37,
+	0x39, 0x5e, 0xce, 0x60, 0x94, 0x13, 0x3a, 0x74, 0x6c, 0x57, 0xa1, 0xe4, 0xe7, 0xd7, 0xe4, 0x78,
+	0x49, 0x69, 0xfc, 0x77, 0x0d, 0x2a, 0x5d, 0xa1, 0x77, 0x02, 0x0b, 0x4a, 0xf0, 0xc0, 0xff, 0xec,
+	0x94, 0x0e, 0xb8, 0x65, 0x09, 0x06, 0x12, 0x24, 0xf7, 0x80, 0x5d, 0x09, 0x16, 0xc6, 0xfb, 0x22,
+	0x06, 0xb5, 0xcb, 0x51, 0x86, 0x80, 0xfc, 0x56, 0xb7, 0xec, 0x80, 0x3f, 0x9b, 0x47, 0xfc, 0x0f,
+	0x9b, 0xc2, 0x1e, 0x97, 0x38, 0x65, 0x21, 0x77, 0x8a, 0x2c, 0x49, 0x54, 0x7c, 0x7b, 0x82, 0x53,
+	0xba, 0x50, 0x9f, 0x52, 0x7f, 0xe2, 0x04, 0x01, 0xde, 0x14, 0x25, 0xbc, 0x29, 0x6e, 0xa5, 0x66,
+	0xed, 0xc5, 0x14, 0xfc, 0x49, 0xaa, 0xce, 0x21, 0x6b, 0x50, 0x1e, 0xf9, 0xde, 0x6c, 0xca, 0x1f,
+	0x8f, 0xf5, 0x35, 0x3d, 0x35, 0x7b, 0x0b, 0x07, 0xf9, 0x44, 0x41, 0x49, 0xbe, 0x80, 0xa5, 0x43,
+	0x74, 0x2b, 0x4b, 0x6c, 0x57, 0x66, 0x41, 0xcb, 0x62, 0x72, 0xc2, 0xe9, 0xcc, 0xe6, 0xa1, 0x0a,
+	0x06, 0x64, 0x15, 0x80, 0x1d, 0x23, 0xee, 0x54, 0xbe, 0x33, 0x96, 0xc4, 0xcc, 0xc8, 0x48, 0x6b,
+	0xc7, 0xe2, 0x5f, 0xa0, 0xff, 0x26, 0xc0, 0xde, 0x98, 0x0e, 0x47, 0x08, 0x32, 0x9d, 0x4f, 0x11,
+	0xf2, 0xa5, 0x67, 0x08, 0x50, 0x71, 0xee, 0x82, 0xea, 0xdc, 0xfa, 0x2f, 0x34, 0xa8, 0x08, 0x6d,
+	0xa3, 0x6b, 0xce, 0x7c, 0x4c, 0x3f, 0xb0, 0xf8, 0x22, 0x4c, 0xa4, 0x21, 0x90, 0x7d, 0x86, 0x63,
+	0x17, 0x02, 0xde, 0xac, 0x87, 0xd4, 0xc7, 0x92, 0xce, 0xc8, 0x96, 0x0e, 0xbe, 0xa4, 0xe2, 0xb7,
+	0x6c, 0xbc, 0x74, 0xb9, 0x78, 0x24, 0xe2, 0x7e, 0x5e, 0xe3, 0x18, 0x36, 0xfc, 0x36, 0x34, 0x1d,
+	0x77, 0xe0, 0x53, 0x3b, 0xa0, 0x56, 0x30, 0xa5, 0x74, 0x28, 0x52, 0xcf, 0x45, 0x89, 0xdd, 0x67,
+	0x48, 0x66, 0xe5, 0xea, 0x03, 0x8e, 0x03, 0xe4, 0x73, 0x68, 0x70, 0x4e, 0x43, 0x6e, 0x14, 0xfc,
+	0x80, 0xae, 0xa6, 0x8f, 0x37, 0x52, 0x8d, 0x59, 0x17, 0xe4, 0x0c, 0xd0, 0xbf, 0x0f, 0x15, 0x61,
+	0x2f, 0x2c, 0x03, 0x8c, 0x4a, 0x51, 0x22, 0x7a, 0xc6, 0x08, 0x66, 0xd8, 0xac, 0x90, 0x25, 0x63,
+	0xdf, 0x2c, 0xe0, 0x0b, 0xe2, 0xea, 0xe1, 0xaf, 0x51, 0x0e, 0xe8, 0x2e, 0x2c, 0x6c, 0x87, 0x74,
+	0x92, 0x29, 0xe7, 0xdd, 0x44, 0xaf, 0x7f, 0x41, 0x4f, 0xad, 0xa9, 0xed, 0xf8, 0x22, 0x1a, 0xd5,
+	0x9c, 0xe0, 0x31, 0x3d, 0xdd, 0xb3, 0x1d, 0x3c, 0x98, 0x97, 0xd4, 0x19, 0x1d, 0x85, 0x82, 0x9d,
+	0x80, 0x58, 0x42, 0x1f, 0x9b, 0xa2, 0x08, 0x24, 0x0a, 0x46, 0x7f, 0x04, 0x25, 0x34, 0xbf, 0x5c,
+	0xdf, 0x7b, 0x0f, 0x4a, 0x4e, 0x48, 0x27, 0xec, 0x64, 0x98, 0x5a, 0x2e, 0xa6, 0xd4, 0xc2, 0x16,
+	0x6a, 0x72, 0x0a, 0xfd, 0x8f, 0x35, 0x80, 0xd8, 0x0b, 0x72, 0xb9, 0xdd, 0x82, 0x3a, 0x1a, 0x37,
+	0x26, 0x08, 0x9c, 0x67, 0xcd, 0x04, 0x44, 0xb1, 0x1c, 0x21, 0x88, 0xc5, 0x15, 0xcf, 0x12, 0xc7,
+	0xd4, 0xcd, 0xf2, 0xa7, 0xe0, 0xc8, 0x1b, 0x0f, 0x65, 0x22, 0x10, 0x21, 0xf4, 0x1f, 0x40, 0x2b,
+	0xed, 0x91, 0x39, 0x15, 0x96, 0x8e, 0x5a, 0x61, 0xc9, 0x39, 0xf4, 0x88, 0x83, 0x5a, 0x7c, 0xd9,
+	0x85, 0xba, 0xe2, 0xae, 0x39, 0x5c, 0xef, 0x26, 0xb9, 0x2e, 0xe7, 0xf9, 0xba, 0xc2, 0xd0, 0xf8,
+	0x07, 0x0d, 0x2b, 0xb1, 0x62, 0x5c, 0xb9, 0xd4, 0x33, 0xfa, 0x3b, 0xf7, 0xad, 0x94, 0xa9, 0xe3,
+	0x16, 0xcf, 0xaa, 0xe3, 0x2e, 0x9c, 0xa7, 0x8e, 0x5b, 0xca, 0xad, 0xe3, 0xfe, 0x42, 0x83, 0xea,
+	0x86, 0xac, 0x1a, 0xa6, 0xad, 0x96, 0xc0, 0x02, 0x16, 0xe2, 0xf8, 0x3d, 0x87, 0xff, 0x59, 0x32,
+	0x31, 0xb6, 0xdd, 0xd1, 0x8c, 0xd7, 0xf7, 0x18, 0x3e, 0x82, 0xd5, 0x27, 0x0d, 0x5f, 0x92, 0x04,
+	0xc9, 0xbb, 0xb0, 0x60, 0x1f, 0x38, 0x32, 0xfe, 0x4a, 0xd3, 0x90, 0x82, 0x57, 0xbb, 0xeb, 0xdb,
+	0x26, 0x12, 0xe8, 0x43, 0x28, 0x76, 0xd7, 0xb7, 0x73, 0x15, 0x48, 0x60, 0xc1, 0xf6, 0x47, 0xd2,
+	0xf2, 0xf0, 0x7f, 0xe6, 0xf1, 0x58, 0x3c, 0xd7, 0xe3, 0xd1, 0xd8, 0x01, 0xb2, 0x45, 0x43, 0x29,
+	0x5e, 0x9e, 0x5a, 0x7a, 0xfb, 0xe7, 0xcf, 0x23, 0xfe, 0x53, 0x83, 0xab, 0x0a, 0xc3, 0xfd, 0xd0,
+	0xf3, 0xed, 0x11, 0x9d, 0xc7, 0x57, 0x58, 0x5d, 0x21, 0x51, 0x2d, 0x3c, 0x74, 0xe8, 0x78, 0x28,
+	0x34, 0xca, 0x81, 0x5c, 0xf9, 0x0b, 0xe7, 0xb2, 0x98, 0xd2, 0x59, 0x16, 0x53, 0x3e, 0x8f, 0xc5,
+	0x54, 0x72, 0x2d, 0xc6, 0x07, 0x3d, 0x6f, 0xab, 0x22, 0xc7, 0x90, 0x75, 0x65, 0x2d, 0xae, 0x2b,
+	0x9f, 0xd5, 0x74, 0x38, 0xdb, 0xdc, 0x8d, 0x09, 0xdc, 0xca, 0xca, 0x7c, 0xc4, 0x94, 0x14, 0x9c,
+	0x5f, 0xc9, 0x79, 0xea, 0x2c, 0xe6, 0x1e, 0xe7, 0x1f, 0xc0, 0xca, 0x7c, 0x71, 0x71, 0x6a, 0x88,
+	0xa7, 0xc4, 0x5e, 0x71, 0xcc, 0x1e, 0x05, 0xf4, 0x7f, 0xb0, 0x59, 0x0a, 0x57, 0xf6, 0xa9, 0x3b,
+	0xcc, 0x2b, 0xbd, 0xe5, 0x3d, 0x16, 0x3e, 0x81, 0xe6, 0xd4, 0xa7, 0x96, 0x52, 0xdb, 0x2b, 0xcc,
+	0xa9, 0xed, 0x35, 0xa6, 0x3e, 0x8d, 0x20, 0xc3, 0xc7, 0x87, 0x44, 0xdf, 0x7b, 0x11, 0xe5, 0x1d,
+	0x91, 0x18, 0x25, 0x69, 0xd3, 0x92, 0x49, 0x5b, 0x4e, 0x5e, 0x53, 0x38, 0x7f, 0x5e, 0x63, 0xfc,
+	0xab, 0x06, 0x97, 0x33, 0x42, 0xcf, 0x4a, 0xe7, 0xa3, 0xee, 0x48, 0x41, 0xed, 0x8e, 0x9c, 0xfb,
+	0x34, 0x33, 0x2a, 0x5f, 0x38, 0xcb, 0x39, 0x4a, 0xe7, 0x71, 0x8e, 0x72, 0xae, 0x73, 0x98, 0xa0,
+	0xcb, 0xfd, 0xdd, 0x5f, 0xbb, 0x77, 0x86, 0x5e, 0x8b, 0xb1, 0x5e, 0x75, 0xa8, 0xe2, 0xb6, 0xb6,
+	0x37, 0x65, 0x7c, 0x8b, 0x60, 0x23, 0x88, 0x75, 0x76, 0x7f, 0xed, 0x9e, 0xfa, 0x04, 0xca, 0xef,
+	0x1b, 0x5d, 0x15, 0xbc, 0xd8, 0xd3, 0x43, 0x74, 0x0e, 0x38, 0xaf, 0xe1, 0x2f, 0xe1, 0x02, 0x0f,
+	0xe0, 0x9a, 0x22, 0xf4, 0x29, 0x0d, 0x6d, 0xe6, 0xca, 0xd1, 0x4e, 0x74, 0xa8, 0x4e, 0x04, 0x4e,
+	0x36, 0x2e, 0x24, 0x6c, 0x7c, 0x00, 0x6d, 0x65, 0xea, 0xee, 0x4b, 0x97, 0xfa, 0xd1, 0xbc, 0x65,
+	0x28, 0x79, 0x0c, 0x21, 0x57, 0x8c, 0x80, 0xf1, 0x3f, 0x1a, 0x94, 0x7a, 0xc7, 0x14, 0x9f, 0x6e,
+	0xa5, 0xd0, 0x9b, 0x3a, 0x03, 0x51, 0x1a, 0x91, 0x81, 0x1c, 0x07, 0x57, 0xfb, 0x6c, 0xc4, 0xe4,
+	0x04, 0x51, 0xa0, 0x29, 0x28, 0x81, 0x46, 0xbe, 0x51, 0x8b, 0xca, 0x1b, 0xf5, 0xaf, 0x34, 0x28,
+	0xe1, 0x44, 0xb2, 0x0c, 0xad, 0x8d, 0xdd, 0x9d, 0xbe, 0xd9, 0xdd, 0xe8, 0x5b, 0x66, 0x6f, 0xa3,
+	0xb7, 0xbd, 0xd7, 0x6f, 0xbd, 0x41, 0x08, 0x34, 0x23, 0x6c, 0xef, 0x79, 0x6f, 0x87, 0xf5, 0x4c,
+	0x96, 0xa0, 0xde, 0xff, 0xc6, 0xea, 0x6e, 0x6c, 0xf4, 0xf6, 0xfa, 0xbd, 0x4d, 0x5e, 0x91, 0xed,
+	0x7f, 0x63, 0x89, 0x6a, 0x73, 0x91, 0xb5, 0x41, 0xfa, 0xdf, 0x58, 0x89, 0xa2, 0xca, 0x02, 0x69,
+	0x02, 0xf4, 0xbf, 0xb1, 0x36, 0xcd, 0xdd, 0xbd, 0xbd, 0xde, 0x66, 0xab, 0x44, 0x1a, 0x50, 0xdd,
+	0xe9, 0x7d, 0x6d, 0x7d, 0xd5, 0xeb, 0x6e, 0xb6, 0xca, 0xac, 0x22, 0xc3, 0xa0, 0x27, 0xdb, 0xeb,
+	0xad, 0x0a, 0xe3, 0xbf, 0xf1, 0x55, 0x77, 0x7b, 0xc7, 0x32, 0x7b, 0xbb, 0xe6, 0x56, 0xab, 0x6a,
+	0xfc, 0xb5, 0x06, 0xad, 0x2d, 0x1a, 0xe2, 0x36, 0xa3, 0x88, 0x76, 0x03, 0xe0, 0xd0, 0xf7, 0x26,
+	0xa2, 0xd0, 0x21, 0x92, 0x52, 0x86, 0xe1, 0x95, 0x0e, 0x3c, 0x66, 0x2b, 0x2e, 0x0a, 0xb1, 0x32,
+	0x9a, 0xc7, 0x87, 0x6e, 0x43, 0x43, 0x76, 0x02, 0x2d, 0x67, 0xc8, 0x13, 0xb2, 0x9a, 0x59, 0x97,
+	0xb8, 0xed, 0x21, 0xa6, 0xdd, 0xa2, 0x9d, 0x64, 0x4d, 0x7d, 0x7a, 0xe8, 0x9c, 0x88, 0x1b, 0x7b,
+	0x51, 0x60, 0xf7, 0x10, 0x99, 0x4c, 0xbb, 0x4b, 0x22, 0xed, 0x66, 0x3a, 0x6d, 0x88, 0x30, 0xc2,
+	0x8f, 0xed, 0x1c, 0x9d, 0x67, 0xa5, 0x9d, 0x58, 0x48, 0xb4, 0x13, 0x6f, 0x41, 0x5d, 0x59, 0xac,
+	0xac, 0x76, 0xc6, 0x6b, 0x4d, 0x76, 0xc9, 0x16, 0xe6, 0x77, 0xc9, 0x4a, 0xc9, 0x2e, 0xd9, 0x97,
+	0x98, 0x98, 0x49, 0x95, 0x0a, 0xfb, 0xfb, 0x1e, 0x94, 0x29, 0x62, 0xda, 0x5a, 0x22, 0x13, 0x51,
+	0x77, 0x63, 0x0a, 0x12, 0xc3, 0x86, 0x1b, 0x71, 0x6a, 0xa7, 0x84, 0xe3, 0xe0, 0x75, 0x69, 0x5e,
+	0xa4, 0xb1, 0x82, 0xa2, 0x31, 0x76, 0x5b, 0x0c, 0x66, 0x7e, 0xe0, 0xf9, 0x62, 0x7f, 0x02, 0x32,
+	0x26, 0x40, 0xb2, 0xfc, 0xcf, 0xa3, 0xce, 0x5f, 0xad, 0x93, 0xf2, 0x47, 0x1a, 0xdc, 0x9c, 0xb7,
+	0x25, 0xa1, 0xa1, 0x2f, 0x52, 0xb5, 0x55, 0x2d, 0xef, 0x49, 0x35, 0xbf, 0xc4, 0x7a, 0x0b, 0xea,
+	0x2e, 0x3d, 0x09, 0x2d, 0xb1, 0x5b, 0xd1, 0x78, 0x66, 0xa8, 0x0d, 0xbe, 0xe3, 0x35, 0x8c, 0x0e,
+	0x4f, 0xb6, 0xd7, 0xbb, 0x61, 0x48, 0x03, 0xfe, 0x8d, 0xc4, 0x19, 0x05, 0x2c, 0xe3, 0xbf, 0x34,
+	0x68, 0x26, 0x67, 0xcc, 0x23, 0x3d, 0xeb, 0xfa, 0xbd, 0x0e, 0x35, 0x51, 0xd1, 0xa3, 0xd2, 0x2d,
+	0x62, 0x04, 0x63, 0x7a, 0xe0, 0x84, 0x13, 0x7b, 0x8a, 0x66, 0xd6, 0x30, 0x05, 0x94, 0xea, 0x28,
+	0x94, 0xce, 0xd7, 0x51, 0xb0, 0x47, 0x23, 0x9f, 0x8e, 0xec, 0x90, 0xe2, 0x55, 0xd1, 0x30, 0x63,
+	0x04, 0x2b, 0x73, 0xbf, 0xa0, 0xa7, 0xb2, 0x24, 0x20, 0xcb, 0xdc, 0xa2, 0xa6, 0xf8, 0x98, 0x9e,
+	0x9a, 0x38, 0x6c, 0x74, 0xe2, 0x9a, 0xe1, 0x57, 0xd4, 0x1e, 0x9e, 0x59, 0xe8, 0x33, 0xfe, 0xbd,
+	0x00, 0x75, 0x85, 0xfc, 0xd7, 0xa8, 0xed, 0x40, 0x44, 0xe1, 0x9f, 0xab, 0x0a, 0xff, 0x2b, 0xbb,
+	0xac, 0xcc, 0x6b, 0x45, 0x54, 0xf3, 0x5b, 0x11, 0x35, 0xa5, 0x15, 0xb1, 0xaa, 0xf6, 0xef, 0x60,
+	0x45, 0xcb, 0x3d, 0xba, 0x64, 0x47, 0x4f, 0xe9, 0x11, 0xd4, 0x53, 0x3d, 0x02, 0xe3, 0x3e, 0xd4,
+	0xf9, 0xb2, 0xf7, 0x7c, 0xcf, 0x3b, 0x64, 0xde, 0xce, 0x0b, 0xff, 0xbc, 0x57, 0xc0, 0x01, 0xb6,
+	0x8e, 0xa9, 0x1d, 0x1e, 0xe1, 0x4d, 0xde, 0x30, 0xf1, 0x3f, 0x73, 0xbd, 0xa5, 0xfe, 0x09, 0xce,
+	0x8a, 0x7c, 0xed, 0x2e, 0x94, 0x8f, 0xf0, 0xa4, 0xda, 0x5a, 0xc2, 0x7f, 0xd5, 0x23, 0x17, 0x14,
+	0xf3, 0xe3, 0xe7, 0x1d, 0x28, 0x4d, 0x19, 0xd7, 0x76, 0x31, 0xc1, 0x43, 0x59, 0xa5, 0xc9, 0x09,
+	0xd8, 0x77, 0x03, 0xcb, 0x42, 0xf5, 0xbf, 0xfa, 0x3a, 0xf0, 0x5b, 0x8a, 0x38, 0xcf, 0x6c, 0x98,
+	0x12, 0x4c, 0x35, 0x98, 0x8b, 0x67, 0x36, 0x98, 0xe3, 0x95, 0x2f, 0x9c, 0xb5, 0xf2, 0xdf, 0x85,
+	0xf6, 0x06, 0xcb, 0x93, 0xc6, 0xf9, 0x7d, 0xd0, 0x8c, 0x91, 0xaf, 0xa6, 0x9b, 0xb6, 0xaf, 0x3f,
+	0x74, 0xe3, 0x05, 0x2c, 0xb3, 0x32, 0x30, 0x75, 0x87, 0x8e, 0x3b, 0xea, 0x9f, 0x44, 0x01, 0x3e,
+	0xd1, 0x18, 0xd4, 0x72, 0x3a, 0xf5, 0xea, 0xcd, 0x55, 0xc8, 0xdc, 0x5c, 0xd1, 0x5d, 0x50, 0x54,
+	0x6f, 0xcf, 0x09, 0xd4, 0x22, 0x49, 0xe9, 0x38, 0xae, 0x9d, 0x2b, 0x8e, 0xb3, 0x3d, 0xfb, 0xb6,
+	0xfb, 0x42, 0xdc, 0x31, 0xf8, 0x5f, 0xa9, 0x1b, 0x17, 0xd5, 0xba, 0xb1, 0x31, 0xc0, 0x28, 0xa2,
+	0xee, 0x4d, 0x9c, 0xfa, 0x47, 0xb9, 0x91, 0x5e, 0xea, 0x29, 0x9a, 0x90, 0x0a, 0xf0, 0x51, 0xdd,
+	0xab, 0xa0, 0xd4, 0xbd, 0x8c, 0x7b, 0xf8, 0xfe, 0x8d, 0xe6, 0x6c, 0xf0, 0x62, 0x48, 0x9c, 0xf4,
+	0xc5, 0x89, 0x7d, 0xd1, 0xe4, 0x80, 0xf1, 0x77, 0x1a, 0xb4, 0xf6, 0x67, 0x07, 0xc1, 0xc0, 0x77,
+	0x0e, 0xa2, 0x57, 0xc0, 0x5d, 0x28, 0x63, 0x7a, 0xc7, 0x57, 0x93, 0x9f, 0x00, 0x0a, 0x0a, 0xf2,
+	0x09, 0x7b, 0x81, 0x8d, 0x43, 0xea, 0x8b, 0x13, 0x96, 0xdf, 0x19, 0xa5, 0x99, 0xae, 0x3e, 0x42,
+	0x2a, 0x53, 0x50, 0xeb, 0xeb, 0x50, 0xe6, 0x98, 0xf4, 0x01, 0x6a, 0x99, 0x03, 0x9c, 0xe7, 0x74,
+	0xc6, 0x7d, 0xb8, 0xa0, 0x88, 0x11, 0xfb, 0x34, 0xa0, 0x84, 0x99, 0x43, 0x5b, 0x4b, 0xf4, 0xe8,
+	0x78, 0x52, 0xc1, 0x87, 0x8c, 0x7b, 0xf8, 0xea, 0x92, 0xc5, 0x7e, 0xd6, 0xae, 0x0b, 0x94, 0xa8,
+	0x9e, 0xd7, 0x6c, 0x30, 0x7e, 0xae, 0xc1, 0x62, 0x62, 0xc2, 0x3c, 0x4a, 0x96, 0x95, 0x8b, 0xc6,
+	0x82, 0xac, 0x55, 0x46, 0x30, 0x9b, 0xc3, 0xea, 0x61, 0x74, 0x28, 0x2b, 0x8c, 0x1c, 0x62, 0xe5,
+	0xde, 0xb1, 0x1d, 0x84, 0x56, 0x34, 0x91, 0x3f, 0x8f, 0x1a, 0x0c, 0xb9, 0x27, 0x27, 0xb3, 0xce,
+	0x01, 0x23, 0xe2, 0x73, 0xac, 0x60, 0xec, 0x85, 0xa2, 0xc6, 0xd0, 0x64, 0xf8, 0xa7, 0x88, 0xde,
+	0x1f, 0x7b, 0xfc, 0xc3, 0xb3, 0xe3, 0x91, 0x35, 0xb6, 0x43, 0xea, 0x0e, 0xe4, 0x57, 0x35, 0x60,
+	0x1f, 0x8f, 0x9e, 0x70, 0x8c, 0xf1, 0x08, 0xef, 0xff, 0x94, 0x02, 0xa2, 0x38, 0x54, 0x62, 0x46,
+	0x2b, 0x4d, 0x51, 0xbe, 0x29, 0x93, 0xc4, 0x9c, 0xc4, 0xb8, 0x07, 0x97, 0x36, 0xe9, 0x71, 0x77,
+	0x78, 0xcc, 0xe2, 0x02, 0xfb, 0x62, 0x4f, 0x79, 0x48, 0x06, 0x74, 0xe0, 0xb9, 0xc3, 0x40, 0x3e,
+	0xb2, 0x04, 0x68, 0xbc, 0x0f, 0x97, 0xd3, 0x53, 0xe2, 0x77, 0x75, 0xfa, 0x5b, 0x0a, 0x83, 0x40,
+	0x6b, 0x93, 0x1e, 0x9b, 0x34, 0xa0, 0x91, 0x25, 0x1b, 0xff, 0xa8, 0xc1, 0x45, 0xf9, 0xb6, 0x51,
+	0x1f, 0x62, 0xcc, 0xf7, 0x4e, 0x27, 0x07, 0xde, 0x58, 0x1e, 0x08, 0x87, 0xfe, 0x9f, 0xd6, 0xfc,
+	0xfe, 0xb2, 0x00, 0xb5, 0x68, 0x0b, 0x73, 0xd7, 0x8e, 0x69, 0xf7, 0x78, 0xac, 0x7e, 0x40, 0x58,
+	0x65, 0x08, 0x4c, 0xbb, 0x2f, 0x43, 0xd9, 0x09, 0x82, 0x19, 0x8d, 0xf2, 0x59, 0x0e, 0x21, 0xb3,
+	0xd9, 0x74, 0x3a, 0x3e, 0x15, 0x55, 0x7c, 0x01, 0xb1, 0xed, 0xf1, 0x4f, 0x3b, 0xc5, 0x28, 0xaf,
+	0xe2, 0xd7, 0x11, 0xb7, 0xcf, 0x49, 0xda, 0x50, 0x19, 0xd2, 0x81, 0x33, 0xb1, 0xc7, 0x68, 0x35,
+	0x25, 0x53, 0x82, 0x6c, 0xf2, 0xc0, 0x76, 0x2d, 0xd9, 0x58, 0x10, 0x75, 0xa9, 0xfa, 0xc0, 0x76,
+	0xfb, 0x02, 0x45, 0xee, 0x43, 0xdb, 0x73, 0xc7, 0xa7, 0x16, 0x5f, 0x86, 0x95, 0x20, 0xaf, 0x22,
+	0xf9, 0x25, 0x36, 0xbe, 0x8d, 0xc3, 0x1b, 0xca, 0x44, 0xe6, 0x4a, 0x36, 0x16, 0xf7, 0x6b, 0x48,
+	0x26, 0x20, 0xe3, 0x05, 0x5c, 0x60, 0xcd, 0x5d, 0x54, 0x53, 0xe4, 0xa1, 0x79, 0xc7, 0xa9, 0xe5,
+	0x1e, 0xe7, 0x2f, 0xf7, 0x0a, 0xb0, 0x80, 0xa8, 0xc2, 0xa2, 0x76, 0x5d, 0x19, 0xdf, 0xed, 0xe9,
+	0xc8, 0x1c, 0x5b, 0x9f, 0x18, 0x3f, 0x3b, 0xe9, 0xfe, 0x16, 0x20, 0xce, 0x2e, 0x53, 0xe1, 0xa3,
+	0x11, 0x85, 0x8f, 0x65, 0x79, 0x1f, 0xf3, 0x8b, 0x9d, 0x03, 0xc9, 0xbb, 0xb4, 0x78, 0xe6, 0x5d,
+	0xba, 0xf6, 0xe3, 0x9b, 0x00, 0xdd, 0xa9, 0xb3, 0x4f, 0xfd, 0x63, 0x67, 0x40, 0xc9, 0xf7, 0xa1,
+	0xbe, 0x45, 0x43, 0xf9, 0xf5, 0x2d, 0x91, 0x0f, 0x2e, 0xf5, 0x5b, 0x68, 0xfd, 0x8a, 0x40, 0xa6,
+	0xbf, 0xd1, 0x35, 0x96, 0x7f, 0xf4, 0xcf, 0xff, 0xf1, 0xd3, 0x42, 0x93, 0x34, 0x3a, 0x23, 0x85,
+	0x47, 0x1f, 0x1a, 0x5b, 0x94, 0x2b, 0x7a, 0x3e, 0x4f, 0xf9, 0x1d, 0x67, 0xe6, 0x3b, 0x07, 0xe3,
+	0x12, 0x32, 0x5d, 0x22, 0x8b, 0x8c, 0x69, 0xcc, 0x65, 0x1f, 0x20, 0xfe, 0xa4, 0x9a, 0xc8, 0xe9,
+	0x99, 0xaf, 0xac, 0x75, 0xd9, 0x72, 0x4c, 0x7d, 0xfd, 0x6c, 0x5c, 0x44, 0xb6, 0x8b, 0xa4, 0xce,
+	0xd8, 0x4a, 0x36, 0xbf, 0x8d, 0xbb, 0xef, 0x9f, 0xf0, 0x9e, 0x3f, 0x59, 0x8e, 0xd2, 0x21, 0xe5,
+	0x13, 0x00, 0x5d, 0x9f, 0xff, 0x01, 0x9d, 0x71, 0x0d, 0xb9, 0x5e, 0x22, 0x17, 0x3b, 0xa3, 0x98,
+	0x4f, 0xe7, 0x15, 0x0b, 0x01, 0xdf, 0x91, 0x21, 0xa6, 0x2d, 0x51, 0x6e, 0xb5, 0x7e, 0xda, 0x3f,
+	0x79, 0x8d, 0x98, 0x4c, 0x2e, 0x66, 0xbc, 0x85, 0xcc, 0x6f, 0x92, 0xeb, 0x9c, 0x79, 0x8a, 0x8d,
+	0x94, 0xe2, 0x41, 0x33, 0xf9, 0xe9, 0x02, 0xb9, 0x1e, 0x2b, 0x27, 0xfb, 0x45, 0x83, 0xbe, 0x9c,
+	0xf7, 0x3d, 0x8b, 0xf1, 0x1e, 0xca, 0x7a, 0x93, 0xdc, 0x66, 0xb2, 0x94, 0x59, 0x42, 0x4a, 0xe7,
+	0x95, 0xfc, 0x24, 0xe1, 0x3b, 0xf2, 0x12, 0x8b, 0x21, 0x89, 0x4f, 0x1c, 0xc8, 0xcd, 0x8c, 0xc8,
+	0xc4, 0xb7, 0x0f, 0x73, 0x84, 0xfe, 0x06, 0x0a, 0x7d, 0x97, 0xbc, 0xdd, 0x19, 0xa5, 0xe6, 0x75,
+	0x5e, 0xf1, 0x10, 0x99, 0x10, 0x4c, 0xd1, 0x04, 0x64, 0x3b, 0x5b, 0x31, 0x81, 0x64, 0x7b, 0x47,
+	0x6f, 0x26, 0x5f, 0xc3, 0x49, 0x31, 0x02, 0xd9, 0x79, 0xc5, 0x02, 0xe7, 0x77, 0x9d, 0x57, 0xe9,
+	0x88, 0xf1, 0x1d, 0xf9, 0x53, 0x0d, 0x96, 0x52, 0x55, 0x50, 0x72, 0x23, 0x16, 0x96, 0x53, 0x1d,
+	0xd5, 0x6f, 0xce, 0x1b, 0x16, 0x1b, 0xfd, 0x02, 0x57, 0x70, 0x9f, 0x7c, 0xdc, 0x19, 0x25, 0x29,
+	0x3a, 0xaf, 0x44, 0x19, 0xf5, 0xbb, 0xce, 0x2b, 0x8c, 0x16, 0xb9, 0x2b, 0xfa, 0x73, 0x0d, 0x1b,
+	0x22, 0xa9, 0xba, 0xe5, 0x59, 0x8b, 0xba, 0x9d, 0x1a, 0xce, 0x56, 0x3c, 0x8d, 0x2f, 0x71, 0x5d,
+	0x0f, 0xc9, 0xa7, 0x9d, 0x51, 0x86, 0xe8, 0x7c, 0x4b, 0xfb, 0x0b, 0xe5, 0xca, 0x55, 0x2a, 0x91,
+	0x99, 0xb5, 0x25, 0x4b, 0xa3, 0xba, 0x91, 0x1d, 0x4e, 0x17, 0x31, 0x8d, 0x75, 0x5c, 0xdc, 0xe7,
+	0xe4, 0x61, 0x67, 0x94, 0xa5, 0x8a, 0xd7, 0x24, 0x8b, 0xa9, 0xb9, 0xcb, 0xfb, 0x29, 0xaf, 0xdc,
+	0x25, 0xaa, 0x9d, 0x67, 0xad, 0xed, 0x56, 0x76, 0x38, 0x51, 0x25, 0x35, 0x7e, 0x0b, 0x17, 0xf6,
+	0x80, 0xdc, 0xef, 0x8c, 0x52, 0x24, 0xe7, 0x5c, 0x15, 0x0f, 0xba, 0xd1, 0xe7, 0x1c, 0xaf, 0x0d,
+	0xba, 0xe9, 0xcf, 0x44, 0x92, 0x41, 0x37, 0xe2, 0xf1, 0x67, 0xfc, 0x1c, 0xd2, 0x9f, 0xca, 0x10,
+	0xc5, 0x08, 0xe6, 0x7c, 0xa9, 0xa3, 0x1b, 0xaf, 0x23, 0x11, 0x42, 0x1f, 0xa0, 0xd0, 0x0f, 0xc9,
+	0xbd, 0xce, 0x28, 0x4b, 0xa5, 0x5a, 0x4a, 0x76, 0xb3, 0x23, 0xa8, 0x2b, 0xdd, 0x1a, 0x72, 0x35,
+	0x96, 0x96, 0x6a, 0xf0, 0xe9, 0x4b, 0xa9, 0xbe, 0xa3, 0xf1, 0x3e, 0x4a, 0x7d, 0x87, 0xbc, 0x85,
+	0x57, 0x81, 0xc0, 0x76, 0x5e, 0xcd, 0xd1, 0xea, 0x29, 0x90, 0x6c, 0x5b, 0x88, 0xac, 0x64, 0xe5,
+	0x25, 0xfb, 0x7f, 0xfa, 0xed, 0xd7, 0x50, 0x88, 0xed, 0xdf, 0xc4, 0x85, 0xb4, 0x8d, 0x8b, 0x9d,
+	0x51, 0x86, 0xe8, 0xa1, 0x76, 0x97, 0xfc, 0x44, 0xc3, 0xb4, 0x39, 0xb7, 0x25, 0x45, 0xde, 0x99,
+	0xcb, 0x3f, 0xd1, 0x22, 0xd3, 0xdf, 0x3d, 0x93, 0x4e, 0xac, 0x46, 0xdc, 0x0b, 0x0f, 0xb5, 0xbb,
+	0xc6, 0xd5, 0xce, 0x68, 0x0e, 0x35, 0xf9, 0x21, 0x2c, 0xa5, 0xfa, 0x54, 0x91, 0xee, 0xb3, 0xcf,
+	0xf4, 0x28, 0x82, 0xcd, 0x69, 0x6d, 0x19, 0x04, 0x65, 0x36, 0x8c, 0x4a, 0x27, 0x60, 0x14, 0x27,
+	0x6c, 0xd7, 0x26, 0x2c, 0xf5, 0x4e, 0xe8, 0xe0, 0x9c, 0x12, 0xb2, 0xf7, 0x9b, 0xe0, 0xc9, 0xf6,
+	0x51, 0xe9, 0x50, 0xc6, 0xe9, 0x84, 0x3c, 0x83, 0x5a, 0x54, 0x16, 0x26, 0x57, 0x62, 0x8d, 0x24,
+	0x6a, 0xef, 0x7a, 0x3b, 0x3b, 0x90, 0xcc, 0x1e, 0x0c, 0xe8, 0x8c, 0xe4, 0x18, 0x5b, 0xea, 0x8f,
+	0x79, 0x67, 0x2b, 0xa7, 0xb2, 0x4a, 0xde, 0xca, 0xdc, 0x23, 0x39, 0xb5, 0x64, 0xfd, 0xed, 0x33,
+	0xa8, 0x84, 0xf8, 0x77, 0x50, 0xfc, 0x0a, 0xb9, 0xd9, 0x19, 0xe5, 0x12, 0x8a, 0x6b, 0x87, 0x4c,
+	0xb1, 0xfa, 0x9d, 0x2a, 0x9a, 0x2a, 0x81, 0x27, 0xb7, 0x00, 0xab, 0x5f, 0x12, 0x04, 0xc9, 0x51,
+	0xe3, 0x4d, 0x14, 0x7a, 0x83, 0x5c, 0x63, 0x42, 0x93, 0x63, 0xd1, 0x3d, 0x4a, 0x86, 0x71, 0x9a,
+	0x20, 0xca, 0x8f, 0xe9, 0x34, 0x21, 0x51, 0xc4, 0xd4, 0x73, 0x8a, 0x4c, 0xc6, 0x0a, 0x0a, 0xd2,
+	0x49, 0x3b, 0xba, 0xaf, 0xf9, 0x40, 0x2c, 0xe5, 0x39, 0x5e, 0xd1, 0xa2, 0x90, 0x36, 0x27, 0xd1,
+	0xb9, 0x1c, 0x61, 0x13, 0x65, 0x2e, 0x43, 0x47, 0xee, 0xcb, 0x84, 0xf0, 0x74, 0x07, 0x07, 0x65,
+	0x92, 0x43, 0xf1, 0x4a, 0x56, 0xab, 0x63, 0x73, 0x98, 0x5f, 0x4b, 0x76, 0x0c, 0x92, 0x12, 0x6e,
+	0xa1, 0x84, 0xab, 0xe4, 0x0a, 0x93, 0xa0, 0x52, 0x48, 0x31, 0xdf, 0xc2, 0x85, 0x4c, 0x21, 0x2b,
+	0x3a, 0x96, 0x79, 0x25, 0xae, 0x33, 0x7d, 0x47, 0x44, 0x6c, 0xa3, 0xd6, 0x19, 0x70, 0x16, 0xe8,
+	0x3d, 0x14, 0x16, 0x13, 0x85, 0x1f, 0x72, 0x4d, 0x89, 0xc3, 0xe9, 0x52, 0x97, 0x7e, 0x3d, 0x7f,
+	0x50, 0x48, 0xb8, 0x8a, 0x12, 0x2e, 0x1a, 0xcd, 0xce, 0x48, 0x1d, 0x67, 0x62, 0x0e, 0xd1, 0xd2,
+	0x92, 0xa5, 0x9f, 0xfc, 0x1b, 0x67, 0x25, 0x47, 0x44, 0xa2, 0x52, 0x94, 0x3c, 0xa1, 0x14, 0xcb,
+	0x11, 0x5c, 0x8c, 0x4a, 0x2e, 0xe7, 0xdd, 0x54, 0xa6, 0x98, 0x25, 0x4f, 0xc8, 0x58, 0xee, 0x04,
+	0x59, 0x66, 0x0f, 0xb5, 0xbb, 0x1f, 0x68, 0xc4, 0xc5, 0x1b, 0x3d, 0x59, 0x71, 0xb9, 0x99, 0xbd,
+	0xc2, 0xd4, 0xda, 0x8d, 0x7e, 0x6b, 0xee, 0x78, 0x52, 0x81, 0xe4, 0x42, 0x67, 0x94, 0x22, 0x21,
+	0xdf, 0x42, 0x33, 0x59, 0x96, 0x88, 0x1c, 0x27, 0xb7, 0xc0, 0xa1, 0xdf, 0x98, 0x33, 0x9a, 0x7c,
+	0x31, 0x18, 0xad, 0xce, 0x90, 0x1e, 0x77, 0xec, 0x98, 0x82, 0x1d, 0xd6, 0x2e, 0x54, 0x65, 0x51,
+	0xe3, 0xf5, 0x59, 0x41, 0xa6, 0xf4, 0x11, 0xc7, 0x3d, 0xc6, 0xd6, 0x67, 0x63, 0x8c, 0xa1, 0x87,
+	0x6f, 0xb1, 0xb8, 0x9a, 0xa0, 0xa7, 0x72, 0x1b, 0x35, 0x0f, 0xc8, 0x3c, 0x60, 0x8d, 0x7b, 0xc8,
+	0xf4, 0x7b, 0xe4, 0xbd, 0x28, 0xd1, 0xe1, 0xd7, 0x3d, 0x2f, 0x41, 0xe4, 0x5e, 0xc2, 0xcf, 0x01,
+	0xe2, 0xb7, 0x72, 0x94, 0xa3, 0x67, 0xde, 0xea, 0xfa, 0xd5, 0x9c, 0x91, 0xcc, 0x4b, 0x6d, 0x1c,
+	0x73, 0xfa, 0x1a, 0x6a, 0x91, 0x79, 0x45, 0xf7, 0x42, 0xba, 0x94, 0xa8, 0xb7, 0xb3, 0x03, 0x19,
+	0xfd, 0x44, 0x86, 0x85, 0xe6, 0x74, 0x50, 0xc6, 0x2f, 0xe4, 0x3f, 0xfc, 0xdf, 0x01, 0x00, 0x68,
+	0x24, 0x1c, 0xba, 0x38, 0x3c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -3691,7 +5742,7 @@ type ApiServiceClient interface {
 	// get blockchain information
 	GetChainInfo(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*ChainInfoResponse, error)
 	// get current blockchain ram information
-	GetRAMInfo(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*RAMInfoResponse, error)
+	GetRAMInfo(ctx context.Context, in *GetRAMInfoRequest, opts ...grpc.CallOption) (*RAMInfoResponse, error)
 	// get transaction by hash
 	GetTxByHash(ctx context.Context, in *TxHashRequest, opts ...grpc.CallOption) (*TransactionResponse, error)
 	// get transaction receipt by transaction hash
@@ -3724,6 +5775,36 @@ type ApiServiceClient interface {
 	SendTransaction(ctx context.Context, in *TransactionRequest, opts ...grpc.CallOption) (*SendTransactionResponse, error)
 	// execute transaction
 	ExecTransaction(ctx context.Context, in *TransactionRequest, opts ...grpc.CallOption) (*TxReceipt, error)
+	// get contract receipts in irreversible blocks
+	GetEvents(ctx context.Context, in *GetEventsRequest, opts ...grpc.CallOption) (*GetEventsResponse, error)
+	// get transactions involving an account in irreversible blocks, from the newest to the oldest
+	GetAccountTransactions(ctx context.Context, in *GetAccountTransactionsRequest, opts ...grpc.CallOption) (*GetAccountTransactionsResponse, error)
+	// get the attestation of an irreversible block, which is signed by more than 2/3 of the witnesses
+	GetLIBAttestation(ctx context.Context, in *GetLIBAttestationRequest, opts ...grpc.CallOption) (*LIBAttestation, error)
+	// get the head of an irreversible block with the signature of its producer
+	GetBlockHeader(ctx context.Context, in *GetBlockHeaderRequest, opts ...grpc.CallOption) (*BlockHeader, error)
+	// get the merkle proof of an irreversible transaction
+	GetTxProof(ctx context.Context, in *TxHashRequest, opts ...grpc.CallOption) (*TxProofResponse, error)
+	// get the merkle proof of the receipt of an irreversible transaction
+	GetReceiptProof(ctx context.Context, in *TxHashRequest, opts ...grpc.CallOption) (*ReceiptProofResponse, error)
+	// cancel a pending transaction with the signature of its publisher
+	CancelTransaction(ctx context.Context, in *CancelTransactionRequest, opts ...grpc.CallOption) (*SendTransactionResponse, error)
+	// get pending transactions in packing order
+	GetPendingTxs(ctx context.Context, in *GetPendingTxsRequest, opts ...grpc.CallOption) (*GetPendingTxsResponse, error)
+	// get the number of pending transactions
+	GetPendingTxCount(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*GetPendingTxCountResponse, error)
+	// subscribe the transactions accepted into the pending list
+	SubscribePendingTxs(ctx context.Context, in *GetPendingTxsRequest, opts ...grpc.CallOption) (ApiService_SubscribePendingTxsClient, error)
+	// get the reliability statistics of the producers counted by this node on the irreversible blocks
+	GetProducerStats(ctx context.Context, in *GetProducerStatsRequest, opts ...grpc.CallOption) (*GetProducerStatsResponse, error)
+	// advance the chain time of the dev mode, a block is produced at the new time
+	DevAdvanceTime(ctx context.Context, in *DevAdvanceTimeRequest, opts ...grpc.CallOption) (*DevAdvanceTimeResponse, error)
+	// reset the chain of the dev mode to a new genesis block with the same accounts
+	DevReset(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*DevResetResponse, error)
+	// get the metadata of a token
+	GetTokenInfo(ctx context.Context, in *GetTokenInfoRequest, opts ...grpc.CallOption) (*TokenInfo, error)
+	// list the metadata of the tokens in the order of symbol
+	ListTokens(ctx context.Context, in *ListTokensRequest, opts ...grpc.CallOption) (*ListTokensResponse, error)
 	// subscribe an event
 	Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (ApiService_SubscribeClient, error)
 }
@@ -3754,7 +5835,7 @@ func (c *apiServiceClient) GetChainInfo(ctx context.Context, in *EmptyRequest, o
 	return out, nil
 }
 
-func (c *apiServiceClient) GetRAMInfo(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*RAMInfoResponse, error) {
+func (c *apiServiceClient) GetRAMInfo(ctx context.Context, in *GetRAMInfoRequest, opts ...grpc.CallOption) (*RAMInfoResponse, error) {
 	out := new(RAMInfoResponse)
 	err := c.cc.Invoke(ctx, "/rpcpb.ApiService/GetRAMInfo", in, out, opts...)
 	if err != nil {
@@ -3853,54 +5934,212 @@ func (c *apiServiceClient) GetGasRatio(ctx context.Context, in *EmptyRequest, op
 	return out, nil
 }
 
-func (c *apiServiceClient) GetProducerVoteInfo(ctx context.Context, in *GetProducerVoteInfoRequest, opts ...grpc.CallOption) (*GetProducerVoteInfoResponse, error) {
-	out := new(GetProducerVoteInfoResponse)
-	err := c.cc.Invoke(ctx, "/rpcpb.ApiService/GetProducerVoteInfo", in, out, opts...)
-	if err != nil {
+func (c *apiServiceClient) GetProducerVoteInfo(ctx context.Context, in *GetProducerVoteInfoRequest, opts ...grpc.CallOption) (*GetProducerVoteInfoResponse, error) {
+	out := new(GetProducerVoteInfoResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.ApiService/GetProducerVoteInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetContract(ctx context.Context, in *GetContractRequest, opts ...grpc.CallOption) (*Contract, error) {
+	out := new(Contract)
+	err := c.cc.Invoke(ctx, "/rpcpb.ApiService/GetContract", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetContractStorage(ctx context.Context, in *GetContractStorageRequest, opts ...grpc.CallOption) (*GetContractStorageResponse, error) {
+	out := new(GetContractStorageResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.ApiService/GetContractStorage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetContractStorageFields(ctx context.Context, in *GetContractStorageFieldsRequest, opts ...grpc.CallOption) (*GetContractStorageFieldsResponse, error) {
+	out := new(GetContractStorageFieldsResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.ApiService/GetContractStorageFields", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) SendTransaction(ctx context.Context, in *TransactionRequest, opts ...grpc.CallOption) (*SendTransactionResponse, error) {
+	out := new(SendTransactionResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.ApiService/SendTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) ExecTransaction(ctx context.Context, in *TransactionRequest, opts ...grpc.CallOption) (*TxReceipt, error) {
+	out := new(TxReceipt)
+	err := c.cc.Invoke(ctx, "/rpcpb.ApiService/ExecTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetEvents(ctx context.Context, in *GetEventsRequest, opts ...grpc.CallOption) (*GetEventsResponse, error) {
+	out := new(GetEventsResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.ApiService/GetEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetAccountTransactions(ctx context.Context, in *GetAccountTransactionsRequest, opts ...grpc.CallOption) (*GetAccountTransactionsResponse, error) {
+	out := new(GetAccountTransactionsResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.ApiService/GetAccountTransactions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetLIBAttestation(ctx context.Context, in *GetLIBAttestationRequest, opts ...grpc.CallOption) (*LIBAttestation, error) {
+	out := new(LIBAttestation)
+	err := c.cc.Invoke(ctx, "/rpcpb.ApiService/GetLIBAttestation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetBlockHeader(ctx context.Context, in *GetBlockHeaderRequest, opts ...grpc.CallOption) (*BlockHeader, error) {
+	out := new(BlockHeader)
+	err := c.cc.Invoke(ctx, "/rpcpb.ApiService/GetBlockHeader", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetTxProof(ctx context.Context, in *TxHashRequest, opts ...grpc.CallOption) (*TxProofResponse, error) {
+	out := new(TxProofResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.ApiService/GetTxProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetReceiptProof(ctx context.Context, in *TxHashRequest, opts ...grpc.CallOption) (*ReceiptProofResponse, error) {
+	out := new(ReceiptProofResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.ApiService/GetReceiptProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) CancelTransaction(ctx context.Context, in *CancelTransactionRequest, opts ...grpc.CallOption) (*SendTransactionResponse, error) {
+	out := new(SendTransactionResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.ApiService/CancelTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetPendingTxs(ctx context.Context, in *GetPendingTxsRequest, opts ...grpc.CallOption) (*GetPendingTxsResponse, error) {
+	out := new(GetPendingTxsResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.ApiService/GetPendingTxs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetPendingTxCount(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*GetPendingTxCountResponse, error) {
+	out := new(GetPendingTxCountResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.ApiService/GetPendingTxCount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) SubscribePendingTxs(ctx context.Context, in *GetPendingTxsRequest, opts ...grpc.CallOption) (ApiService_SubscribePendingTxsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ApiService_serviceDesc.Streams[0], "/rpcpb.ApiService/SubscribePendingTxs", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &apiServiceSubscribePendingTxsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ApiService_SubscribePendingTxsClient interface {
+	Recv() (*PendingTx, error)
+	grpc.ClientStream
+}
+
+type apiServiceSubscribePendingTxsClient struct {
+	grpc.ClientStream
+}
+
+func (x *apiServiceSubscribePendingTxsClient) Recv() (*PendingTx, error) {
+	m := new(PendingTx)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	return out, nil
+	return m, nil
 }
 
-func (c *apiServiceClient) GetContract(ctx context.Context, in *GetContractRequest, opts ...grpc.CallOption) (*Contract, error) {
-	out := new(Contract)
-	err := c.cc.Invoke(ctx, "/rpcpb.ApiService/GetContract", in, out, opts...)
+func (c *apiServiceClient) GetProducerStats(ctx context.Context, in *GetProducerStatsRequest, opts ...grpc.CallOption) (*GetProducerStatsResponse, error) {
+	out := new(GetProducerStatsResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.ApiService/GetProducerStats", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *apiServiceClient) GetContractStorage(ctx context.Context, in *GetContractStorageRequest, opts ...grpc.CallOption) (*GetContractStorageResponse, error) {
-	out := new(GetContractStorageResponse)
-	err := c.cc.Invoke(ctx, "/rpcpb.ApiService/GetContractStorage", in, out, opts...)
+func (c *apiServiceClient) DevAdvanceTime(ctx context.Context, in *DevAdvanceTimeRequest, opts ...grpc.CallOption) (*DevAdvanceTimeResponse, error) {
+	out := new(DevAdvanceTimeResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.ApiService/DevAdvanceTime", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *apiServiceClient) GetContractStorageFields(ctx context.Context, in *GetContractStorageFieldsRequest, opts ...grpc.CallOption) (*GetContractStorageFieldsResponse, error) {
-	out := new(GetContractStorageFieldsResponse)
-	err := c.cc.Invoke(ctx, "/rpcpb.ApiService/GetContractStorageFields", in, out, opts...)
+func (c *apiServiceClient) DevReset(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*DevResetResponse, error) {
+	out := new(DevResetResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.ApiService/DevReset", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *apiServiceClient) SendTransaction(ctx context.Context, in *TransactionRequest, opts ...grpc.CallOption) (*SendTransactionResponse, error) {
-	out := new(SendTransactionResponse)
-	err := c.cc.Invoke(ctx, "/rpcpb.ApiService/SendTransaction", in, out, opts...)
+func (c *apiServiceClient) GetTokenInfo(ctx context.Context, in *GetTokenInfoRequest, opts ...grpc.CallOption) (*TokenInfo, error) {
+	out := new(TokenInfo)
+	err := c.cc.Invoke(ctx, "/rpcpb.ApiService/GetTokenInfo", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *apiServiceClient) ExecTransaction(ctx context.Context, in *TransactionRequest, opts ...grpc.CallOption) (*TxReceipt, error) {
-	out := new(TxReceipt)
-	err := c.cc.Invoke(ctx, "/rpcpb.ApiService/ExecTransaction", in, out, opts...)
+func (c *apiServiceClient) ListTokens(ctx context.Context, in *ListTokensRequest, opts ...grpc.CallOption) (*ListTokensResponse, error) {
+	out := new(ListTokensResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.ApiService/ListTokens", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -3908,7 +6147,7 @@ func (c *apiServiceClient) ExecTransaction(ctx context.Context, in *TransactionR
 }
 
 func (c *apiServiceClient) Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (ApiService_SubscribeClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_ApiService_serviceDesc.Streams[0], "/rpcpb.ApiService/Subscribe", opts...)
+	stream, err := c.cc.NewStream(ctx, &_ApiService_serviceDesc.Streams[1], "/rpcpb.ApiService/Subscribe", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -3946,7 +6185,7 @@ type ApiServiceServer interface {
 	// get blockchain information
 	GetChainInfo(context.Context, *EmptyRequest) (*ChainInfoResponse, error)
 	// get current blockchain ram information
-	GetRAMInfo(context.Context, *EmptyRequest) (*RAMInfoResponse, error)
+	GetRAMInfo(context.Context, *GetRAMInfoRequest) (*RAMInfoResponse, error)
 	// get transaction by hash
 	GetTxByHash(context.Context, *TxHashRequest) (*TransactionResponse, error)
 	// get transaction receipt by transaction hash
@@ -3979,6 +6218,36 @@ type ApiServiceServer interface {
 	SendTransaction(context.Context, *TransactionRequest) (*SendTransactionResponse, error)
 	// execute transaction
 	ExecTransaction(context.Context, *TransactionRequest) (*TxReceipt, error)
+	// get contract receipts in irreversible blocks
+	GetEvents(context.Context, *GetEventsRequest) (*GetEventsResponse, error)
+	// get transactions involving an account in irreversible blocks, from the newest to the oldest
+	GetAccountTransactions(context.Context, *GetAccountTransactionsRequest) (*GetAccountTransactionsResponse, error)
+	// get the attestation of an irreversible block, which is signed by more than 2/3 of the witnesses
+	GetLIBAttestation(context.Context, *GetLIBAttestationRequest) (*LIBAttestation, error)
+	// get the head of an irreversible block with the signature of its producer
+	GetBlockHeader(context.Context, *GetBlockHeaderRequest) (*BlockHeader, error)
+	// get the merkle proof of an irreversible transaction
+	GetTxProof(context.Context, *TxHashRequest) (*TxProofResponse, error)
+	// get the merkle proof of the receipt of an irreversible transaction
+	GetReceiptProof(context.Context, *TxHashRequest) (*ReceiptProofResponse, error)
+	// cancel a pending transaction with the signature of its publisher
+	CancelTransaction(context.Context, *CancelTransactionRequest) (*SendTransactionResponse, error)
+	// get pending transactions in packing order
+	GetPendingTxs(context.Context, *GetPendingTxsRequest) (*GetPendingTxsResponse, error)
+	// get the number of pending transactions
+	GetPendingTxCount(context.Context, *EmptyRequest) (*GetPendingTxCountResponse, error)
+	// subscribe the transactions accepted into the pending list
+	SubscribePendingTxs(*GetPendingTxsRequest, ApiService_SubscribePendingTxsServer) error
+	// get the reliability statistics of the producers counted by this node on the irreversible blocks
+	GetProducerStats(context.Context, *GetProducerStatsRequest) (*GetProducerStatsResponse, error)
+	// advance the chain time of the dev mode, a block is produced at the new time
+	DevAdvanceTime(context.Context, *DevAdvanceTimeRequest) (*DevAdvanceTimeResponse, error)
+	// reset the chain of the dev mode to a new genesis block with the same accounts
+	DevReset(context.Context, *EmptyRequest) (*DevResetResponse, error)
+	// get the metadata of a token
+	GetTokenInfo(context.Context, *GetTokenInfoRequest) (*TokenInfo, error)
+	// list the metadata of the tokens in the order of symbol
+	ListTokens(context.Context, *ListTokensRequest) (*ListTokensResponse, error)
 	// subscribe an event
 	Subscribe(*SubscribeRequest, ApiService_SubscribeServer) error
 }
@@ -4024,7 +6293,7 @@ func _ApiService_GetChainInfo_Handler(srv interface{}, ctx context.Context, dec
 }
 
 func _ApiService_GetRAMInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EmptyRequest)
+	in := new(GetRAMInfoRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -4036,7 +6305,7 @@ func _ApiService_GetRAMInfo_Handler(srv interface{}, ctx context.Context, dec fu
 		FullMethod: "/rpcpb.ApiService/GetRAMInfo",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).GetRAMInfo(ctx, req.(*EmptyRequest))
+		return srv.(ApiServiceServer).GetRAMInfo(ctx, req.(*GetRAMInfoRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -4329,6 +6598,279 @@ func _ApiService_ExecTransaction_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApiService_GetEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetEvents(ctx, req.(*GetEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_GetAccountTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetAccountTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetAccountTransactions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetAccountTransactions(ctx, req.(*GetAccountTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_GetLIBAttestation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLIBAttestationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetLIBAttestation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetLIBAttestation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetLIBAttestation(ctx, req.(*GetLIBAttestationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_GetBlockHeader_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBlockHeaderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetBlockHeader(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetBlockHeader",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetBlockHeader(ctx, req.(*GetBlockHeaderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_GetTxProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TxHashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetTxProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetTxProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetTxProof(ctx, req.(*TxHashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_GetReceiptProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TxHashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetReceiptProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetReceiptProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetReceiptProof(ctx, req.(*TxHashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_CancelTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).CancelTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/CancelTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).CancelTransaction(ctx, req.(*CancelTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_GetPendingTxs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPendingTxsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetPendingTxs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetPendingTxs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetPendingTxs(ctx, req.(*GetPendingTxsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_GetPendingTxCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetPendingTxCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetPendingTxCount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetPendingTxCount(ctx, req.(*EmptyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_SubscribePendingTxs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetPendingTxsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ApiServiceServer).SubscribePendingTxs(m, &apiServiceSubscribePendingTxsServer{stream})
+}
+
+type ApiService_SubscribePendingTxsServer interface {
+	Send(*PendingTx) error
+	grpc.ServerStream
+}
+
+type apiServiceSubscribePendingTxsServer struct {
+	grpc.ServerStream
+}
+
+func (x *apiServiceSubscribePendingTxsServer) Send(m *PendingTx) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ApiService_GetProducerStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProducerStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetProducerStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetProducerStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetProducerStats(ctx, req.(*GetProducerStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_DevAdvanceTime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DevAdvanceTimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).DevAdvanceTime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/DevAdvanceTime",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).DevAdvanceTime(ctx, req.(*DevAdvanceTimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_DevReset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).DevReset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/DevReset",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).DevReset(ctx, req.(*EmptyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_GetTokenInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTokenInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetTokenInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetTokenInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetTokenInfo(ctx, req.(*GetTokenInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_ListTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTokensRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).ListTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/ListTokens",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).ListTokens(ctx, req.(*ListTokensRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ApiService_Subscribe_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(SubscribeRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -4430,8 +6972,69 @@ var _ApiService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ExecTransaction",
 			Handler:    _ApiService_ExecTransaction_Handler,
 		},
+		{
+			MethodName: "GetEvents",
+			Handler:    _ApiService_GetEvents_Handler,
+		},
+		{
+			MethodName: "GetAccountTransactions",
+			Handler:    _ApiService_GetAccountTransactions_Handler,
+		},
+		{
+			MethodName: "GetLIBAttestation",
+			Handler:    _ApiService_GetLIBAttestation_Handler,
+		},
+		{
+			MethodName: "GetBlockHeader",
+			Handler:    _ApiService_GetBlockHeader_Handler,
+		},
+		{
+			MethodName: "GetTxProof",
+			Handler:    _ApiService_GetTxProof_Handler,
+		},
+		{
+			MethodName: "GetReceiptProof",
+			Handler:    _ApiService_GetReceiptProof_Handler,
+		},
+		{
+			MethodName: "CancelTransaction",
+			Handler:    _ApiService_CancelTransaction_Handler,
+		},
+		{
+			MethodName: "GetPendingTxs",
+			Handler:    _ApiService_GetPendingTxs_Handler,
+		},
+		{
+			MethodName: "GetPendingTxCount",
+			Handler:    _ApiService_GetPendingTxCount_Handler,
+		},
+		{
+			MethodName: "GetProducerStats",
+			Handler:    _ApiService_GetProducerStats_Handler,
+		},
+		{
+			MethodName: "DevAdvanceTime",
+			Handler:    _ApiService_DevAdvanceTime_Handler,
+		},
+		{
+			MethodName: "DevReset",
+			Handler:    _ApiService_DevReset_Handler,
+		},
+		{
+			MethodName: "GetTokenInfo",
+			Handler:    _ApiService_GetTokenInfo_Handler,
+		},
+		{
+			MethodName: "ListTokens",
+			Handler:    _ApiService_ListTokens_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribePendingTxs",
+			Handler:       _ApiService_SubscribePendingTxs_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "Subscribe",
 			Handler:       _ApiService_Subscribe_Handler,
@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/iost-official/go-iost/consensus/producerstats"
 	"github.com/iost-official/go-iost/core/blockcache"
 	"github.com/iost-official/go-iost/core/global"
 	"github.com/iost-official/go-iost/core/txpool"
@@ -37,7 +38,8 @@ type Server struct {
 	gatewayServer *http.Server
 	allowOrigins  []string
 
-	quitCh chan struct{}
+	quitCh  chan struct{}
+	resetCh chan struct{}
 
 	enable bool
 }
@@ -47,12 +49,13 @@ func p(pp interface{}) error {
 }
 
 // New returns a new rpc server instance.
-func New(tp txpool.TxPool, bc blockcache.BlockCache, bv global.BaseVariable, p2pService p2p.Service) *Server {
+func New(tp txpool.TxPool, bc blockcache.BlockCache, bv global.BaseVariable, p2pService p2p.Service, stats *producerstats.Tracker) *Server {
 	s := &Server{
 		grpcAddr:     bv.Config().RPC.GRPCAddr,
 		gatewayAddr:  bv.Config().RPC.GatewayAddr,
 		allowOrigins: bv.Config().RPC.AllowOrigins,
 		quitCh:       make(chan struct{}),
+		resetCh:      make(chan struct{}, 1),
 		enable:       bv.Config().RPC.Enable,
 	}
 	s.grpcServer = grpc.NewServer(
@@ -69,11 +72,16 @@ func New(tp txpool.TxPool, bc blockcache.BlockCache, bv global.BaseVariable, p2p
 			),
 		),
 		grpc.MaxConcurrentStreams(maxConcurrentStreams))
-	apiService := NewAPIService(tp, bc, bv, p2pService, s.quitCh)
+	apiService := NewAPIService(tp, bc, bv, p2pService, stats, s.quitCh, s.resetCh)
 	rpcpb.RegisterApiServiceServer(s.grpcServer, apiService)
 	return s
 }
 
+// ResetRequested returns the channel notified when the chain of the dev mode is requested to reset.
+func (s *Server) ResetRequested() <-chan struct{} {
+	return s.resetCh
+}
+
 // Start starts the rpc server.
 func (s *Server) Start() error {
 	if !s.enable {
@@ -10,9 +10,9 @@ import (
 
 	"github.com/golang/protobuf/proto"
 	"github.com/iost-official/go-iost/account"
-	"github.com/iost-official/go-iost/common"
 	"github.com/iost-official/go-iost/core/contract"
 	"github.com/iost-official/go-iost/rpc/pb"
+	"github.com/iost-official/go-iost/signer"
 	"google.golang.org/grpc"
 )
 
@@ -23,7 +23,7 @@ type IOSTDevSDK struct {
 
 	// account used for sending tx
 	accountName string
-	keyPair     *account.KeyPair
+	signer      signer.Signer
 	// signing algorithm
 	signAlgo string
 
@@ -78,7 +78,16 @@ func (s *IOSTDevSDK) SetChainID(chainID uint32) {
 // SetAccount ...
 func (s *IOSTDevSDK) SetAccount(name string, kp *account.KeyPair) {
 	s.accountName = name
-	s.keyPair = kp
+	s.signer = nil
+	if kp != nil {
+		s.signer = signer.NewLocal(kp)
+	}
+}
+
+// SetSigner sets the account with the signer, such as a remote signer, which signs the transactions.
+func (s *IOSTDevSDK) SetSigner(name string, sig signer.Signer) {
+	s.accountName = name
+	s.signer = sig
 }
 
 // SetTxInfo ...
@@ -262,6 +271,42 @@ func (s *IOSTDevSDK) GetTxReceiptByTxHash(txHashStr string) (*rpcpb.TxReceipt, e
 	return client.GetTxReceiptByTxHash(context.Background(), &rpcpb.TxHashRequest{Hash: txHashStr})
 }
 
+// GetAccountTransactions returns a page of transactions involving the account, from the newest to the oldest
+func (s *IOSTDevSDK) GetAccountTransactions(name string, limit int32, cursor string) (*rpcpb.GetAccountTransactionsResponse, error) {
+	if s.rpcConn == nil {
+		if err := s.Connect(); err != nil {
+			return nil, err
+		}
+		defer s.CloseConn()
+	}
+	client := rpcpb.NewApiServiceClient(s.rpcConn)
+	return client.GetAccountTransactions(context.Background(), &rpcpb.GetAccountTransactionsRequest{Name: name, Limit: limit, Cursor: cursor})
+}
+
+// GetTokenInfo returns the metadata of the token
+func (s *IOSTDevSDK) GetTokenInfo(symbol string) (*rpcpb.TokenInfo, error) {
+	if s.rpcConn == nil {
+		if err := s.Connect(); err != nil {
+			return nil, err
+		}
+		defer s.CloseConn()
+	}
+	client := rpcpb.NewApiServiceClient(s.rpcConn)
+	return client.GetTokenInfo(context.Background(), &rpcpb.GetTokenInfoRequest{Symbol: symbol, ByLongestChain: s.useLongestChain})
+}
+
+// ListTokens returns a page of the token metadata in the order of symbol
+func (s *IOSTDevSDK) ListTokens(limit int32, cursor string) (*rpcpb.ListTokensResponse, error) {
+	if s.rpcConn == nil {
+		if err := s.Connect(); err != nil {
+			return nil, err
+		}
+		defer s.CloseConn()
+	}
+	client := rpcpb.NewApiServiceClient(s.rpcConn)
+	return client.ListTokens(context.Background(), &rpcpb.ListTokensRequest{ByLongestChain: s.useLongestChain, Limit: limit, Cursor: cursor})
+}
+
 // SendTransaction send raw transaction to server
 func (s *IOSTDevSDK) SendTransaction(signedTx *rpcpb.TransactionRequest) (string, error) {
 	if s.rpcConn == nil {
@@ -306,15 +351,15 @@ func (s *IOSTDevSDK) CreateTxFromActions(actions []*rpcpb.Action) (*rpcpb.Transa
 }
 
 // SignTx ...
-func (s *IOSTDevSDK) SignTx(t *rpcpb.TransactionRequest, signAlgo string) (*rpcpb.TransactionRequest, error) {
-	signAlgorithm := GetSignAlgoByName(signAlgo)
-	txHashBytes := common.Sha3(txToBytes(t, true))
-	publishSig := &rpcpb.Signature{
-		Algorithm: rpcpb.Signature_Algorithm(signAlgorithm),
-		Signature: signAlgorithm.Sign(txHashBytes, s.keyPair.Seckey),
-		PublicKey: signAlgorithm.GetPubkey(s.keyPair.Seckey),
-	}
-	t.PublisherSigs = []*rpcpb.Signature{publishSig}
+func (s *IOSTDevSDK) SignTx(t *rpcpb.TransactionRequest) (*rpcpb.TransactionRequest, error) {
+	if s.signer == nil {
+		return nil, fmt.Errorf("no key to sign the transaction of %v", s.accountName)
+	}
+	sig, err := s.signer.SignTx(toTx(t))
+	if err != nil {
+		return nil, err
+	}
+	t.PublisherSigs = []*rpcpb.Signature{toRPCSign(sig)}
 	t.Publisher = s.accountName
 	return t, nil
 }
@@ -348,7 +393,7 @@ func (s *IOSTDevSDK) checkTransaction(txHash string) error {
 
 // SendTx send transaction and check result if sdk.checkResult is set
 func (s *IOSTDevSDK) SendTx(tx *rpcpb.TransactionRequest) (string, error) {
-	signedTx, err := s.SignTx(tx, s.signAlgo)
+	signedTx, err := s.SignTx(tx)
 	if err != nil {
 		return "", fmt.Errorf("sign tx error %v", err)
 	}
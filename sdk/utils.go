@@ -10,6 +10,8 @@ import (
 	"github.com/golang/protobuf/proto"
 	"github.com/iost-official/go-iost/account"
 	"github.com/iost-official/go-iost/common"
+	"github.com/iost-official/go-iost/core/contract"
+	"github.com/iost-official/go-iost/core/tx"
 	"github.com/iost-official/go-iost/crypto"
 	"github.com/iost-official/go-iost/rpc/pb"
 )
@@ -110,6 +112,8 @@ func GetSignAlgoByName(name string) crypto.Algorithm {
 		return crypto.Secp256k1
 	case "ed25519":
 		return crypto.Ed25519
+	case "secp256r1":
+		return crypto.Secp256r1
 	default:
 		return crypto.Ed25519
 	}
@@ -122,15 +126,18 @@ func GetSignAlgoByEnum(enum rpcpb.Signature_Algorithm) crypto.Algorithm {
 		return crypto.Secp256k1
 	case rpcpb.Signature_ED25519:
 		return crypto.Ed25519
+	case rpcpb.Signature_SECP256R1:
+		return crypto.Secp256r1
 	default:
 		return crypto.Ed25519
 	}
 }
 
 // CheckPubKey check whether a string is a valid public key. Since it is not easy to check it fully, only check length here
+// ed25519 public keys are 32 bytes, and compressed secp256k1/secp256r1 public keys are 33 bytes
 func CheckPubKey(k string) bool {
 	bytes := common.Base58Decode(k)
-	if len(bytes) != 32 {
+	if len(bytes) != 32 && len(bytes) != 33 {
 		return false
 	}
 	return true
@@ -209,3 +216,37 @@ func txToBytes(t *rpcpb.TransactionRequest, withSign bool) []byte {
 
 	return se.Bytes()
 }
+
+// toTx converts the transaction request to the tx to sign, the publish hash of which is the same as txToBytes with signs.
+func toTx(t *rpcpb.TransactionRequest) *tx.Tx {
+	ret := &tx.Tx{
+		Time:       t.Time,
+		Expiration: t.Expiration,
+		GasRatio:   int64(t.GasRatio * 100),
+		GasLimit:   int64(t.GasLimit * 100),
+		Delay:      t.Delay,
+		ChainID:    t.ChainId,
+		Signers:    t.Signers,
+	}
+	for _, a := range t.Actions {
+		ret.Actions = append(ret.Actions, &tx.Action{
+			Contract:   a.Contract,
+			ActionName: a.ActionName,
+			Data:       a.Data,
+		})
+	}
+	for _, a := range t.AmountLimit {
+		ret.AmountLimit = append(ret.AmountLimit, &contract.Amount{
+			Token: a.Token,
+			Val:   a.Value,
+		})
+	}
+	for _, s := range t.Signatures {
+		ret.Signs = append(ret.Signs, &crypto.Signature{
+			Algorithm: crypto.Algorithm(s.Algorithm),
+			Pubkey:    s.PublicKey,
+			Sig:       s.Signature,
+		})
+	}
+	return ret
+}
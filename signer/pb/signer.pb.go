@@ -0,0 +1,555 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: signer/pb/signer.proto
+
+package signerpb
+
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	pb "github.com/iost-official/go-iost/core/block/pb"
+	pb1 "github.com/iost-official/go-iost/core/tx/pb"
+	pb2 "github.com/iost-official/go-iost/crypto/pb"
+	grpc "google.golang.org/grpc"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type GetPubkeyRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetPubkeyRequest) Reset()         { *m = GetPubkeyRequest{} }
+func (m *GetPubkeyRequest) String() string { return proto.CompactTextString(m) }
+func (*GetPubkeyRequest) ProtoMessage()    {}
+func (*GetPubkeyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59b89b7d87cd685c, []int{0}
+}
+
+func (m *GetPubkeyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPubkeyRequest.Unmarshal(m, b)
+}
+func (m *GetPubkeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPubkeyRequest.Marshal(b, m, deterministic)
+}
+func (m *GetPubkeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPubkeyRequest.Merge(m, src)
+}
+func (m *GetPubkeyRequest) XXX_Size() int {
+	return xxx_messageInfo_GetPubkeyRequest.Size(m)
+}
+func (m *GetPubkeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPubkeyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPubkeyRequest proto.InternalMessageInfo
+
+type GetPubkeyResponse struct {
+	Algorithm            int32    `protobuf:"varint,1,opt,name=algorithm,proto3" json:"algorithm,omitempty"`
+	Pubkey               []byte   `protobuf:"bytes,2,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetPubkeyResponse) Reset()         { *m = GetPubkeyResponse{} }
+func (m *GetPubkeyResponse) String() string { return proto.CompactTextString(m) }
+func (*GetPubkeyResponse) ProtoMessage()    {}
+func (*GetPubkeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59b89b7d87cd685c, []int{1}
+}
+
+func (m *GetPubkeyResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPubkeyResponse.Unmarshal(m, b)
+}
+func (m *GetPubkeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPubkeyResponse.Marshal(b, m, deterministic)
+}
+func (m *GetPubkeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPubkeyResponse.Merge(m, src)
+}
+func (m *GetPubkeyResponse) XXX_Size() int {
+	return xxx_messageInfo_GetPubkeyResponse.Size(m)
+}
+func (m *GetPubkeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPubkeyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPubkeyResponse proto.InternalMessageInfo
+
+func (m *GetPubkeyResponse) GetAlgorithm() int32 {
+	if m != nil {
+		return m.Algorithm
+	}
+	return 0
+}
+
+func (m *GetPubkeyResponse) GetPubkey() []byte {
+	if m != nil {
+		return m.Pubkey
+	}
+	return nil
+}
+
+type SignBlockRequest struct {
+	Head                 *pb.BlockHead `protobuf:"bytes,1,opt,name=head,proto3" json:"head,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *SignBlockRequest) Reset()         { *m = SignBlockRequest{} }
+func (m *SignBlockRequest) String() string { return proto.CompactTextString(m) }
+func (*SignBlockRequest) ProtoMessage()    {}
+func (*SignBlockRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59b89b7d87cd685c, []int{2}
+}
+
+func (m *SignBlockRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SignBlockRequest.Unmarshal(m, b)
+}
+func (m *SignBlockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SignBlockRequest.Marshal(b, m, deterministic)
+}
+func (m *SignBlockRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignBlockRequest.Merge(m, src)
+}
+func (m *SignBlockRequest) XXX_Size() int {
+	return xxx_messageInfo_SignBlockRequest.Size(m)
+}
+func (m *SignBlockRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignBlockRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignBlockRequest proto.InternalMessageInfo
+
+func (m *SignBlockRequest) GetHead() *pb.BlockHead {
+	if m != nil {
+		return m.Head
+	}
+	return nil
+}
+
+type SignAttestationRequest struct {
+	Number               int64    `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"`
+	BlockHash            []byte   `protobuf:"bytes,2,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SignAttestationRequest) Reset()         { *m = SignAttestationRequest{} }
+func (m *SignAttestationRequest) String() string { return proto.CompactTextString(m) }
+func (*SignAttestationRequest) ProtoMessage()    {}
+func (*SignAttestationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59b89b7d87cd685c, []int{3}
+}
+
+func (m *SignAttestationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SignAttestationRequest.Unmarshal(m, b)
+}
+func (m *SignAttestationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SignAttestationRequest.Marshal(b, m, deterministic)
+}
+func (m *SignAttestationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignAttestationRequest.Merge(m, src)
+}
+func (m *SignAttestationRequest) XXX_Size() int {
+	return xxx_messageInfo_SignAttestationRequest.Size(m)
+}
+func (m *SignAttestationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignAttestationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignAttestationRequest proto.InternalMessageInfo
+
+func (m *SignAttestationRequest) GetNumber() int64 {
+	if m != nil {
+		return m.Number
+	}
+	return 0
+}
+
+func (m *SignAttestationRequest) GetBlockHash() []byte {
+	if m != nil {
+		return m.BlockHash
+	}
+	return nil
+}
+
+type SignWitnessKeyRequest struct {
+	Pubkey               []byte   `protobuf:"bytes,1,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SignWitnessKeyRequest) Reset()         { *m = SignWitnessKeyRequest{} }
+func (m *SignWitnessKeyRequest) String() string { return proto.CompactTextString(m) }
+func (*SignWitnessKeyRequest) ProtoMessage()    {}
+func (*SignWitnessKeyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59b89b7d87cd685c, []int{4}
+}
+
+func (m *SignWitnessKeyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SignWitnessKeyRequest.Unmarshal(m, b)
+}
+func (m *SignWitnessKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SignWitnessKeyRequest.Marshal(b, m, deterministic)
+}
+func (m *SignWitnessKeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignWitnessKeyRequest.Merge(m, src)
+}
+func (m *SignWitnessKeyRequest) XXX_Size() int {
+	return xxx_messageInfo_SignWitnessKeyRequest.Size(m)
+}
+func (m *SignWitnessKeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignWitnessKeyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignWitnessKeyRequest proto.InternalMessageInfo
+
+func (m *SignWitnessKeyRequest) GetPubkey() []byte {
+	if m != nil {
+		return m.Pubkey
+	}
+	return nil
+}
+
+type SignTxRequest struct {
+	Tx                   *pb1.Tx  `protobuf:"bytes,1,opt,name=tx,proto3" json:"tx,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SignTxRequest) Reset()         { *m = SignTxRequest{} }
+func (m *SignTxRequest) String() string { return proto.CompactTextString(m) }
+func (*SignTxRequest) ProtoMessage()    {}
+func (*SignTxRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59b89b7d87cd685c, []int{5}
+}
+
+func (m *SignTxRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SignTxRequest.Unmarshal(m, b)
+}
+func (m *SignTxRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SignTxRequest.Marshal(b, m, deterministic)
+}
+func (m *SignTxRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignTxRequest.Merge(m, src)
+}
+func (m *SignTxRequest) XXX_Size() int {
+	return xxx_messageInfo_SignTxRequest.Size(m)
+}
+func (m *SignTxRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignTxRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignTxRequest proto.InternalMessageInfo
+
+func (m *SignTxRequest) GetTx() *pb1.Tx {
+	if m != nil {
+		return m.Tx
+	}
+	return nil
+}
+
+type SignResponse struct {
+	Signature            *pb2.Signature `protobuf:"bytes,1,opt,name=signature,proto3" json:"signature,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *SignResponse) Reset()         { *m = SignResponse{} }
+func (m *SignResponse) String() string { return proto.CompactTextString(m) }
+func (*SignResponse) ProtoMessage()    {}
+func (*SignResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59b89b7d87cd685c, []int{6}
+}
+
+func (m *SignResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SignResponse.Unmarshal(m, b)
+}
+func (m *SignResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SignResponse.Marshal(b, m, deterministic)
+}
+func (m *SignResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignResponse.Merge(m, src)
+}
+func (m *SignResponse) XXX_Size() int {
+	return xxx_messageInfo_SignResponse.Size(m)
+}
+func (m *SignResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignResponse proto.InternalMessageInfo
+
+func (m *SignResponse) GetSignature() *pb2.Signature {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*GetPubkeyRequest)(nil), "signerpb.GetPubkeyRequest")
+	proto.RegisterType((*GetPubkeyResponse)(nil), "signerpb.GetPubkeyResponse")
+	proto.RegisterType((*SignBlockRequest)(nil), "signerpb.SignBlockRequest")
+	proto.RegisterType((*SignAttestationRequest)(nil), "signerpb.SignAttestationRequest")
+	proto.RegisterType((*SignWitnessKeyRequest)(nil), "signerpb.SignWitnessKeyRequest")
+	proto.RegisterType((*SignTxRequest)(nil), "signerpb.SignTxRequest")
+	proto.RegisterType((*SignResponse)(nil), "signerpb.SignResponse")
+}
+
+func init() { proto.RegisterFile("signer/pb/signer.proto", fileDescriptor_59b89b7d87cd685c) }
+
+var fileDescriptor_59b89b7d87cd685c = []byte{
+	// 437 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x51, 0x6f, 0xd3, 0x30,
+	0x14, 0x85, 0x69, 0x81, 0x6a, 0xb9, 0x0c, 0x28, 0x96, 0x08, 0x55, 0x01, 0x51, 0xe5, 0x01, 0x8d,
+	0x87, 0x39, 0xd2, 0xe0, 0x69, 0x48, 0x48, 0xe3, 0x01, 0x86, 0x26, 0x04, 0x4a, 0x2b, 0xf1, 0x88,
+	0xec, 0xce, 0x4b, 0xac, 0xb5, 0xb1, 0x89, 0x6f, 0x24, 0xf7, 0x3f, 0xf3, 0x23, 0x90, 0x1d, 0xa7,
+	0x4b, 0x3a, 0xb4, 0xed, 0x2d, 0xbe, 0x3e, 0xe7, 0xd3, 0xc9, 0x3d, 0x86, 0xd8, 0xc8, 0xbc, 0x14,
+	0x55, 0xaa, 0x79, 0xda, 0x7c, 0x51, 0x5d, 0x29, 0x54, 0x64, 0xaf, 0x39, 0x69, 0x3e, 0x3d, 0xce,
+	0x25, 0x16, 0x35, 0xa7, 0x4b, 0xb5, 0x4e, 0xa5, 0x32, 0x78, 0xa8, 0x2e, 0x2e, 0xe4, 0x52, 0xb2,
+	0x55, 0x9a, 0xab, 0x43, 0x37, 0x48, 0x97, 0xd5, 0x46, 0xa3, 0x6a, 0x11, 0x0c, 0xeb, 0x4a, 0x34,
+	0x94, 0xbb, 0x78, 0x55, 0x25, 0x52, 0xbe, 0x52, 0xcb, 0x4b, 0xe7, 0xf7, 0x1f, 0xc1, 0xfb, 0xe1,
+	0x6e, 0x5e, 0xb4, 0xce, 0x88, 0xb6, 0x71, 0x25, 0x04, 0xc6, 0x5f, 0x05, 0xfe, 0xac, 0xf9, 0xa5,
+	0xd8, 0x64, 0xe2, 0x4f, 0x2d, 0x0c, 0x26, 0xdf, 0xe0, 0x59, 0x67, 0x66, 0xb4, 0x2a, 0x8d, 0x20,
+	0xaf, 0x20, 0x62, 0xab, 0x5c, 0x55, 0x12, 0x8b, 0xf5, 0x64, 0x30, 0x1b, 0x1c, 0x3c, 0xcc, 0xae,
+	0x06, 0x24, 0x86, 0x91, 0xf6, 0xfa, 0xc9, 0x70, 0x36, 0x38, 0xd8, 0xcf, 0xc2, 0x29, 0x39, 0x86,
+	0xf1, 0x5c, 0xe6, 0xe5, 0x67, 0x97, 0x33, 0xe0, 0xc9, 0x5b, 0x78, 0x50, 0x08, 0x76, 0xee, 0x21,
+	0x8f, 0x8e, 0x08, 0xf5, 0x3f, 0xa1, 0x39, 0xf5, 0xa2, 0x53, 0xc1, 0xce, 0x33, 0x7f, 0x9f, 0xfc,
+	0x80, 0xd8, 0x79, 0x4f, 0x10, 0x85, 0x41, 0x86, 0x52, 0x95, 0x2d, 0x21, 0x86, 0x51, 0x59, 0xaf,
+	0xb9, 0xa8, 0x3c, 0xe3, 0x7e, 0x16, 0x4e, 0xe4, 0x35, 0x80, 0x87, 0xfd, 0x2e, 0x98, 0x29, 0x42,
+	0x92, 0xc8, 0x4f, 0x4e, 0x99, 0x29, 0x92, 0x14, 0x9e, 0x3b, 0xe0, 0x2f, 0x89, 0xa5, 0x30, 0xe6,
+	0x4c, 0x6c, 0x3a, 0xbc, 0x90, 0x7e, 0xd0, 0x4b, 0xff, 0x0e, 0x1e, 0x3b, 0xc3, 0xc2, 0xb6, 0xc2,
+	0x09, 0x0c, 0xd1, 0x86, 0xe0, 0x7b, 0x14, 0xad, 0xe6, 0x74, 0x61, 0xb3, 0x21, 0xda, 0xe4, 0x13,
+	0xec, 0x3b, 0xe9, 0x76, 0x5d, 0x14, 0xa2, 0x6d, 0xb9, 0xc1, 0x30, 0xa6, 0x46, 0xe6, 0x9a, 0xd3,
+	0x79, 0x3b, 0xcf, 0xae, 0x24, 0x47, 0x7f, 0x87, 0x30, 0x9a, 0xfb, 0x27, 0x44, 0xbe, 0x40, 0xb4,
+	0x5d, 0x3f, 0x99, 0xd2, 0xf6, 0x61, 0xd1, 0xdd, 0x9e, 0xa6, 0x2f, 0xff, 0x7b, 0xd7, 0x04, 0x48,
+	0xee, 0x91, 0x13, 0x88, 0xb6, 0xbb, 0xef, 0x72, 0x76, 0x0b, 0x99, 0xc6, 0xfd, 0xbb, 0x0e, 0xe2,
+	0x3b, 0x3c, 0xdd, 0xa9, 0x80, 0xcc, 0xfa, 0xe2, 0xeb, 0xed, 0xdc, 0x80, 0x3b, 0x83, 0x27, 0xfd,
+	0x02, 0xc8, 0x9b, 0xbe, 0xf6, 0x5a, 0x35, 0x37, 0xc0, 0x3e, 0x36, 0x0b, 0x5b, 0x58, 0xf2, 0xa2,
+	0xaf, 0x59, 0xd8, 0x5b, 0xcd, 0x7c, 0xe4, 0x5f, 0xff, 0xfb, 0x7f, 0x03, 0x00, 0x0d, 0x3a, 0xc6,
+	0xe2, 0xcf, 0x03, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// SignerClient is the client API for Signer service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type SignerClient interface {
+	GetPubkey(ctx context.Context, in *GetPubkeyRequest, opts ...grpc.CallOption) (*GetPubkeyResponse, error)
+	SignBlock(ctx context.Context, in *SignBlockRequest, opts ...grpc.CallOption) (*SignResponse, error)
+	SignAttestation(ctx context.Context, in *SignAttestationRequest, opts ...grpc.CallOption) (*SignResponse, error)
+	SignWitnessKey(ctx context.Context, in *SignWitnessKeyRequest, opts ...grpc.CallOption) (*SignResponse, error)
+	SignTx(ctx context.Context, in *SignTxRequest, opts ...grpc.CallOption) (*SignResponse, error)
+}
+
+type signerClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewSignerClient(cc *grpc.ClientConn) SignerClient {
+	return &signerClient{cc}
+}
+
+func (c *signerClient) GetPubkey(ctx context.Context, in *GetPubkeyRequest, opts ...grpc.CallOption) (*GetPubkeyResponse, error) {
+	out := new(GetPubkeyResponse)
+	err := c.cc.Invoke(ctx, "/signerpb.Signer/GetPubkey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *signerClient) SignBlock(ctx context.Context, in *SignBlockRequest, opts ...grpc.CallOption) (*SignResponse, error) {
+	out := new(SignResponse)
+	err := c.cc.Invoke(ctx, "/signerpb.Signer/SignBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *signerClient) SignAttestation(ctx context.Context, in *SignAttestationRequest, opts ...grpc.CallOption) (*SignResponse, error) {
+	out := new(SignResponse)
+	err := c.cc.Invoke(ctx, "/signerpb.Signer/SignAttestation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *signerClient) SignWitnessKey(ctx context.Context, in *SignWitnessKeyRequest, opts ...grpc.CallOption) (*SignResponse, error) {
+	out := new(SignResponse)
+	err := c.cc.Invoke(ctx, "/signerpb.Signer/SignWitnessKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *signerClient) SignTx(ctx context.Context, in *SignTxRequest, opts ...grpc.CallOption) (*SignResponse, error) {
+	out := new(SignResponse)
+	err := c.cc.Invoke(ctx, "/signerpb.Signer/SignTx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SignerServer is the server API for Signer service.
+type SignerServer interface {
+	GetPubkey(context.Context, *GetPubkeyRequest) (*GetPubkeyResponse, error)
+	SignBlock(context.Context, *SignBlockRequest) (*SignResponse, error)
+	SignAttestation(context.Context, *SignAttestationRequest) (*SignResponse, error)
+	SignWitnessKey(context.Context, *SignWitnessKeyRequest) (*SignResponse, error)
+	SignTx(context.Context, *SignTxRequest) (*SignResponse, error)
+}
+
+func RegisterSignerServer(s *grpc.Server, srv SignerServer) {
+	s.RegisterService(&_Signer_serviceDesc, srv)
+}
+
+func _Signer_GetPubkey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPubkeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SignerServer).GetPubkey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/signerpb.Signer/GetPubkey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SignerServer).GetPubkey(ctx, req.(*GetPubkeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Signer_SignBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SignerServer).SignBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/signerpb.Signer/SignBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SignerServer).SignBlock(ctx, req.(*SignBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Signer_SignAttestation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignAttestationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SignerServer).SignAttestation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/signerpb.Signer/SignAttestation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SignerServer).SignAttestation(ctx, req.(*SignAttestationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Signer_SignWitnessKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignWitnessKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SignerServer).SignWitnessKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/signerpb.Signer/SignWitnessKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SignerServer).SignWitnessKey(ctx, req.(*SignWitnessKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Signer_SignTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignTxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SignerServer).SignTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/signerpb.Signer/SignTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SignerServer).SignTx(ctx, req.(*SignTxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Signer_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "signerpb.Signer",
+	HandlerType: (*SignerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetPubkey",
+			Handler:    _Signer_GetPubkey_Handler,
+		},
+		{
+			MethodName: "SignBlock",
+			Handler:    _Signer_SignBlock_Handler,
+		},
+		{
+			MethodName: "SignAttestation",
+			Handler:    _Signer_SignAttestation_Handler,
+		},
+		{
+			MethodName: "SignWitnessKey",
+			Handler:    _Signer_SignWitnessKey_Handler,
+		},
+		{
+			MethodName: "SignTx",
+			Handler:    _Signer_SignTx_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "signer/pb/signer.proto",
+}
@@ -0,0 +1,107 @@
+package signer
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/iost-official/go-iost/core/block"
+	"github.com/iost-official/go-iost/core/tx"
+	"github.com/iost-official/go-iost/crypto"
+	"github.com/iost-official/go-iost/signer/pb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+var signTimeout = time.Second
+
+// Remote is the signer asking a signer daemon for the signatures over grpc.
+type Remote struct {
+	conn   *grpc.ClientConn
+	client signerpb.SignerClient
+	pubkey []byte
+}
+
+// NewRemote connects to the signer daemon at the addr over mutual tls, and fetches the public key of it.
+func NewRemote(addr string, tlsConfig *tls.Config) (*Remote, error) {
+	conn, err := grpc.Dial(addr, grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)))
+	if err != nil {
+		return nil, fmt.Errorf("fail to dial signer %v: %v", addr, err)
+	}
+	r := &Remote{
+		conn:   conn,
+		client: signerpb.NewSignerClient(conn),
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), signTimeout)
+	defer cancel()
+	resp, err := r.client.GetPubkey(ctx, &signerpb.GetPubkeyRequest{})
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("fail to get pubkey of signer %v: %v", addr, err)
+	}
+	r.pubkey = resp.Pubkey
+	return r, nil
+}
+
+// Close closes the connection to the signer daemon.
+func (r *Remote) Close() error {
+	return r.conn.Close()
+}
+
+// Pubkey returns the public key of the signer daemon.
+func (r *Remote) Pubkey() []byte {
+	return r.pubkey
+}
+
+// SignBlock asks the signer daemon for the signature of the block head.
+func (r *Remote) SignBlock(head *block.BlockHead) (*crypto.Signature, error) {
+	hash, err := headHash(head)
+	if err != nil {
+		return nil, err
+	}
+	return r.sign(hash, func(ctx context.Context) (*signerpb.SignResponse, error) {
+		return r.client.SignBlock(ctx, &signerpb.SignBlockRequest{Head: head.ToPb()})
+	})
+}
+
+// SignAttestation asks the signer daemon for the signature of the attestation vote.
+func (r *Remote) SignAttestation(number int64, blockHash []byte) (*crypto.Signature, error) {
+	return r.sign(block.AttestationMessage(number, blockHash), func(ctx context.Context) (*signerpb.SignResponse, error) {
+		return r.client.SignAttestation(ctx, &signerpb.SignAttestationRequest{Number: number, BlockHash: blockHash})
+	})
+}
+
+// SignWitnessKey asks the signer daemon for the signature of the BLS public key of the witness.
+func (r *Remote) SignWitnessKey(blsPubkey []byte) (*crypto.Signature, error) {
+	return r.sign(block.WitnessKeyMessage(blsPubkey), func(ctx context.Context) (*signerpb.SignResponse, error) {
+		return r.client.SignWitnessKey(ctx, &signerpb.SignWitnessKeyRequest{Pubkey: blsPubkey})
+	})
+}
+
+// SignTx asks the signer daemon for the publisher signature of the tx.
+func (r *Remote) SignTx(t *tx.Tx) (*crypto.Signature, error) {
+	return r.sign(t.PublishHash(), func(ctx context.Context) (*signerpb.SignResponse, error) {
+		return r.client.SignTx(ctx, &signerpb.SignTxRequest{Tx: t.ToPb()})
+	})
+}
+
+// sign sends the request to the signer daemon, the signature is verified against the message before returned.
+func (r *Remote) sign(message []byte, request func(ctx context.Context) (*signerpb.SignResponse, error)) (*crypto.Signature, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), signTimeout)
+	defer cancel()
+	resp, err := request(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("fail to sign by remote signer: %v", err)
+	}
+	if resp.Signature == nil {
+		return nil, errors.New("empty signature from remote signer")
+	}
+	sig := (&crypto.Signature{}).FromPb(resp.Signature)
+	if !bytes.Equal(sig.Pubkey, r.pubkey) || !sig.Verify(message) {
+		return nil, errors.New("wrong signature from remote signer")
+	}
+	return sig, nil
+}
@@ -0,0 +1,136 @@
+package signer
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+
+	"github.com/iost-official/go-iost/common"
+	"github.com/iost-official/go-iost/core/block"
+	"github.com/iost-official/go-iost/core/tx"
+	"github.com/iost-official/go-iost/crypto"
+	"github.com/iost-official/go-iost/ilog"
+	"github.com/iost-official/go-iost/signer/pb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+// Guard refuses to sign a block conflicting with the signed ones, such as failover.SignGuard.
+type Guard interface {
+	// Sign records the block of the slot and number to sign, it returns an error if the block must not be signed.
+	Sign(slot, number int64) error
+}
+
+// Server serves a signer over grpc, it is the core of the signer daemon.
+//
+// Only the clients with a certificate signed by the CA of the tls config are served. Every block head is checked
+// by the guard before signed, so that the daemon never signs two conflicting blocks, even for a compromised node.
+type Server struct {
+	signer     Signer
+	algorithm  crypto.Algorithm
+	guard      Guard
+	grpcServer *grpc.Server
+	lis        net.Listener
+}
+
+// NewServer returns a server of the signer, the algorithm is the one of the key.
+func NewServer(signer Signer, algorithm crypto.Algorithm, guard Guard, tlsConfig *tls.Config) *Server {
+	s := &Server{
+		signer:     signer,
+		algorithm:  algorithm,
+		guard:      guard,
+		grpcServer: grpc.NewServer(grpc.Creds(credentials.NewTLS(tlsConfig))),
+	}
+	signerpb.RegisterSignerServer(s.grpcServer, s)
+	return s
+}
+
+// Start starts serving on the addr.
+func (s *Server) Start(addr string) error {
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		return fmt.Errorf("fail to listen signer addr: %v", err)
+	}
+	s.lis = lis
+	go func() {
+		if err := s.grpcServer.Serve(lis); err != nil {
+			ilog.Errorf("Signer server stopped: %v", err)
+		}
+	}()
+	return nil
+}
+
+// Addr returns the address the server is serving on.
+func (s *Server) Addr() string {
+	return s.lis.Addr().String()
+}
+
+// Stop stops the server.
+func (s *Server) Stop() {
+	s.grpcServer.GracefulStop()
+}
+
+// GetPubkey returns the public key of the signer.
+func (s *Server) GetPubkey(ctx context.Context, req *signerpb.GetPubkeyRequest) (*signerpb.GetPubkeyResponse, error) {
+	return &signerpb.GetPubkeyResponse{
+		Algorithm: int32(s.algorithm),
+		Pubkey:    s.signer.Pubkey(),
+	}, nil
+}
+
+// SignBlock signs the block head of the request if the guard allows.
+func (s *Server) SignBlock(ctx context.Context, req *signerpb.SignBlockRequest) (*signerpb.SignResponse, error) {
+	if req.Head == nil {
+		return nil, errors.New("empty block head")
+	}
+	head := (&block.BlockHead{}).FromPb(req.Head)
+	if err := s.guard.Sign(block.SlotOfHead(head), head.Number); err != nil {
+		ilog.Warnf("Refused to sign block %v of witness %v: %v", head.Number, head.Witness, err)
+		return nil, err
+	}
+	sig, err := s.signer.SignBlock(head)
+	if err != nil {
+		return nil, err
+	}
+	ilog.Infof("Signed block %v", head.Number)
+	return &signerpb.SignResponse{Signature: sig.ToPb()}, nil
+}
+
+// SignAttestation signs the attestation vote of the request.
+func (s *Server) SignAttestation(ctx context.Context, req *signerpb.SignAttestationRequest) (*signerpb.SignResponse, error) {
+	sig, err := s.signer.SignAttestation(req.Number, req.BlockHash)
+	if err != nil {
+		return nil, err
+	}
+	ilog.Infof("Signed attestation of block %v, hash:%v", req.Number, common.Base58Encode(req.BlockHash))
+	return &signerpb.SignResponse{Signature: sig.ToPb()}, nil
+}
+
+// SignWitnessKey signs the BLS public key of the request.
+func (s *Server) SignWitnessKey(ctx context.Context, req *signerpb.SignWitnessKeyRequest) (*signerpb.SignResponse, error) {
+	if len(req.Pubkey) == 0 {
+		return nil, errors.New("empty witness key")
+	}
+	sig, err := s.signer.SignWitnessKey(req.Pubkey)
+	if err != nil {
+		return nil, err
+	}
+	ilog.Infof("Signed witness key %v", common.Base58Encode(req.Pubkey))
+	return &signerpb.SignResponse{Signature: sig.ToPb()}, nil
+}
+
+// SignTx signs the tx of the request as the publisher.
+func (s *Server) SignTx(ctx context.Context, req *signerpb.SignTxRequest) (*signerpb.SignResponse, error) {
+	if req.Tx == nil {
+		return nil, errors.New("empty tx")
+	}
+	t := (&tx.Tx{}).FromPb(req.Tx)
+	sig, err := s.signer.SignTx(t)
+	if err != nil {
+		return nil, err
+	}
+	ilog.Infof("Signed tx %v", common.Base58Encode(t.Hash()))
+	return &signerpb.SignResponse{Signature: sig.ToPb()}, nil
+}
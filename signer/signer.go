@@ -0,0 +1,73 @@
+// Package signer signs the blocks and transactions with a key either kept in memory or by a remote signer daemon,
+// so that the keys of the producers can live on a separate host.
+package signer
+
+import (
+	"github.com/iost-official/go-iost/account"
+	"github.com/iost-official/go-iost/core/block"
+	"github.com/iost-official/go-iost/core/tx"
+	"github.com/iost-official/go-iost/crypto"
+)
+
+// Signer signs the block heads, the attestations and the transactions with a key.
+// The messages are typed, so that a remote signer knows what it signs and never signs a block head as a transaction.
+type Signer interface {
+	// Pubkey returns the public key of the signer.
+	Pubkey() []byte
+	// SignBlock returns the signature of the block head.
+	SignBlock(head *block.BlockHead) (*crypto.Signature, error)
+	// SignAttestation returns the signature of the attestation vote for the irreversible block.
+	SignAttestation(number int64, blockHash []byte) (*crypto.Signature, error)
+	// SignWitnessKey returns the signature binding the BLS public key to the witness, for the aggregate attestations.
+	SignWitnessKey(blsPubkey []byte) (*crypto.Signature, error)
+	// SignTx returns the publisher signature of the tx.
+	SignTx(t *tx.Tx) (*crypto.Signature, error)
+}
+
+// headHash returns the hash of the block head, which is signed by the witness.
+func headHash(head *block.BlockHead) ([]byte, error) {
+	blk := &block.Block{Head: head}
+	if err := blk.CalculateHeadHash(); err != nil {
+		return nil, err
+	}
+	return blk.HeadHash(), nil
+}
+
+// Local is the signer with the key pair in memory.
+type Local struct {
+	kp *account.KeyPair
+}
+
+// NewLocal returns a signer with the key pair.
+func NewLocal(kp *account.KeyPair) *Local {
+	return &Local{kp: kp}
+}
+
+// Pubkey returns the public key of the key pair.
+func (l *Local) Pubkey() []byte {
+	return l.kp.Pubkey
+}
+
+// SignBlock signs the block head with the key pair.
+func (l *Local) SignBlock(head *block.BlockHead) (*crypto.Signature, error) {
+	hash, err := headHash(head)
+	if err != nil {
+		return nil, err
+	}
+	return l.kp.Sign(hash), nil
+}
+
+// SignAttestation signs the attestation vote with the key pair.
+func (l *Local) SignAttestation(number int64, blockHash []byte) (*crypto.Signature, error) {
+	return l.kp.Sign(block.AttestationMessage(number, blockHash)), nil
+}
+
+// SignWitnessKey signs the BLS public key of the witness with the key pair.
+func (l *Local) SignWitnessKey(blsPubkey []byte) (*crypto.Signature, error) {
+	return l.kp.Sign(block.WitnessKeyMessage(blsPubkey)), nil
+}
+
+// SignTx signs the tx as the publisher with the key pair.
+func (l *Local) SignTx(t *tx.Tx) (*crypto.Signature, error) {
+	return l.kp.Sign(t.PublishHash()), nil
+}
@@ -0,0 +1,139 @@
+package signer
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/iost-official/go-iost/account"
+	"github.com/iost-official/go-iost/consensus/failover"
+	"github.com/iost-official/go-iost/core/block"
+	"github.com/iost-official/go-iost/core/tx"
+	"github.com/iost-official/go-iost/crypto"
+	"github.com/iost-official/go-iost/crypto/bls"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// newCert returns a certificate signed by the parent, or a self-signed CA if the parent is nil.
+func newCert(serial int64, parent *x509.Certificate, parentKey *ecdsa.PrivateKey, usage x509.ExtKeyUsage) (tls.Certificate, *x509.Certificate) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	So(err, ShouldBeNil)
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: "signer test"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+	}
+	if parent == nil {
+		tmpl.IsCA = true
+		tmpl.BasicConstraintsValid = true
+		parent, parentKey = tmpl, key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, parent, &key.PublicKey, parentKey)
+	So(err, ShouldBeNil)
+	cert, err := x509.ParseCertificate(der)
+	So(err, ShouldBeNil)
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key, Leaf: cert}, cert
+}
+
+func TestSigner(t *testing.T) {
+	Convey("Test of signer", t, func() {
+		kp, err := account.NewKeyPair(nil, crypto.Ed25519)
+		So(err, ShouldBeNil)
+		head := &block.BlockHead{Witness: kp.ReadablePubkey(), Number: 100, Time: 3e9}
+		hash, err := headHash(head)
+		So(err, ShouldBeNil)
+		trx := tx.NewTx([]*tx.Action{tx.NewAction("iost", "transfer", "[]")}, []string{"a@active"}, 100000, 100, 1e10, 0, tx.ChainID)
+
+		Convey("local signer", func() {
+			l := NewLocal(kp)
+			So(l.Pubkey(), ShouldResemble, kp.Pubkey)
+			sig, err := l.SignBlock(head)
+			So(err, ShouldBeNil)
+			So(sig.Verify(hash), ShouldBeTrue)
+			sig, err = l.SignAttestation(100, hash)
+			So(err, ShouldBeNil)
+			So(sig.Verify(block.AttestationMessage(100, hash)), ShouldBeTrue)
+			key, err := block.NewWitnessKey(bls.GenSeckey(), l.SignWitnessKey)
+			So(err, ShouldBeNil)
+			So(key.Verify(), ShouldBeNil)
+			So(key.Witness(), ShouldEqual, kp.ReadablePubkey())
+			sig, err = l.SignTx(trx)
+			So(err, ShouldBeNil)
+			So(sig.Verify(trx.PublishHash()), ShouldBeTrue)
+		})
+
+		caCert, ca := newCert(1, nil, nil, x509.ExtKeyUsageAny)
+		pool := x509.NewCertPool()
+		pool.AddCert(ca)
+		serverCert, _ := newCert(2, ca, caCert.PrivateKey.(*ecdsa.PrivateKey), x509.ExtKeyUsageServerAuth)
+		clientCert, _ := newCert(3, ca, caCert.PrivateKey.(*ecdsa.PrivateKey), x509.ExtKeyUsageClientAuth)
+
+		dir, err := ioutil.TempDir("", "signer")
+		So(err, ShouldBeNil)
+		defer os.RemoveAll(dir)
+		guard, err := failover.NewSignGuard(filepath.Join(dir, "guard"))
+		So(err, ShouldBeNil)
+
+		s := NewServer(NewLocal(kp), crypto.Ed25519, guard, newTLSConfig(serverCert, pool, true))
+		So(s.Start("127.0.0.1:0"), ShouldBeNil)
+		defer s.Stop()
+
+		Convey("remote signer", func() {
+			r, err := NewRemote(s.Addr(), newTLSConfig(clientCert, pool, false))
+			So(err, ShouldBeNil)
+			defer r.Close()
+			So(r.Pubkey(), ShouldResemble, kp.Pubkey)
+
+			sig, err := r.SignBlock(head)
+			So(err, ShouldBeNil)
+			So(sig.Verify(hash), ShouldBeTrue)
+			So(sig.Pubkey, ShouldResemble, kp.Pubkey)
+			sig, err = r.SignAttestation(100, hash)
+			So(err, ShouldBeNil)
+			So(sig.Verify(block.AttestationMessage(100, hash)), ShouldBeTrue)
+			key, err := block.NewWitnessKey(bls.GenSeckey(), r.SignWitnessKey)
+			So(err, ShouldBeNil)
+			So(key.Verify(), ShouldBeNil)
+			So(key.Witness(), ShouldEqual, kp.ReadablePubkey())
+			sig, err = r.SignTx(trx)
+			So(err, ShouldBeNil)
+			So(sig.Verify(trx.PublishHash()), ShouldBeTrue)
+
+			// The guard refuses another block of the same slot and number.
+			_, err = r.SignBlock(&block.BlockHead{Witness: kp.ReadablePubkey(), Number: 100, Time: 3e9 + 1})
+			So(err, ShouldNotBeNil)
+			_, err = r.SignBlock(&block.BlockHead{Witness: kp.ReadablePubkey(), Number: 101, Time: 3e9 + 1})
+			So(err, ShouldBeNil)
+		})
+
+		Convey("remote signer with a wrong key", func() {
+			other, err := account.NewKeyPair(nil, crypto.Ed25519)
+			So(err, ShouldBeNil)
+			r, err := NewRemote(s.Addr(), newTLSConfig(clientCert, pool, false))
+			So(err, ShouldBeNil)
+			defer r.Close()
+			r.pubkey = other.Pubkey
+			_, err = r.SignTx(trx)
+			So(err, ShouldNotBeNil)
+		})
+
+		Convey("client without a certificate", func() {
+			_, err := NewRemote(s.Addr(), &tls.Config{RootCAs: pool})
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
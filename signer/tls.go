@@ -0,0 +1,44 @@
+package signer
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io/ioutil"
+)
+
+// LoadTLSConfig returns the mutual tls config of the signer daemon or its clients. The certificate of each side
+// must be signed by the CA, so that only the nodes holding a client certificate are able to ask for signatures.
+func LoadTLSConfig(certFile, keyFile, caFile string, server bool) (*tls.Config, error) {
+	if certFile == "" || keyFile == "" || caFile == "" {
+		return nil, errors.New("the certificate, key and ca of the signer must be set")
+	}
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("fail to load signer certificate: %v", err)
+	}
+	b, err := ioutil.ReadFile(caFile)
+	if err != nil {
+		return nil, fmt.Errorf("fail to read signer ca: %v", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(b) {
+		return nil, fmt.Errorf("invalid signer ca %v", caFile)
+	}
+	return newTLSConfig(cert, pool, server), nil
+}
+
+func newTLSConfig(cert tls.Certificate, pool *x509.CertPool, server bool) *tls.Config {
+	conf := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+	if server {
+		conf.ClientCAs = pool
+		conf.ClientAuth = tls.RequireAndVerifyClientCert
+	} else {
+		conf.RootCAs = pool
+	}
+	return conf
+}
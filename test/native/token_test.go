@@ -624,3 +624,208 @@ func TestToken_TransferFreeze(t *testing.T) {
 
 	})
 }
+
+func TestToken_TransferFrom(t *testing.T) {
+	issuer0 := "issuer0"
+	e, host, code := InitVM(t, "token")
+	code.ID = "token.iost"
+	host.Context().Set("contract_name", "token.iost")
+	host.SetDeadline(time.Now().Add(10 * time.Second))
+	authList := host.Context().Value("auth_list").(map[string]int)
+
+	Convey("Test of Token transferFrom", t, func() {
+
+		Reset(func() {
+			e, host, code = InitVM(t, "token")
+			code.ID = "token.iost"
+			host.Context().Set("contract_name", "token.iost")
+			host.SetDeadline(time.Now().Add(10 * time.Second))
+			authList = host.Context().Value("auth_list").(map[string]int)
+
+			authList[issuer0] = 1
+			host.Context().Set("auth_list", authList)
+			_, _, err := e.LoadAndCall(host, code, "create", "iost", "issuer0", int64(100), []byte("{}"))
+			So(err, ShouldBeNil)
+
+			_, _, err = e.LoadAndCall(host, code, "issue", "iost", "issuer0", "100.0")
+			So(err, ShouldBeNil)
+		})
+
+		Convey("correct transferFrom", func() {
+			_, _, err := e.LoadAndCall(host, code, "approve", "iost", "issuer0", "user0", "30")
+			So(err, ShouldBeNil)
+
+			rs, _, err := e.LoadAndCall(host, code, "allowance", "iost", "issuer0", "user0")
+			So(err, ShouldBeNil)
+			So(rs[0], ShouldEqual, "30")
+
+			delete(authList, issuer0)
+			authList["user0"] = 1
+			_, cost, err := e.LoadAndCall(host, code, "transferFrom", "iost", "user0", "issuer0", "user1", "22.3", "")
+			So(err, ShouldBeNil)
+			So(cost.ToGas(), ShouldBeGreaterThan, 0)
+
+			rs, _, err = e.LoadAndCall(host, code, "balanceOf", "iost", "user1")
+			So(err, ShouldBeNil)
+			So(rs[0], ShouldEqual, "22.3")
+
+			rs, _, err = e.LoadAndCall(host, code, "balanceOf", "iost", "issuer0")
+			So(err, ShouldBeNil)
+			So(rs[0], ShouldEqual, "77.7")
+
+			rs, _, err = e.LoadAndCall(host, code, "allowance", "iost", "issuer0", "user0")
+			So(err, ShouldBeNil)
+			So(rs[0], ShouldEqual, "7.7")
+		})
+
+		Convey("transferFrom exceeds allowance", func() {
+			_, _, err := e.LoadAndCall(host, code, "approve", "iost", "issuer0", "user0", "1")
+			So(err, ShouldBeNil)
+
+			authList["user0"] = 1
+			_, _, err = e.LoadAndCall(host, code, "transferFrom", "iost", "user0", "issuer0", "user1", "1.1", "")
+			So(err.Error(), ShouldEqual, "allowance not enough 1 < 1.1")
+
+			_, _, err = e.LoadAndCall(host, code, "transferFrom", "iost", "user1", "issuer0", "user1", "1", "")
+			So(err.Error(), ShouldEqual, "transaction has no permission")
+		})
+
+		Convey("revoke allowance", func() {
+			_, _, err := e.LoadAndCall(host, code, "approve", "iost", "issuer0", "user0", "10")
+			So(err, ShouldBeNil)
+			_, _, err = e.LoadAndCall(host, code, "approve", "iost", "issuer0", "user0", "0")
+			So(err, ShouldBeNil)
+
+			rs, _, err := e.LoadAndCall(host, code, "allowance", "iost", "issuer0", "user0")
+			So(err, ShouldBeNil)
+			So(rs[0], ShouldEqual, "0")
+
+			authList["user0"] = 1
+			_, _, err = e.LoadAndCall(host, code, "transferFrom", "iost", "user0", "issuer0", "user1", "1", "")
+			So(err.Error(), ShouldStartWith, "allowance not enough")
+		})
+
+		Convey("approve without auth", func() {
+			_, _, err := e.LoadAndCall(host, code, "approve", "iost", "user1", "user0", "10")
+			So(err.Error(), ShouldEqual, "transaction has no permission")
+		})
+	})
+}
+
+func TestToken_IssuerControls(t *testing.T) {
+	issuer0 := "issuer0"
+	e, host, code := InitVM(t, "token")
+	code.ID = "token.iost"
+	host.Context().Set("contract_name", "token.iost")
+	host.SetDeadline(time.Now().Add(10 * time.Second))
+	authList := host.Context().Value("auth_list").(map[string]int)
+
+	Convey("Test of Token issuer controls", t, func() {
+
+		Reset(func() {
+			e, host, code = InitVM(t, "token")
+			code.ID = "token.iost"
+			host.Context().Set("contract_name", "token.iost")
+			host.SetDeadline(time.Now().Add(10 * time.Second))
+			authList = host.Context().Value("auth_list").(map[string]int)
+
+			authList[issuer0] = 1
+			host.Context().Set("auth_list", authList)
+			_, _, err := e.LoadAndCall(host, code, "create", "iost1", "issuer0", int64(100), []byte("{}"))
+			So(err, ShouldBeNil)
+
+			_, _, err = e.LoadAndCall(host, code, "issue", "iost1", "issuer0", "100.0")
+			So(err, ShouldBeNil)
+		})
+
+		Convey("pause and unpause", func() {
+			_, _, err := e.LoadAndCall(host, code, "pause", "iost1")
+			So(err, ShouldBeNil)
+
+			_, _, err = e.LoadAndCall(host, code, "transfer", "iost1", "issuer0", "user0", "1", "")
+			So(err.Error(), ShouldEqual, "token paused")
+
+			_, _, err = e.LoadAndCall(host, code, "unpause", "iost1")
+			So(err, ShouldBeNil)
+
+			_, _, err = e.LoadAndCall(host, code, "transfer", "iost1", "issuer0", "user0", "1", "")
+			So(err, ShouldBeNil)
+		})
+
+		Convey("freeze account", func() {
+			_, _, err := e.LoadAndCall(host, code, "freezeAccount", "iost1", "user0")
+			So(err, ShouldBeNil)
+
+			_, _, err = e.LoadAndCall(host, code, "transfer", "iost1", "issuer0", "user0", "1", "")
+			So(err.Error(), ShouldEqual, "account user0 is frozen")
+
+			_, _, err = e.LoadAndCall(host, code, "transfer", "iost1", "issuer0", "user1", "1", "")
+			So(err, ShouldBeNil)
+
+			_, _, err = e.LoadAndCall(host, code, "approve", "iost1", "issuer0", "user0", "10")
+			So(err, ShouldBeNil)
+			authList["user0"] = 1
+			_, _, err = e.LoadAndCall(host, code, "transferFrom", "iost1", "user0", "issuer0", "user1", "1", "")
+			So(err.Error(), ShouldEqual, "account user0 is frozen")
+
+			_, _, err = e.LoadAndCall(host, code, "unfreezeAccount", "iost1", "user0")
+			So(err, ShouldBeNil)
+
+			_, _, err = e.LoadAndCall(host, code, "transfer", "iost1", "issuer0", "user0", "1", "")
+			So(err, ShouldBeNil)
+		})
+
+		Convey("set total supply", func() {
+			_, _, err := e.LoadAndCall(host, code, "setTotalSupply", "iost1", "99")
+			So(err.Error(), ShouldStartWith, "totalSupply less than supply")
+
+			_, _, err = e.LoadAndCall(host, code, "setTotalSupply", "iost1", "150")
+			So(err, ShouldBeNil)
+
+			rs, _, err := e.LoadAndCall(host, code, "totalSupply", "iost1")
+			So(err, ShouldBeNil)
+			So(rs[0], ShouldEqual, "150")
+
+			_, _, err = e.LoadAndCall(host, code, "issue", "iost1", "issuer0", "50")
+			So(err, ShouldBeNil)
+		})
+
+		Convey("transfer issuer", func() {
+			_, _, err := e.LoadAndCall(host, code, "transferIssuer", "iost1", "user0")
+			So(err, ShouldBeNil)
+
+			_, _, err = e.LoadAndCall(host, code, "pause", "iost1")
+			So(err.Error(), ShouldEqual, "transaction has no permission")
+
+			authList["user0"] = 1
+			_, _, err = e.LoadAndCall(host, code, "pause", "iost1")
+			So(err, ShouldBeNil)
+		})
+
+		Convey("controls of a system token", func() {
+			_, _, err := e.LoadAndCall(host, code, "create", "iost", "issuer0", int64(100), []byte("{}"))
+			So(err, ShouldBeNil)
+
+			_, _, err = e.LoadAndCall(host, code, "pause", "iost")
+			So(err.Error(), ShouldEqual, "system token iost can not be controlled")
+
+			_, _, err = e.LoadAndCall(host, code, "freezeAccount", "iost", "user0")
+			So(err.Error(), ShouldEqual, "system token iost can not be controlled")
+
+			_, _, err = e.LoadAndCall(host, code, "setTotalSupply", "iost", "200")
+			So(err.Error(), ShouldEqual, "system token iost can not be controlled")
+		})
+
+		Convey("controls without auth", func() {
+			delete(authList, issuer0)
+			_, _, err := e.LoadAndCall(host, code, "pause", "iost1")
+			So(err.Error(), ShouldEqual, "transaction has no permission")
+
+			_, _, err = e.LoadAndCall(host, code, "freezeAccount", "iost1", "user0")
+			So(err.Error(), ShouldEqual, "transaction has no permission")
+
+			_, _, err = e.LoadAndCall(host, code, "setTotalSupply", "iost1", "200")
+			So(err.Error(), ShouldEqual, "transaction has no permission")
+		})
+	})
+}
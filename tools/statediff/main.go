@@ -0,0 +1,110 @@
+// Command statediff compares the state dbs of two nodes and prints the divergent keys.
+//
+// The state roots in the block heads tell the first block whose execution diverges, this tool tells which keys.
+// Stop both nodes, or copy their state dbs, at the same irreversible block before comparing:
+//
+//	go run tools/statediff/main.go -a node1/storage/StateDB -b node2/storage/StateDB
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/iost-official/go-iost/db/kv/leveldb"
+)
+
+var (
+	pathA = flag.String("a", "", "path of the first state db")
+	pathB = flag.String("b", "", "path of the second state db")
+	limit = flag.Int("n", 10, "the number of divergent keys printed, 0 means all")
+)
+
+// internal keys of mvccdb, such as the tag and the archive, start with the separator.
+const separator = '/'
+
+func open(path string) *leveldb.DB {
+	db, err := leveldb.NewDB(path)
+	if err != nil {
+		fmt.Printf("open %v failed: %v\n", path, err)
+		os.Exit(1)
+	}
+	return db
+}
+
+func next(iter *leveldb.Iter) bool {
+	for iter.Next() {
+		if len(iter.Key()) > 0 && iter.Key()[0] != separator {
+			return true
+		}
+	}
+	return false
+}
+
+func main() {
+	flag.Parse()
+	if *pathA == "" || *pathB == "" {
+		flag.Usage()
+		os.Exit(1)
+	}
+	a, b := open(*pathA), open(*pathB)
+	defer a.Close()
+	defer b.Close()
+
+	tagA, _ := a.Get([]byte{separator, 't', 'a', 'g'})
+	tagB, _ := b.Get([]byte{separator, 't', 'a', 'g'})
+	if !bytes.Equal(tagA, tagB) {
+		fmt.Println("warning: the state dbs are flushed at different blocks, the differences may be normal")
+	}
+
+	iterA := a.NewIteratorByRange(nil, nil).(*leveldb.Iter)
+	iterB := b.NewIteratorByRange(nil, nil).(*leveldb.Iter)
+	defer iterA.Release()
+	defer iterB.Release()
+
+	count := 0
+	report := func(format string, args ...interface{}) bool {
+		count++
+		fmt.Printf(format+"\n", args...)
+		return *limit <= 0 || count < *limit
+	}
+	okA, okB := next(iterA), next(iterB)
+	for okA || okB {
+		var c int
+		switch {
+		case !okA:
+			c = 1
+		case !okB:
+			c = -1
+		default:
+			c = bytes.Compare(iterA.Key(), iterB.Key())
+		}
+		goon := true
+		switch {
+		case c < 0:
+			goon = report("only in a: %q = %q", iterA.Key(), iterA.Value())
+			okA = next(iterA)
+		case c > 0:
+			goon = report("only in b: %q = %q", iterB.Key(), iterB.Value())
+			okB = next(iterB)
+		default:
+			if !bytes.Equal(iterA.Value(), iterB.Value()) {
+				goon = report("different: %q, a = %q, b = %q", iterA.Key(), iterA.Value(), iterB.Value())
+			}
+			okA, okB = next(iterA), next(iterB)
+		}
+		if !goon {
+			break
+		}
+	}
+	if err := iterA.Error(); err != nil {
+		fmt.Printf("iterate %v failed: %v\n", *pathA, err)
+	}
+	if err := iterB.Error(); err != nil {
+		fmt.Printf("iterate %v failed: %v\n", *pathB, err)
+	}
+	if count == 0 {
+		fmt.Println("no divergent key")
+	}
+}
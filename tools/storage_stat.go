@@ -1,6 +1,7 @@
 package main
 
 import (
+	"encoding/binary"
 	"fmt"
 	"strings"
 
@@ -130,6 +131,35 @@ func printRAMUsage(db *leveldb.DB) {
 	fmt.Println()
 }
 
+func printArchiveUsage(db *leveldb.DB) {
+	fmt.Println("######## state archive usage #############")
+	for _, name := range []string{"tag", "block", "history"} {
+		count := 0
+		size := 0
+		iter := db.NewIteratorByPrefix([]byte("/archive/" + name + "/")).(*leveldb.Iter)
+		for iter.Next() {
+			count++
+			size += len(iter.Key()) + len(iter.Value())
+		}
+		iter.Release()
+		err := iter.Error()
+		if err != nil {
+			panic(err)
+		}
+		fmt.Printf("%v\t%v entries\t%v bytes\n", padTo(name, " ", 20), count, size)
+	}
+	for _, name := range []string{"start", "seq"} {
+		raw, err := db.Get([]byte("/archive/" + name))
+		if err != nil {
+			panic(err)
+		}
+		if len(raw) == 8 {
+			fmt.Printf("%v\t%v\n", padTo(name, " ", 20), binary.BigEndian.Uint64(raw))
+		}
+	}
+	fmt.Println()
+}
+
 func main() {
 	storagePath := "storage/StateDB"
 	db, err := leveldb.NewDB(storagePath)
@@ -142,5 +172,6 @@ func main() {
 	printRAMUsage(db)
 	printTokenBalance(db, "iost")
 	printTokenBalance(db, "ram")
+	printArchiveUsage(db)
 	//printAll(db)
 }
@@ -134,3 +134,40 @@ func (m *TokenHandler) Decimal(tokenName string) int {
 	}
 	return int(decimal)
 }
+
+// TokenInfo is the metadata of a token created by token.iost
+type TokenInfo struct {
+	Symbol                string
+	FullName              string
+	Issuer                string
+	Supply                int64
+	TotalSupply           int64
+	Decimal               int
+	CanTransfer           bool
+	OnlyIssuerCanTransfer bool
+	Paused                bool
+}
+
+func (m *TokenHandler) infoKey(tokenName, field string) string {
+	return "m-" + TokenContractName + "-" + "TI" + tokenName + "-" + field
+}
+
+// TokenInfo get metadata of the token, nil if the token does not exist
+func (m *TokenHandler) TokenInfo(tokenName string) *TokenInfo {
+	issuer, ok := Unmarshal(m.db.Get(m.infoKey(tokenName, "issuer"))).(string)
+	if !ok {
+		return nil
+	}
+	info := &TokenInfo{
+		Symbol:  tokenName,
+		Issuer:  issuer,
+		Decimal: m.Decimal(tokenName),
+	}
+	info.FullName, _ = Unmarshal(m.db.Get(m.infoKey(tokenName, "fullName"))).(string)
+	info.Supply, _ = Unmarshal(m.db.Get(m.infoKey(tokenName, "supply"))).(int64)
+	info.TotalSupply, _ = Unmarshal(m.db.Get(m.infoKey(tokenName, "totalSupply"))).(int64)
+	info.CanTransfer, _ = Unmarshal(m.db.Get(m.infoKey(tokenName, "canTransfer"))).(bool)
+	info.OnlyIssuerCanTransfer, _ = Unmarshal(m.db.Get(m.infoKey(tokenName, "onlyIssuerCanTransfer"))).(bool)
+	info.Paused, _ = Unmarshal(m.db.Get(m.infoKey(tokenName, "paused"))).(bool)
+	return info
+}
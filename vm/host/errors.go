@@ -27,6 +27,7 @@ var (
 	ErrTokenNotExists            = errors.New("token not exists")
 	ErrAmountLimitTokenNotExists = errors.New("token not exists in amountLimit")
 	ErrTokenNoTransfer           = errors.New("token can't transfer")
+	ErrTokenPaused               = errors.New("token paused")
 	ErrTokenIssueRefused         = errors.New("token issue refused")
 	ErrMemoTooLarge              = errors.New("memo too large")
 
@@ -189,6 +189,14 @@ func (m *Monitor) Call(h *host.Host, contractName, api string, jarg string) (rtn
 				if from != to && !h.IsContract(from) {
 					amount, _ = common.NewFixed(args[3].(string), h.DB().Decimal(token))
 				}
+			} else if receipt.FuncName == "token.iost/transferFrom" {
+				_ = json.Unmarshal([]byte(receipt.Content), &args)
+				token = args[0].(string)
+				from := args[2].(string)
+				to := args[3].(string)
+				if from != to && !h.IsContract(from) {
+					amount, _ = common.NewFixed(args[4].(string), h.DB().Decimal(token))
+				}
 			} else if receipt.FuncName == "token.iost/destroy" {
 				_ = json.Unmarshal([]byte(receipt.Content), &args)
 				token = args[0].(string)
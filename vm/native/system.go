@@ -2,10 +2,14 @@ package native
 
 import (
 	"errors"
+	"fmt"
+	"strconv"
 
 	"encoding/json"
 
 	"github.com/bitly/go-simplejson"
+	"github.com/iost-official/go-iost/common"
+	"github.com/iost-official/go-iost/core/block"
 	"github.com/iost-official/go-iost/core/contract"
 	"github.com/iost-official/go-iost/vm/host"
 )
@@ -22,8 +26,12 @@ func init() {
 	systemABIs.Register(cancelDelaytx)
 	systemABIs.Register(hostSettings)
 	systemABIs.Register(updateNativeCode)
+	systemABIs.Register(verifyDoubleSign)
 }
 
+// verifySignCost is the cost of verifying a signature, the same as the crypto of v8vm.
+var verifySignCost = contract.NewCost(0, 0, 100)
+
 // var .
 var (
 	requireAuth = &abi{
@@ -256,4 +264,42 @@ var (
 			return nil, cost, nil
 		},
 	}
+
+	// verifyDoubleSign verifies the base58 encoded double sign evidence of a witness,
+	// and returns the witness and the block number. One of the heads must be a block of this chain, which is
+	// recorded as chain_info_<hash> by base.iost, so the evidence of another chain is not accepted.
+	verifyDoubleSign = &abi{
+		name: "verifyDoubleSign",
+		args: []string{"string"},
+		do: func(h *host.Host, args ...interface{}) (rtn []interface{}, cost contract.Cost, err error) {
+			cost = contract.Cost0()
+			b := common.Base58Decode(args[0].(string))
+			cost.AddAssign(host.CommonOpCost(1))
+			cost.AddAssign(host.Costs["OpPrice"].Multiply(int64(len(b))))
+
+			e := &block.DoubleSignEvidence{}
+			if err = e.Decode(b); err != nil {
+				return nil, cost, err
+			}
+			cost.AddAssign(verifySignCost.Multiply(2))
+			if !CheckCost(h, cost) {
+				return nil, cost, host.ErrOutOfGas
+			}
+			if err = e.Verify(); err != nil {
+				return nil, cost, fmt.Errorf("invalid double sign evidence: %v", err)
+			}
+			if err = e.VerifyRecent(h.Context().Value("number").(int64)); err != nil {
+				return nil, cost, fmt.Errorf("invalid double sign evidence: %v", err)
+			}
+			hash1, hash2 := e.Hashes()
+			ok1, cost0 := h.GlobalHas("base.iost", "chain_info_"+common.Base58Encode(hash1))
+			cost.AddAssign(cost0)
+			ok2, cost0 := h.GlobalHas("base.iost", "chain_info_"+common.Base58Encode(hash2))
+			cost.AddAssign(cost0)
+			if !ok1 && !ok2 {
+				return nil, cost, errors.New("invalid double sign evidence: no head is a block of this chain")
+			}
+			return []interface{}{e.Witness(), strconv.FormatInt(e.Number(), 10)}, cost, nil
+		},
+	}
 )
@@ -21,6 +21,8 @@ const (
 	TokenInfoMapPrefix            = "TI"
 	TokenBalanceMapPrefix         = "TB"
 	TokenFreezeMapPrefix          = "TF"
+	TokenAllowanceMapPrefix       = "TA"
+	TokenFrozenAccountMapPrefix   = "TZ"
 	IssuerMapField                = "issuer"
 	SupplyMapField                = "supply"
 	TotalSupplyMapField           = "totalSupply"
@@ -29,6 +31,8 @@ const (
 	DefaultRateMapField           = "defaultRate"
 	DecimalMapField               = "decimal"
 	FullNameMapField              = "fullName"
+	PausedMapField                = "paused"
+	ControlsMapField              = "controls"
 )
 
 func init() {
@@ -42,6 +46,15 @@ func init() {
 	tokenABIs.Register(supplyTokenABI)
 	tokenABIs.Register(totalSupplyTokenABI)
 	tokenABIs.Register(destroyTokenABI)
+	tokenABIs.Register(approveTokenABI)
+	tokenABIs.Register(allowanceTokenABI)
+	tokenABIs.Register(transferFromTokenABI)
+	tokenABIs.Register(pauseTokenABI)
+	tokenABIs.Register(unpauseTokenABI)
+	tokenABIs.Register(freezeAccountTokenABI)
+	tokenABIs.Register(unfreezeAccountTokenABI)
+	tokenABIs.Register(setTotalSupplyTokenABI)
+	tokenABIs.Register(transferIssuerTokenABI)
 }
 
 func checkTokenExists(h *host.Host, tokenSym string) (ok bool, cost contract.Cost) {
@@ -49,12 +62,62 @@ func checkTokenExists(h *host.Host, tokenSym string) (ok bool, cost contract.Cos
 	return exists, cost0
 }
 
+func isSystemToken(tokenSym string) bool {
+	return tokenSym == "iost" || tokenSym == "ram"
+}
+
+// requireIssuer checks that the token exists and the tx has the token permission of its issuer.
+// The system tokens are never under the control of an issuer.
+func requireIssuer(h *host.Host, tokenSym string) (cost contract.Cost, err error) {
+	if isSystemToken(tokenSym) {
+		return contract.Cost0(), fmt.Errorf("system token %v can not be controlled", tokenSym)
+	}
+	ok, cost := checkTokenExists(h, tokenSym)
+	if !ok {
+		return cost, host.ErrTokenNotExists
+	}
+	issuer, cost0 := h.MapGet(TokenInfoMapPrefix+tokenSym, IssuerMapField)
+	cost.AddAssign(cost0)
+	ok, cost0 = h.RequireAuth(issuer.(string), TokenPermission)
+	cost.AddAssign(cost0)
+	if !ok {
+		return cost, host.ErrPermissionLost
+	}
+	return cost, nil
+}
+
+// checkNotPaused checks that the issuer has neither paused the token nor frozen any of the accounts.
+// Nothing is charged for the tokens whose issuer never used the controls, so that their transfers cost the same gas
+// as before the controls existed and the old blocks replay unchanged.
+func checkNotPaused(h *host.Host, tokenSym string, accounts ...string) (cost contract.Cost, err error) {
+	if isSystemToken(tokenSym) {
+		return contract.Cost0(), nil
+	}
+	controls, cost := h.MapGet(TokenInfoMapPrefix+tokenSym, ControlsMapField)
+	if c, ok := controls.(bool); !ok || !c {
+		return contract.Cost0(), nil
+	}
+	paused, cost0 := h.MapGet(TokenInfoMapPrefix+tokenSym, PausedMapField)
+	cost.AddAssign(cost0)
+	if p, ok := paused.(bool); ok && p {
+		return cost, host.ErrTokenPaused
+	}
+	for _, acc := range accounts {
+		frozen, cost0 := h.MapHas(TokenFrozenAccountMapPrefix+tokenSym, acc)
+		cost.AddAssign(cost0)
+		if frozen {
+			return cost, fmt.Errorf("account %v is frozen", acc)
+		}
+	}
+	return cost, nil
+}
+
 func setBalance(h *host.Host, tokenSym string, from string, balance int64, ramPayer string) (cost contract.Cost) {
 	ok, cost := h.MapHas(TokenBalanceMapPrefix+from, tokenSym)
 	if ok {
 		cost0, _ := h.MapPut(TokenBalanceMapPrefix+from, tokenSym, balance)
 		cost.AddAssign(cost0)
-	} else if (tokenSym == "iost" || tokenSym == "ram") && !strings.HasPrefix(from, "Contract") {
+	} else if isSystemToken(tokenSym) && !strings.HasPrefix(from, "Contract") {
 		cost0, _ := h.MapPut(TokenBalanceMapPrefix+from, tokenSym, balance)
 		cost.AddAssign(cost0)
 	} else {
@@ -162,6 +225,34 @@ func freezeBalance(h *host.Host, tokenSym string, from string, balance int64, ft
 	return cost, nil
 }
 
+// allowanceField is the field under TokenAllowanceMapPrefix+owner holding what spender may spend of tokenSym.
+func allowanceField(tokenSym string, spender string) string {
+	return tokenSym + ":" + spender
+}
+
+func getAllowance(h *host.Host, tokenSym string, owner string, spender string) (allowance int64, cost contract.Cost) {
+	ok, cost := h.MapHas(TokenAllowanceMapPrefix+owner, allowanceField(tokenSym, spender))
+	if !ok {
+		return 0, cost
+	}
+	tmp, cost0 := h.MapGet(TokenAllowanceMapPrefix+owner, allowanceField(tokenSym, spender))
+	cost.AddAssign(cost0)
+	return tmp.(int64), cost
+}
+
+func setAllowance(h *host.Host, tokenSym string, owner string, spender string, allowance int64, ramPayer string) (cost contract.Cost, err error) {
+	if allowance == 0 {
+		ok, cost := h.MapHas(TokenAllowanceMapPrefix+owner, allowanceField(tokenSym, spender))
+		if !ok {
+			return cost, nil
+		}
+		cost0, err := h.MapDel(TokenAllowanceMapPrefix+owner, allowanceField(tokenSym, spender))
+		cost.AddAssign(cost0)
+		return cost, err
+	}
+	return h.MapPut(TokenAllowanceMapPrefix+owner, allowanceField(tokenSym, spender), allowance, ramPayer)
+}
+
 func parseAmount(h *host.Host, tokenSym string, amountStr string) (amount int64, cost contract.Cost, err error) {
 	decimal, cost := h.MapGet(TokenInfoMapPrefix+tokenSym, DecimalMapField)
 	amountNumber, err := common.NewFixed(amountStr, int(decimal.(int64)))
@@ -192,6 +283,104 @@ func checkTokenSymValid(symbol string) error {
 	return nil
 }
 
+// enableControls marks the token once paused or frozen, from then on every transfer checks the controls.
+func enableControls(h *host.Host, tokenSym string, ramPayer string) (cost contract.Cost, err error) {
+	ok, cost := h.MapHas(TokenInfoMapPrefix+tokenSym, ControlsMapField)
+	if ok {
+		return cost, nil
+	}
+	cost0, err := h.MapPut(TokenInfoMapPrefix+tokenSym, ControlsMapField, true, ramPayer)
+	cost.AddAssign(cost0)
+	return cost, err
+}
+
+func setPaused(h *host.Host, paused bool, args ...interface{}) (rtn []interface{}, cost contract.Cost, err error) {
+	cost = contract.Cost0()
+	cost.AddAssign(host.CommonOpCost(1))
+	tokenSym := args[0].(string)
+
+	// check auth
+	cost0, err := requireIssuer(h, tokenSym)
+	cost.AddAssign(cost0)
+	if err != nil {
+		return nil, cost, err
+	}
+	if !CheckCost(h, cost) {
+		return nil, cost, host.ErrOutOfGas
+	}
+
+	publisher := h.Context().Value("publisher").(string)
+	cost0, err = enableControls(h, tokenSym, publisher)
+	cost.AddAssign(cost0)
+	if err != nil {
+		return nil, cost, err
+	}
+	cost0, err = h.MapPut(TokenInfoMapPrefix+tokenSym, PausedMapField, paused, publisher)
+	cost.AddAssign(cost0)
+	if err != nil {
+		return nil, cost, err
+	}
+
+	// generate receipt
+	message, err := json.Marshal(args)
+	cost.AddAssign(host.CommonOpCost(1))
+	if err != nil {
+		return nil, cost, err
+	}
+	cost0 = h.Receipt(string(message))
+	cost.AddAssign(cost0)
+	return []interface{}{}, cost, nil
+}
+
+func setAccountFrozen(h *host.Host, frozen bool, args ...interface{}) (rtn []interface{}, cost contract.Cost, err error) {
+	cost = contract.Cost0()
+	cost.AddAssign(host.CommonOpCost(1))
+	tokenSym := args[0].(string)
+	acc := args[1].(string)
+	if !h.IsValidAccount(acc) {
+		return nil, cost, fmt.Errorf("invalid account %v", acc)
+	}
+
+	// check auth
+	cost0, err := requireIssuer(h, tokenSym)
+	cost.AddAssign(cost0)
+	if err != nil {
+		return nil, cost, err
+	}
+	if !CheckCost(h, cost) {
+		return nil, cost, host.ErrOutOfGas
+	}
+
+	ok, cost0 := h.MapHas(TokenFrozenAccountMapPrefix+tokenSym, acc)
+	cost.AddAssign(cost0)
+	if frozen && !ok {
+		publisher := h.Context().Value("publisher").(string)
+		cost0, err = enableControls(h, tokenSym, publisher)
+		cost.AddAssign(cost0)
+		if err != nil {
+			return nil, cost, err
+		}
+		cost0, err = h.MapPut(TokenFrozenAccountMapPrefix+tokenSym, acc, true, publisher)
+		cost.AddAssign(cost0)
+	} else if !frozen && ok {
+		cost0, err = h.MapDel(TokenFrozenAccountMapPrefix+tokenSym, acc)
+		cost.AddAssign(cost0)
+	}
+	if err != nil {
+		return nil, cost, err
+	}
+
+	// generate receipt
+	message, err := json.Marshal(args)
+	cost.AddAssign(host.CommonOpCost(1))
+	if err != nil {
+		return nil, cost, err
+	}
+	cost0 = h.Receipt(string(message))
+	cost.AddAssign(cost0)
+	return []interface{}{}, cost, nil
+}
+
 var (
 	initTokenABI = &abi{
 		name: "init",
@@ -458,6 +647,11 @@ var (
 					return nil, cost, fmt.Errorf("transfer need issuer permission")
 				}
 			}
+			cost0, err = checkNotPaused(h, tokenSym, from, to)
+			cost.AddAssign(cost0)
+			if err != nil {
+				return nil, cost, err
+			}
 			if !CheckCost(h, cost) {
 				return nil, cost, host.ErrOutOfGas
 			}
@@ -568,6 +762,11 @@ var (
 					return nil, cost, fmt.Errorf("transfer need issuer permission")
 				}
 			}
+			cost0, err = checkNotPaused(h, tokenSym, from, to)
+			cost.AddAssign(cost0)
+			if err != nil {
+				return nil, cost, err
+			}
 			if !CheckCost(h, cost) {
 				return nil, cost, host.ErrOutOfGas
 			}
@@ -808,4 +1007,371 @@ var (
 			return []interface{}{totalSupplyStr}, cost, nil
 		},
 	}
+
+	approveTokenABI = &abi{
+		name: "approve",
+		args: []string{"string", "string", "string", "string"},
+		do: func(h *host.Host, args ...interface{}) (rtn []interface{}, cost contract.Cost, err error) {
+			cost = contract.Cost0()
+			cost.AddAssign(host.CommonOpCost(1))
+			tokenSym := args[0].(string)
+			owner := args[1].(string)
+			spender := args[2].(string)
+			amountStr := args[3].(string)
+			if !h.IsValidAccount(owner) {
+				return nil, cost, fmt.Errorf("invalid account %v", owner)
+			}
+			if !h.IsValidAccount(spender) {
+				return nil, cost, fmt.Errorf("invalid account %v", spender)
+			}
+			if owner == spender {
+				return nil, cost, errors.New("can't approve to self")
+			}
+
+			// get token info
+			ok, cost0 := checkTokenExists(h, tokenSym)
+			cost.AddAssign(cost0)
+			if !ok {
+				return nil, cost, host.ErrTokenNotExists
+			}
+			if !CheckCost(h, cost) {
+				return nil, cost, host.ErrOutOfGas
+			}
+
+			// check auth
+			ok, cost0 = h.RequireAuth(owner, TransferPermission)
+			cost.AddAssign(cost0)
+			if !ok {
+				return nil, cost, host.ErrPermissionLost
+			}
+			if !CheckCost(h, cost) {
+				return nil, cost, host.ErrOutOfGas
+			}
+
+			// get amount by fixed point number, zero revokes the allowance
+			amount, cost0, err := parseAmount(h, tokenSym, amountStr)
+			cost.AddAssign(cost0)
+			if err != nil {
+				return nil, cost, err
+			}
+			if amount < 0 {
+				return nil, cost, host.ErrInvalidAmount
+			}
+
+			publisher := h.Context().Value("publisher").(string)
+			cost0, err = setAllowance(h, tokenSym, owner, spender, amount, publisher)
+			cost.AddAssign(cost0)
+			if err != nil {
+				return nil, cost, err
+			}
+
+			// generate receipt
+			message, err := json.Marshal(args)
+			cost.AddAssign(host.CommonOpCost(1))
+			if err != nil {
+				return nil, cost, err
+			}
+			cost0 = h.Receipt(string(message))
+			cost.AddAssign(cost0)
+			return []interface{}{}, cost, nil
+		},
+	}
+
+	allowanceTokenABI = &abi{
+		name: "allowance",
+		args: []string{"string", "string", "string"},
+		do: func(h *host.Host, args ...interface{}) (rtn []interface{}, cost contract.Cost, err error) {
+			cost = contract.Cost0()
+			cost.AddAssign(host.CommonOpCost(1))
+			tokenSym := args[0].(string)
+			owner := args[1].(string)
+			spender := args[2].(string)
+
+			// check token info
+			ok, cost0 := checkTokenExists(h, tokenSym)
+			cost.AddAssign(cost0)
+			if !ok {
+				return nil, cost, host.ErrTokenNotExists
+			}
+			if !CheckCost(h, cost) {
+				return nil, cost, host.ErrOutOfGas
+			}
+
+			allowance, cost0 := getAllowance(h, tokenSym, owner, spender)
+			cost.AddAssign(cost0)
+			allowanceStr, cost0 := genAmount(h, tokenSym, allowance)
+			cost.AddAssign(cost0)
+
+			return []interface{}{allowanceStr}, cost, nil
+		},
+	}
+
+	transferFromTokenABI = &abi{
+		name: "transferFrom",
+		args: []string{"string", "string", "string", "string", "string", "string"},
+		do: func(h *host.Host, args ...interface{}) (rtn []interface{}, cost contract.Cost, err error) {
+			cost = contract.Cost0()
+			cost.AddAssign(host.CommonOpCost(1))
+			tokenSym := args[0].(string)
+			spender := args[1].(string)
+			from := args[2].(string)
+			to := args[3].(string)
+			amountStr := args[4].(string)
+			memo := args[5].(string) // memo
+			if len(memo) > 512 {
+				return nil, cost, host.ErrMemoTooLarge
+			}
+			if !h.IsValidAccount(from) {
+				return nil, cost, fmt.Errorf("invalid account %v", from)
+			}
+			if !h.IsValidAccount(to) {
+				return nil, cost, fmt.Errorf("invalid account %v", to)
+			}
+
+			// get token info
+			ok, cost0 := checkTokenExists(h, tokenSym)
+			cost.AddAssign(cost0)
+			if !ok {
+				return nil, cost, host.ErrTokenNotExists
+			}
+			canTransfer, cost0 := h.MapGet(TokenInfoMapPrefix+tokenSym, CanTransferMapField)
+			cost.AddAssign(cost0)
+			if !(canTransfer.(bool)) {
+				return nil, cost, host.ErrTokenNoTransfer
+			}
+			onlyIssuerCanTransfer, cost0 := h.MapGet(TokenInfoMapPrefix+tokenSym, OnlyIssuerCanTransferMapField)
+			cost.AddAssign(cost0)
+			if onlyIssuerCanTransfer.(bool) {
+				issuer, cost0 := h.MapGet(TokenInfoMapPrefix+tokenSym, IssuerMapField)
+				cost.AddAssign(cost0)
+				ok, cost0 = h.RequireAuth(issuer.(string), TransferPermission)
+				cost.AddAssign(cost0)
+				if !ok {
+					return nil, cost, fmt.Errorf("transfer need issuer permission")
+				}
+			}
+			cost0, err = checkNotPaused(h, tokenSym, from, to, spender)
+			cost.AddAssign(cost0)
+			if err != nil {
+				return nil, cost, err
+			}
+			if !CheckCost(h, cost) {
+				return nil, cost, host.ErrOutOfGas
+			}
+
+			// check auth of the spender, the owner has approved beforehand
+			ok, cost0 = h.RequireAuth(spender, TransferPermission)
+			cost.AddAssign(cost0)
+			if !ok {
+				return nil, cost, host.ErrPermissionLost
+			}
+			if !CheckCost(h, cost) {
+				return nil, cost, host.ErrOutOfGas
+			}
+
+			// get amount by fixed point number
+			amount, cost0, err := parseAmount(h, tokenSym, amountStr)
+			cost.AddAssign(cost0)
+			if err != nil {
+				return nil, cost, err
+			}
+			if amount <= 0 {
+				return nil, cost, host.ErrInvalidAmount
+			}
+			if !CheckCost(h, cost) {
+				return nil, cost, host.ErrOutOfGas
+			}
+
+			// check allowance
+			allowance, cost0 := getAllowance(h, tokenSym, from, spender)
+			cost.AddAssign(cost0)
+			if allowance < amount {
+				allowanceStr, cost0 := genAmount(h, tokenSym, allowance)
+				cost.AddAssign(cost0)
+				amountStr, cost0 = genAmount(h, tokenSym, amount)
+				cost.AddAssign(cost0)
+				return nil, cost, fmt.Errorf("allowance not enough %v < %v", allowanceStr, amountStr)
+			}
+
+			publisher := h.Context().Value("publisher").(string)
+			if from != to {
+				// set balance
+				fbalance, cost0, err := getBalance(h, tokenSym, from, publisher)
+				cost.AddAssign(cost0)
+				if err != nil {
+					return nil, cost, err
+				}
+				tbalance, cost0, err := getBalance(h, tokenSym, to, publisher)
+				cost.AddAssign(cost0)
+				if err != nil {
+					return nil, cost, err
+				}
+				if fbalance < amount {
+					fbalanceStr, cost0 := genAmount(h, tokenSym, fbalance)
+					cost.AddAssign(cost0)
+					amountStr, cost0 = genAmount(h, tokenSym, amount)
+					cost.AddAssign(cost0)
+					return nil, cost, fmt.Errorf("balance not enough %v < %v", fbalanceStr, amountStr)
+				}
+				if !CheckCost(h, cost) {
+					return nil, cost, host.ErrOutOfGas
+				}
+
+				fbalance -= amount
+				tbalance += amount
+
+				cost0 = setBalance(h, tokenSym, to, tbalance, publisher)
+				cost.AddAssign(cost0)
+				cost0 = setBalance(h, tokenSym, from, fbalance, publisher)
+				cost.AddAssign(cost0)
+			}
+
+			cost0, err = setAllowance(h, tokenSym, from, spender, allowance-amount, publisher)
+			cost.AddAssign(cost0)
+			if err != nil {
+				return nil, cost, err
+			}
+
+			// generate receipt
+			message, err := json.Marshal(args)
+			cost.AddAssign(host.CommonOpCost(1))
+			if err != nil {
+				return nil, cost, err
+			}
+			cost0 = h.Receipt(string(message))
+			cost.AddAssign(cost0)
+			return []interface{}{}, cost, nil
+		},
+	}
+
+	pauseTokenABI = &abi{
+		name: "pause",
+		args: []string{"string"},
+		do: func(h *host.Host, args ...interface{}) (rtn []interface{}, cost contract.Cost, err error) {
+			return setPaused(h, true, args...)
+		},
+	}
+
+	unpauseTokenABI = &abi{
+		name: "unpause",
+		args: []string{"string"},
+		do: func(h *host.Host, args ...interface{}) (rtn []interface{}, cost contract.Cost, err error) {
+			return setPaused(h, false, args...)
+		},
+	}
+
+	freezeAccountTokenABI = &abi{
+		name: "freezeAccount",
+		args: []string{"string", "string"},
+		do: func(h *host.Host, args ...interface{}) (rtn []interface{}, cost contract.Cost, err error) {
+			return setAccountFrozen(h, true, args...)
+		},
+	}
+
+	unfreezeAccountTokenABI = &abi{
+		name: "unfreezeAccount",
+		args: []string{"string", "string"},
+		do: func(h *host.Host, args ...interface{}) (rtn []interface{}, cost contract.Cost, err error) {
+			return setAccountFrozen(h, false, args...)
+		},
+	}
+
+	setTotalSupplyTokenABI = &abi{
+		name: "setTotalSupply",
+		args: []string{"string", "string"},
+		do: func(h *host.Host, args ...interface{}) (rtn []interface{}, cost contract.Cost, err error) {
+			cost = contract.Cost0()
+			cost.AddAssign(host.CommonOpCost(1))
+			tokenSym := args[0].(string)
+			totalSupplyStr := args[1].(string)
+
+			// check auth
+			cost0, err := requireIssuer(h, tokenSym)
+			cost.AddAssign(cost0)
+			if err != nil {
+				return nil, cost, err
+			}
+			if !CheckCost(h, cost) {
+				return nil, cost, host.ErrOutOfGas
+			}
+
+			// get amount by fixed point number
+			totalSupply, cost0, err := parseAmount(h, tokenSym, totalSupplyStr)
+			cost.AddAssign(cost0)
+			if err != nil {
+				return nil, cost, err
+			}
+			if totalSupply <= 0 {
+				return nil, cost, host.ErrInvalidAmount
+			}
+
+			// the cap can not be lowered under what is issued already
+			supply, cost0 := h.MapGet(TokenInfoMapPrefix+tokenSym, SupplyMapField)
+			cost.AddAssign(cost0)
+			if totalSupply < supply.(int64) {
+				supplyStr, cost0 := genAmount(h, tokenSym, supply.(int64))
+				cost.AddAssign(cost0)
+				return nil, cost, fmt.Errorf("totalSupply less than supply %v", supplyStr)
+			}
+			if !CheckCost(h, cost) {
+				return nil, cost, host.ErrOutOfGas
+			}
+
+			cost0, err = h.MapPut(TokenInfoMapPrefix+tokenSym, TotalSupplyMapField, totalSupply)
+			cost.AddAssign(cost0)
+			if err != nil {
+				return nil, cost, err
+			}
+
+			// generate receipt
+			message, err := json.Marshal(args)
+			cost.AddAssign(host.CommonOpCost(1))
+			if err != nil {
+				return nil, cost, err
+			}
+			cost0 = h.Receipt(string(message))
+			cost.AddAssign(cost0)
+			return []interface{}{}, cost, nil
+		},
+	}
+
+	transferIssuerTokenABI = &abi{
+		name: "transferIssuer",
+		args: []string{"string", "string"},
+		do: func(h *host.Host, args ...interface{}) (rtn []interface{}, cost contract.Cost, err error) {
+			cost = contract.Cost0()
+			cost.AddAssign(host.CommonOpCost(1))
+			tokenSym := args[0].(string)
+			issuer := args[1].(string)
+			if !h.IsValidAccount(issuer) {
+				return nil, cost, fmt.Errorf("invalid account %v", issuer)
+			}
+
+			// check auth
+			cost0, err := requireIssuer(h, tokenSym)
+			cost.AddAssign(cost0)
+			if err != nil {
+				return nil, cost, err
+			}
+			if !CheckCost(h, cost) {
+				return nil, cost, host.ErrOutOfGas
+			}
+
+			cost0, err = h.MapPut(TokenInfoMapPrefix+tokenSym, IssuerMapField, issuer)
+			cost.AddAssign(cost0)
+			if err != nil {
+				return nil, cost, err
+			}
+
+			// generate receipt
+			message, err := json.Marshal(args)
+			cost.AddAssign(host.CommonOpCost(1))
+			if err != nil {
+				return nil, cost, err
+			}
+			cost0 = h.Receipt(string(message))
+			cost.AddAssign(cost0)
+			return []interface{}{}, cost, nil
+		},
+	}
 )
@@ -28,7 +28,7 @@ func goVerify(cSbx C.SandboxPtr, algo C.CStr, msg C.CStr, sig C.CStr, pubkey C.C
 	sigBytes := common.Base58Decode(sig.GoString())
 	pubkeyBytes := common.Base58Decode(pubkey.GoString())
 	*gasUsed = C.size_t(len(msgBytes) + cryptGasBase)
-	if algoStr != "secp256k1" && algoStr != "ed25519" {
+	if algoStr != "secp256k1" && algoStr != "ed25519" && algoStr != "secp256r1" {
 		return 0
 	}
 	if !crypto.NewAlgorithm(algoStr).Verify(msgBytes, pubkeyBytes, sigBytes) {